@@ -2,6 +2,8 @@
 package apis
 
 import (
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	nsv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
 )
 
@@ -9,5 +11,7 @@ func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
 		v1alpha1.SchemeBuilder.AddToScheme,
+		nsv1alpha1.SchemeBuilder.AddToScheme,
+		nsv1beta1.SchemeBuilder.AddToScheme,
 	)
 }
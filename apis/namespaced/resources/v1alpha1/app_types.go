@@ -0,0 +1,91 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// AppSpec defines the desired state of a namespaced App.
+type AppSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.AppParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// App is the Schema for the Apps API. Provides a Cloud Foundry resource to manage applications.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: App GUID (UUID format)
+//   - How to find:
+//   - UI: In the BTP Cockpit, navigate to your app and find the ID after app/ in the URL
+//   - CLI: `cf app <APP_NAME> --guid`
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.spaceName) || has(self.spec.forProvider.spaceRef) || has(self.spec.forProvider.spaceSelector))",message="SpaceReference is required: exactly one of spaceName, spaceRef, or spaceSelector must be set"
+// +kubebuilder:validation:XValidation:rule="[has(self.spec.forProvider.spaceName), has(self.spec.forProvider.spaceRef), has(self.spec.forProvider.spaceSelector)].filter(x, x).size() <= 1",message="SpaceReference validation: only one of spaceName, spaceRef, or spaceSelector can be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.forProvider.deployment) || has(self.spec.forProvider.strategy)",message="deployment requires a strategy"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.forProvider.deployment) || !has(self.spec.forProvider.deployment.canarySteps) || self.spec.forProvider.strategy == 'canary'",message="canarySteps require the canary strategy"
+type App struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppSpec            `json:"spec"`
+	Status v1alpha1.AppStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppList contains a list of Apps
+type AppList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []App `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	App_Kind             = "App"
+	App_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: App_Kind}.String()
+	App_KindAPIVersion   = App_Kind + "." + CRDGroupVersion.String()
+	App_GroupVersionKind = CRDGroupVersion.WithKind(App_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&App{}, &AppList{})
+}
+
+// GetForProvider returns the desired state of the App.
+func (mg *App) GetForProvider() *v1alpha1.AppParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the App.
+func (mg *App) GetAtProvider() *v1alpha1.AppObservation {
+	return &mg.Status.AtProvider
+}
+
+// implement Referenceable interface
+func (s *App) GetID() string {
+	return s.Status.AtProvider.GUID
+}
+
+// implement SpaceScoped interface
+func (s *App) GetSpaceRef() *v1alpha1.SpaceReference {
+	return &s.Spec.ForProvider.SpaceReference
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// BuildpackSpec defines the desired state of a namespaced Buildpack.
+type BuildpackSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.BuildpackParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// Buildpack is the Schema for the Buildpacks API. Provides a Cloud Foundry resource for managing admin buildpacks and uploading their bits.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Buildpack GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/buildpacks?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+type Buildpack struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BuildpackSpec            `json:"spec"`
+	Status v1alpha1.BuildpackStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BuildpackList contains a list of Buildpacks
+type BuildpackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Buildpack `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	Buildpack_Kind             = "Buildpack"
+	Buildpack_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: Buildpack_Kind}.String()
+	Buildpack_KindAPIVersion   = Buildpack_Kind + "." + CRDGroupVersion.String()
+	Buildpack_GroupVersionKind = CRDGroupVersion.WithKind(Buildpack_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Buildpack{}, &BuildpackList{})
+}
+
+// GetForProvider returns the desired state of the Buildpack.
+func (mg *Buildpack) GetForProvider() *v1alpha1.BuildpackParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Buildpack.
+func (mg *Buildpack) GetAtProvider() *v1alpha1.BuildpackObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns the ID of the buildpack
+func (s *Buildpack) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
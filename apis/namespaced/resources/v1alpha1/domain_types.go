@@ -0,0 +1,91 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// DomainSpec defines the desired state of a namespaced Domain.
+type DomainSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.DomainParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// Domain is the Schema for the Domains API. Provides a resource for managing shared or private domains in Cloud Foundry.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Domain GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf domains` (see GUID column)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name) || (has(self.spec.forProvider.subDomain) && has(self.spec.forProvider.domain))",message="either name or both domain and subdomain must be set"
+type Domain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainSpec            `json:"spec"`
+	Status v1alpha1.DomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainList contains a list of Domains
+type DomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Domain `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	Domain_Kind             = "Domain"
+	Domain_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: Domain_Kind}.String()
+	Domain_KindAPIVersion   = Domain_Kind + "." + CRDGroupVersion.String()
+	Domain_GroupVersionKind = CRDGroupVersion.WithKind(Domain_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Domain{}, &DomainList{})
+}
+
+// GetForProvider returns the desired state of the Domain.
+func (mg *Domain) GetForProvider() *v1alpha1.DomainParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Domain.
+func (mg *Domain) GetAtProvider() *v1alpha1.DomainObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns the ID of the domain
+func (d *Domain) GetID() string {
+	if d.Status.AtProvider.ID != nil {
+		return *d.Status.AtProvider.ID
+	}
+	return ""
+}
+
+// GetOrgRef returns the OrgReference of the domain. Implements OrgScoped interface.
+func (d *Domain) GetOrgRef() *v1alpha1.OrgReference {
+	return &d.Spec.ForProvider.OrgReference
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// EnvironmentVariableGroupSpec defines the desired state of a namespaced EnvironmentVariableGroup.
+type EnvironmentVariableGroupSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.EnvironmentVariableGroupParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// EnvironmentVariableGroup is the Schema for the EnvironmentVariableGroups API. Provides a Cloud Foundry resource for managing the running or staging environment variable group of the foundation. The resource replaces all variables of the group and reverts changes made outside of Crossplane. Deleting the resource removes all variables from the group, unless the deletion policy is `Orphan`.
+//
+// External-Name Configuration:
+//   - Follows Standard: no (uses the type of the group, not a GUID)
+//   - Format: Group type, `running` or `staging`
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf running-environment-variable-group` or `cf staging-environment-variable-group`
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.type)",message="type is required"
+type EnvironmentVariableGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentVariableGroupSpec            `json:"spec"`
+	Status v1alpha1.EnvironmentVariableGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentVariableGroupList contains a list of EnvironmentVariableGroups
+type EnvironmentVariableGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnvironmentVariableGroup `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	EnvironmentVariableGroup_Kind             = "EnvironmentVariableGroup"
+	EnvironmentVariableGroup_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: EnvironmentVariableGroup_Kind}.String()
+	EnvironmentVariableGroup_KindAPIVersion   = EnvironmentVariableGroup_Kind + "." + CRDGroupVersion.String()
+	EnvironmentVariableGroup_GroupVersionKind = CRDGroupVersion.WithKind(EnvironmentVariableGroup_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EnvironmentVariableGroup{}, &EnvironmentVariableGroupList{})
+}
+
+// GetForProvider returns the desired state of the EnvironmentVariableGroup.
+func (mg *EnvironmentVariableGroup) GetForProvider() *v1alpha1.EnvironmentVariableGroupParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the EnvironmentVariableGroup.
+func (mg *EnvironmentVariableGroup) GetAtProvider() *v1alpha1.EnvironmentVariableGroupObservation {
+	return &mg.Status.AtProvider
+}
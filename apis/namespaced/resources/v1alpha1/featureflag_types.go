@@ -0,0 +1,80 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// FeatureFlagSpec defines the desired state of a namespaced FeatureFlag.
+type FeatureFlagSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.FeatureFlagParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// FeatureFlag is the Schema for the FeatureFlags API. Provides a Cloud Foundry resource for managing a foundation-wide feature flag. Deleting the resource resets the feature flag to its default, unless the deletion policy is `Orphan`.
+//
+// External-Name Configuration:
+//   - Follows Standard: no (uses the name of the feature flag, not a GUID)
+//   - Format: Feature flag name, e.g. `diego_docker`
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf feature-flags` (field: features)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.enabled)",message="enabled is required"
+type FeatureFlag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FeatureFlagSpec            `json:"spec"`
+	Status v1alpha1.FeatureFlagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FeatureFlagList contains a list of FeatureFlags
+type FeatureFlagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FeatureFlag `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	FeatureFlag_Kind             = "FeatureFlag"
+	FeatureFlag_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: FeatureFlag_Kind}.String()
+	FeatureFlag_KindAPIVersion   = FeatureFlag_Kind + "." + CRDGroupVersion.String()
+	FeatureFlag_GroupVersionKind = CRDGroupVersion.WithKind(FeatureFlag_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FeatureFlag{}, &FeatureFlagList{})
+}
+
+// GetForProvider returns the desired state of the FeatureFlag.
+func (mg *FeatureFlag) GetForProvider() *v1alpha1.FeatureFlagParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the FeatureFlag.
+func (mg *FeatureFlag) GetAtProvider() *v1alpha1.FeatureFlagObservation {
+	return &mg.Status.AtProvider
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+// Package v1alpha1 contains the namespaced managed resources of the
+// cloudfoundry provider. They share their parameters and observations with the
+// cluster scoped managed resources.
+// +kubebuilder:object:generate=true
+// +groupName=m.cloudfoundry.crossplane.io
+// +versionName=v1alpha1
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	CRDGroup   = "m.cloudfoundry.crossplane.io"
+	CRDVersion = "v1alpha1"
+)
+
+var (
+	// CRDGroupVersion is the API Group Version used to register the objects
+	CRDGroupVersion = schema.GroupVersion{Group: CRDGroup, Version: CRDVersion}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: CRDGroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// IsolationSegmentSpec defines the desired state of a namespaced IsolationSegment.
+type IsolationSegmentSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.IsolationSegmentParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// IsolationSegment is the Schema for the IsolationSegments API. Provides a Cloud Foundry resource for managing isolation segments and their entitlements to organizations.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Isolation Segment GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/isolation_segments?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+type IsolationSegment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IsolationSegmentSpec            `json:"spec"`
+	Status v1alpha1.IsolationSegmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IsolationSegmentList contains a list of IsolationSegments
+type IsolationSegmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IsolationSegment `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	IsolationSegment_Kind             = "IsolationSegment"
+	IsolationSegment_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: IsolationSegment_Kind}.String()
+	IsolationSegment_KindAPIVersion   = IsolationSegment_Kind + "." + CRDGroupVersion.String()
+	IsolationSegment_GroupVersionKind = CRDGroupVersion.WithKind(IsolationSegment_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&IsolationSegment{}, &IsolationSegmentList{})
+}
+
+// GetForProvider returns the desired state of the IsolationSegment.
+func (mg *IsolationSegment) GetForProvider() *v1alpha1.IsolationSegmentParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the IsolationSegment.
+func (mg *IsolationSegment) GetAtProvider() *v1alpha1.IsolationSegmentObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns the ID of the isolation segment
+func (s *IsolationSegment) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// NetworkPolicySpec defines the desired state of a namespaced NetworkPolicy.
+type NetworkPolicySpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.NetworkPolicyParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// NetworkPolicy is the Schema for the NetworkPolicies API. Provides a Cloud Foundry resource for allowing container-to-container traffic from a source app to a destination app, like `cf add-network-policy`. Changing the parameters replaces the policy.
+//
+// External-Name Configuration:
+//   - Follows Standard: no (uses compound key `<source-guid>/<destination-guid>/<protocol>/<start>-<end>`, as a policy has no GUID)
+//   - Format: `<source-guid>/<destination-guid>/<protocol>/<start>-<end>`, e.g. `<source-guid>/<destination-guid>/tcp/8080-8080`
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf network-policies` combined with `cf app <APP_NAME> --guid` for the source and destination
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.ports)",message="ports is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.source) || has(self.spec.forProvider.sourceRef) || has(self.spec.forProvider.sourceSelector))",message="SourceReference is required: exactly one of source, sourceRef, or sourceSelector must be set"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.destination) || has(self.spec.forProvider.destinationRef) || has(self.spec.forProvider.destinationSelector))",message="DestinationReference is required: exactly one of destination, destinationRef, or destinationSelector must be set"
+type NetworkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkPolicySpec            `json:"spec"`
+	Status v1alpha1.NetworkPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkPolicyList contains a list of NetworkPolicies
+type NetworkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkPolicy `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	NetworkPolicy_Kind             = "NetworkPolicy"
+	NetworkPolicy_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: NetworkPolicy_Kind}.String()
+	NetworkPolicy_KindAPIVersion   = NetworkPolicy_Kind + "." + CRDGroupVersion.String()
+	NetworkPolicy_GroupVersionKind = CRDGroupVersion.WithKind(NetworkPolicy_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NetworkPolicy{}, &NetworkPolicyList{})
+}
+
+// GetForProvider returns the desired state of the NetworkPolicy.
+func (mg *NetworkPolicy) GetForProvider() *v1alpha1.NetworkPolicyParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the NetworkPolicy.
+func (mg *NetworkPolicy) GetAtProvider() *v1alpha1.NetworkPolicyObservation {
+	return &mg.Status.AtProvider
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// OrgSpec defines the desired state of a namespaced Organization.
+type OrgSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.OrgParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// Org is the Schema for the Orgs API. Creates a Cloud Foundry Organization
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Organization GUID (UUID format)
+//   - How to find:
+//   - UI: In the BTP Cockpit, navigate to your org and find the ID in the URL
+//   - CLI: Use `cf org <org-name> --guid`
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+type Organization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrgSpec            `json:"spec"`
+	Status v1alpha1.OrgStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrgList contains a list of Orgs
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Organization `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	Org_Kind             = "Organization"
+	Org_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: Org_Kind}.String()
+	Org_KindAPIVersion   = Org_Kind + "." + CRDGroupVersion.String()
+	Org_GroupVersionKind = CRDGroupVersion.WithKind(Org_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Organization{}, &OrganizationList{})
+}
+
+// GetForProvider returns the desired state of the Organization.
+func (mg *Organization) GetForProvider() *v1alpha1.OrgParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Organization.
+func (mg *Organization) GetAtProvider() *v1alpha1.OrgObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns ID of underlying resource of this App
+func (tr *Organization) GetID() string {
+	if tr.Status.AtProvider.ID == nil {
+		return ""
+	}
+	return *tr.Status.AtProvider.ID
+}
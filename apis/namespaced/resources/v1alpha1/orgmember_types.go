@@ -0,0 +1,79 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// OrgMembersSpec defines the desired state of a namespaced OrgMembers.
+type OrgMembersSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.OrgMembersParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrgMembers is the Schema for the OrgMembers API. Provides a Cloud Foundry Org users resource.
+//
+// External-Name Configuration:
+//   - Follows Standard: no (uses compound key `<org-guid>/<role-type>`, not a single GUID)
+//   - Format: `<org-guid>/<role-type>`
+//   - How to find:
+//   - UI: BTP Cockpit → Subaccounts → [Select Subaccount] → Cloud Foundry → Organization → Org ID + Settings → Org Members
+//   - CLI: `cf org <ORG_NAME> --guid` (field: guid) combined with spec.forProvider.roleType
+//
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.roleType)",message="roleType is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.orgName) || has(self.spec.forProvider.orgRef) || has(self.spec.forProvider.orgSelector))",message="OrgReference is required: exactly one of orgName, orgRef, or orgSelector must be set"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.members) && self.spec.forProvider.members.size() >= 1)",message="Members validation: at least one member must be set"
+type OrgMembers struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrgMembersSpec            `json:"spec"`
+	Status v1alpha1.OrgMembersStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrgMembersList contains a list of OrgMembers.
+type OrgMembersList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrgMembers `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	OrgMembersKind             = "OrgMembers"
+	OrgMembersGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: OrgMembersKind}.String()
+	OrgMembersKindAPIVersion   = OrgMembersKind + "." + CRDGroupVersion.String()
+	OrgMembersGroupVersionKind = CRDGroupVersion.WithKind(OrgMembersKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrgMembers{}, &OrgMembersList{})
+}
+
+// GetForProvider returns the desired state of the OrgMembers.
+func (mg *OrgMembers) GetForProvider() *v1alpha1.OrgMembersParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the OrgMembers.
+func (mg *OrgMembers) GetAtProvider() *v1alpha1.RoleAssignments {
+	return &mg.Status.AtProvider
+}
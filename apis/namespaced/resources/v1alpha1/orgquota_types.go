@@ -0,0 +1,93 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// OrgQuotaSpec defines the desired state of a namespaced OrgQuota.
+type OrgQuotaSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.OrgQuotaParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider v1alpha1.OrgQuotaInitParameters `json:"initProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// OrgQuota is the Schema for the OrgQuotas API. Provides a Cloud Foundry resource to manage org quota definitions.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: GUID (UUID v4)
+//   - How to find:
+//   - UI: Cloud Foundry > Quota Definitions > `<quota name>` (GUID in URL or details)
+//   - CLI: `cf curl /v3/organization_quotas?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+type OrgQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrgQuotaSpec            `json:"spec"`
+	Status v1alpha1.OrgQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrgQuotaList contains a list of OrgQuotas
+type OrgQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrgQuota `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	OrgQuota_Kind             = "OrgQuota"
+	OrgQuota_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: OrgQuota_Kind}.String()
+	OrgQuota_KindAPIVersion   = OrgQuota_Kind + "." + CRDGroupVersion.String()
+	OrgQuota_GroupVersionKind = CRDGroupVersion.WithKind(OrgQuota_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrgQuota{}, &OrgQuotaList{})
+}
+
+// GetForProvider returns the desired state of the OrgQuota.
+func (mg *OrgQuota) GetForProvider() *v1alpha1.OrgQuotaParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the OrgQuota.
+func (mg *OrgQuota) GetAtProvider() *v1alpha1.OrgQuotaObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetInitProvider returns the state of the OrgQuota that is only applied when it is created.
+func (mg *OrgQuota) GetInitProvider() *v1alpha1.OrgQuotaInitParameters {
+	return &mg.Spec.InitProvider
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// OrgRoleSpec defines the desired state of a namespaced OrgRole.
+type OrgRoleSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.OrgRoleParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// OrgRole is the Schema for the OrgRoles API. Provides a Cloud Foundry resource for assigning org roles.(Updating a role is not supported according to the docs)
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Org Role GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf org-users <ORG> -v` and find the GUID in the output
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.type)",message="type is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.username) || has(self.spec.forProvider.userRef) || has(self.spec.forProvider.userSelector))",message="username is required: set username, userRef or userSelector"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.orgName) || has(self.spec.forProvider.orgRef) || has(self.spec.forProvider.orgSelector))",message="OrgReference is required: exactly one of orgName, orgRef, or orgSelector must be set"
+type OrgRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrgRoleSpec            `json:"spec"`
+	Status v1alpha1.OrgRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrgRoleList contains a list of OrgRoles
+type OrgRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrgRole `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	OrgRole_Kind             = "OrgRole"
+	OrgRole_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: OrgRole_Kind}.String()
+	OrgRole_KindAPIVersion   = OrgRole_Kind + "." + CRDGroupVersion.String()
+	OrgRole_GroupVersionKind = CRDGroupVersion.WithKind(OrgRole_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrgRole{}, &OrgRoleList{})
+}
+
+// GetForProvider returns the desired state of the OrgRole.
+func (mg *OrgRole) GetForProvider() *v1alpha1.OrgRoleParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the OrgRole.
+func (mg *OrgRole) GetAtProvider() *v1alpha1.OrgRoleObservation {
+	return &mg.Status.AtProvider
+}
+
+// implement OrgScoped interface
+func (s *OrgRole) GetOrgRef() *v1alpha1.OrgReference {
+	return &s.Spec.ForProvider.OrgReference
+}
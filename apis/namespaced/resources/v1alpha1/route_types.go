@@ -0,0 +1,104 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// RouteSpec defines the desired state of a namespaced Route.
+type RouteSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.RouteParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// Route is the Schema for the Routes API. Provides a Cloud Foundry route resource.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Route GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf routes` and find the GUID in the output
+//
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.spaceName) || has(self.spec.forProvider.spaceRef) || has(self.spec.forProvider.spaceSelector))",message="SpaceReference is required: exactly one of spaceName, spaceRef, or spaceSelector must be set"
+// +kubebuilder:validation:XValidation:rule="[has(self.spec.forProvider.spaceName), has(self.spec.forProvider.spaceRef), has(self.spec.forProvider.spaceSelector)].filter(x, x).size() <= 1",message="SpaceReference validation: only one of spaceName, spaceRef, or spaceSelector can be set"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.domainName) || has(self.spec.forProvider.domainRef) || has(self.spec.forProvider.domainSelector))",message="DomainReference is required: exactly one of domainName, domainRef, or domainSelector must be set"
+// +kubebuilder:validation:XValidation:rule="[has(self.spec.forProvider.domainName), has(self.spec.forProvider.domainRef), has(self.spec.forProvider.domainSelector)].filter(x, x).size() <= 1",message="DomainReference validation: only one of domainName, domainRef, or domainSelector can be set"
+type Route struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouteSpec            `json:"spec"`
+	Status v1alpha1.RouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouteList contains a list of Routes
+type RouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Route `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	RouteKind             = "Route"
+	RouteGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: RouteKind}.String()
+	RouteKindAPIVersion   = RouteKind + "." + CRDGroupVersion.String()
+	RouteGroupVersionKind = CRDGroupVersion.WithKind(RouteKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Route{}, &RouteList{})
+}
+
+// GetForProvider returns the desired state of the Route.
+func (mg *Route) GetForProvider() *v1alpha1.RouteParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Route.
+func (mg *Route) GetAtProvider() *v1alpha1.RouteObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns the ID of the route
+func (r *Route) GetID() string {
+	return r.Status.AtProvider.GUID
+}
+
+// GetCloudFoundryName implements Namable reference interface
+func (r *Route) GetCloudFoundryName() string {
+	if r.Status.AtProvider.URL != nil {
+		return *r.Status.AtProvider.URL
+	}
+	return ""
+}
+
+// implement DomainScoped interface
+func (r *Route) GetDomainRef() *v1alpha1.DomainReference {
+	return &r.Spec.ForProvider.DomainReference
+}
+
+// implement SpaceScoped interface
+func (r *Route) GetSpaceRef() *v1alpha1.SpaceReference {
+	return &r.Spec.ForProvider.SpaceReference
+}
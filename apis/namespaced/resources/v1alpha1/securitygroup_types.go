@@ -0,0 +1,86 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// SecurityGroupSpec defines the desired state of a namespaced SecurityGroup.
+type SecurityGroupSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.SecurityGroupParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// SecurityGroup is the Schema for the SecurityGroups API. Provides a Cloud Foundry resource for managing application security groups and their bindings to spaces.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Security Group GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/security_groups?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+type SecurityGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityGroupSpec            `json:"spec"`
+	Status v1alpha1.SecurityGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityGroupList contains a list of SecurityGroups
+type SecurityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityGroup `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	SecurityGroup_Kind             = "SecurityGroup"
+	SecurityGroup_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SecurityGroup_Kind}.String()
+	SecurityGroup_KindAPIVersion   = SecurityGroup_Kind + "." + CRDGroupVersion.String()
+	SecurityGroup_GroupVersionKind = CRDGroupVersion.WithKind(SecurityGroup_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SecurityGroup{}, &SecurityGroupList{})
+}
+
+// GetForProvider returns the desired state of the SecurityGroup.
+func (mg *SecurityGroup) GetForProvider() *v1alpha1.SecurityGroupParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the SecurityGroup.
+func (mg *SecurityGroup) GetAtProvider() *v1alpha1.SecurityGroupObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns the ID of the security group
+func (s *SecurityGroup) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
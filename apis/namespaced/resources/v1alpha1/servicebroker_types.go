@@ -0,0 +1,92 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// ServiceBrokerSpec defines the desired state of a namespaced ServiceBroker.
+type ServiceBrokerSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.ServiceBrokerParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ServiceBroker is the Schema for the ServiceBrokers API. Provides a Cloud Foundry resource for registering global or space-scoped service brokers.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Service Broker GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/service_brokers?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.url)",message="url is required"
+type ServiceBroker struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceBrokerSpec            `json:"spec"`
+	Status v1alpha1.ServiceBrokerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceBrokerList contains a list of ServiceBrokers
+type ServiceBrokerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceBroker `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServiceBroker_Kind             = "ServiceBroker"
+	ServiceBroker_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceBroker_Kind}.String()
+	ServiceBroker_KindAPIVersion   = ServiceBroker_Kind + "." + CRDGroupVersion.String()
+	ServiceBroker_GroupVersionKind = CRDGroupVersion.WithKind(ServiceBroker_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceBroker{}, &ServiceBrokerList{})
+}
+
+// GetForProvider returns the desired state of the ServiceBroker.
+func (mg *ServiceBroker) GetForProvider() *v1alpha1.ServiceBrokerParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServiceBroker.
+func (mg *ServiceBroker) GetAtProvider() *v1alpha1.ServiceBrokerObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns the ID of the service broker
+func (s *ServiceBroker) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
+
+// GetSpaceRef returns the reference to the space
+func (s *ServiceBroker) GetSpaceRef() *v1alpha1.SpaceReference {
+	return &s.Spec.ForProvider.SpaceReference
+}
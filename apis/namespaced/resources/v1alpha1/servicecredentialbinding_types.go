@@ -0,0 +1,102 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// ServiceCredentialBindingSpec defines the desired state of a namespaced ServiceCredentialBinding.
+type ServiceCredentialBindingSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// (Boolean) True to write `connectionDetails` as a single key-value in a secret rather than a map. The key is the metadata.name of the service credential binding CR itself.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	ConnectionDetailsAsJSON bool `json:"connectionDetailsAsJSON,omitempty"`
+
+	ForProvider v1alpha1.ServiceCredentialBindingParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceCredentialBinding is the Schema for the ServiceCredentialBindings API. Provides a Cloud Foundry Service Key.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Service Credential Binding GUID (UUID format)
+//   - How to find:
+//   - For type: key
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf service-keys <SERVICE_INSTANCE>` and look up the key GUID via `cf curl /v3/service_credential_bindings?names=<KEY_NAME>`
+//   - For type: app
+//   - UI: Open app > Service Bindings > Service Binding GUID column
+//   - CLI: `cf service <SERVICE_INSTANCE>` > Showing bound apps > guid column
+//
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.type)",message="type is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || !(has(self.spec.forProvider.type) && self.spec.forProvider.type == 'key') || has(self.spec.forProvider.name)",message="name is required when type is key"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || !(has(self.spec.forProvider.type) && self.spec.forProvider.type == 'app') || !has(self.spec.forProvider.rotation)",message="rotation cannot be enabled when type is app"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || !(has(self.spec.forProvider.type) && self.spec.forProvider.type == 'app') || (has(self.spec.forProvider.app) || has(self.spec.forProvider.appRef) || has(self.spec.forProvider.appSelector))",message="AppReference is required: exactly one of app, appRef, or appSelector must be set if type is app"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.serviceInstance) || has(self.spec.forProvider.serviceInstanceRef) || has(self.spec.forProvider.serviceInstanceSelector))",message="ServiceInstanceReference is required: exactly one of serviceInstance, serviceInstanceRef, or serviceInstanceSelector must be set"
+// +kubebuilder:validation:XValidation:rule="[has(self.spec.forProvider.parameters), has(self.spec.forProvider.paramsSecretRef)].filter(x, x).size() <= 1",message="ParametersReference validation:either parameters or paramsSecretRef may be set but not both"
+type ServiceCredentialBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceCredentialBindingSpec            `json:"spec"`
+	Status v1alpha1.ServiceCredentialBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceCredentialBindingList contains a list of ServiceCredentialBindings
+type ServiceCredentialBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceCredentialBinding `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServiceCredentialBindingKind             = "ServiceCredentialBinding"
+	ServiceCredentialBindingGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceCredentialBindingKind}.String()
+	ServiceCredentialBindingKindAPIVersion   = ServiceCredentialBindingKind + "." + CRDGroupVersion.String()
+	ServiceCredentialBindingGroupVersionKind = CRDGroupVersion.WithKind(ServiceCredentialBindingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceCredentialBinding{}, &ServiceCredentialBindingList{})
+}
+
+// GetForProvider returns the desired state of the ServiceCredentialBinding.
+func (mg *ServiceCredentialBinding) GetForProvider() *v1alpha1.ServiceCredentialBindingParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServiceCredentialBinding.
+func (mg *ServiceCredentialBinding) GetAtProvider() *v1alpha1.ServiceCredentialBindingObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetConnectionDetailsAsJSON returns whether the connection details of the ServiceCredentialBinding are written as a single JSON value.
+func (mg *ServiceCredentialBinding) GetConnectionDetailsAsJSON() bool {
+	return mg.Spec.ConnectionDetailsAsJSON
+}
+
+// Implements Referenceable interface
+func (s *ServiceCredentialBinding) GetID() string {
+	return s.Status.AtProvider.GUID
+}
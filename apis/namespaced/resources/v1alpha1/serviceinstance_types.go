@@ -0,0 +1,115 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// ServiceInstanceSpec defines the desired state of a namespaced ServiceInstance.
+type ServiceInstanceSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.ServiceInstanceParameters `json:"forProvider"`
+
+	// (Boolean) Enable drift detection for configuration parameters of managed service instance. Default is false.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	EnableParameterDriftDetection bool `json:"enableParameterDriftDetection,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ServiceInstance is the Schema for the ServiceInstances API. Provides a Cloud Foundry resource for managing service instances.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: ServiceInstance GUID (UUID format)
+//   - How to find:
+//   - UI: In the BTP Cockpit, open the service instance detail view; the GUID is shown in the "Instance ID" field
+//   - CLI: `cf service <SERVICE_INSTANCE_NAME> --guid`
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.type)",message="type is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || !(has(self.spec.forProvider.type) && self.spec.forProvider.type == 'managed') || has(self.spec.forProvider.servicePlan)",message="servicePlan is required when type is managed"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || !(has(self.spec.forProvider.type) && self.spec.forProvider.type == 'managed') || !has(self.spec.forProvider.servicePlan) || has(self.spec.forProvider.servicePlan.id) || (has(self.spec.forProvider.servicePlan.offering) && has(self.spec.forProvider.servicePlan.plan))",message="either id or offering and plan must be set on servicePlan"
+// +kubebuilder:validation:XValidation:rule="!(has(self.spec.forProvider.type) && self.spec.forProvider.type == 'user-provided') || [has(self.spec.forProvider.credentials), has(self.spec.forProvider.jsonCredentials), has(self.spec.forProvider.credentialsSecretRef)].filter(x, x).size() <= 1",message="CredentialsReference validation: only one of credentials, jsonCredentials, or credentialsSecretRef can be set"
+// +kubebuilder:validation:XValidation:rule="[has(self.spec.forProvider.parameters), has(self.spec.forProvider.jsonParams), has(self.spec.forProvider.paramsSecretRef )].filter(x, x).size() <= 1",message="ParamsReference validation: only one of parameters, jsonParams, or paramsSecretRef  can be set"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.spaceName) || has(self.spec.forProvider.spaceRef) || has(self.spec.forProvider.spaceSelector))",message="SpaceReference is required: exactly one of spaceName, spaceRef, or spaceSelector must be set"
+type ServiceInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceInstanceSpec            `json:"spec"`
+	Status v1alpha1.ServiceInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceInstanceList contains a list of ServiceInstances
+type ServiceInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceInstance `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServiceInstance_Kind             = "ServiceInstance"
+	ServiceInstance_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceInstance_Kind}.String()
+	ServiceInstance_KindAPIVersion   = ServiceInstance_Kind + "." + CRDGroupVersion.String()
+	ServiceInstance_GroupVersionKind = CRDGroupVersion.WithKind(ServiceInstance_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceInstance{}, &ServiceInstanceList{})
+}
+
+// GetForProvider returns the desired state of the ServiceInstance.
+func (mg *ServiceInstance) GetForProvider() *v1alpha1.ServiceInstanceParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServiceInstance.
+func (mg *ServiceInstance) GetAtProvider() *v1alpha1.ServiceInstanceObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetEnableParameterDriftDetection returns whether drift of the parameters of the ServiceInstance is detected.
+func (mg *ServiceInstance) GetEnableParameterDriftDetection() bool {
+	return mg.Spec.EnableParameterDriftDetection
+}
+
+// GetName implements Nameable interface
+func (r *ServiceInstance) GetCloudFoundryName() string {
+	if r.Spec.ForProvider.Name == nil {
+		return ""
+	}
+	return *r.Spec.ForProvider.Name
+}
+
+// GetID implements Referenceable interface (used by resources.ExternalID extractor)
+func (r *ServiceInstance) GetID() string {
+	if r.Status.AtProvider.ID == nil {
+		return ""
+	}
+	return *r.Status.AtProvider.ID
+}
+
+// GetSpaceRef returns the reference to the space
+func (s *ServiceInstance) GetSpaceRef() *v1alpha1.SpaceReference {
+	return &s.Spec.ForProvider.SpaceReference
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// ServiceOfferingSpec defines the desired state of a namespaced ServiceOffering.
+type ServiceOfferingSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.ServiceOfferingParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ServiceOffering is the Schema for the ServiceOfferings API. Observes a service offering of the marketplace. Service offerings are registered by service brokers and cannot be created, updated or deleted.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Service Offering GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/service_offerings?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+type ServiceOffering struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceOfferingSpec            `json:"spec"`
+	Status v1alpha1.ServiceOfferingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceOfferingList contains a list of ServiceOfferings
+type ServiceOfferingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceOffering `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServiceOffering_Kind             = "ServiceOffering"
+	ServiceOffering_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceOffering_Kind}.String()
+	ServiceOffering_KindAPIVersion   = ServiceOffering_Kind + "." + CRDGroupVersion.String()
+	ServiceOffering_GroupVersionKind = CRDGroupVersion.WithKind(ServiceOffering_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceOffering{}, &ServiceOfferingList{})
+}
+
+// GetForProvider returns the desired state of the ServiceOffering.
+func (mg *ServiceOffering) GetForProvider() *v1alpha1.ServiceOfferingParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServiceOffering.
+func (mg *ServiceOffering) GetAtProvider() *v1alpha1.ServiceOfferingObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns the ID of the service offering
+func (s *ServiceOffering) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
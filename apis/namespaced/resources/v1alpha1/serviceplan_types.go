@@ -0,0 +1,85 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// ServicePlanSpec defines the desired state of a namespaced ServicePlan.
+type ServicePlanSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.ServicePlanParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ServicePlan is the Schema for the ServicePlans API. Observes a service plan of the marketplace. Service plans are registered by service brokers and cannot be created, updated or deleted.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Service Plan GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/service_plans?service_offering_names=<offering>&names=<plan>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+type ServicePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServicePlanSpec            `json:"spec"`
+	Status v1alpha1.ServicePlanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServicePlanList contains a list of ServicePlans
+type ServicePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServicePlan `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServicePlan_Kind             = "ServicePlan"
+	ServicePlan_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServicePlan_Kind}.String()
+	ServicePlan_KindAPIVersion   = ServicePlan_Kind + "." + CRDGroupVersion.String()
+	ServicePlan_GroupVersionKind = CRDGroupVersion.WithKind(ServicePlan_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServicePlan{}, &ServicePlanList{})
+}
+
+// GetForProvider returns the desired state of the ServicePlan.
+func (mg *ServicePlan) GetForProvider() *v1alpha1.ServicePlanParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServicePlan.
+func (mg *ServicePlan) GetAtProvider() *v1alpha1.ServicePlanObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns the ID of the service plan
+func (s *ServicePlan) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// ServicePlanVisibilitySpec defines the desired state of a namespaced ServicePlanVisibility.
+type ServicePlanVisibilitySpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.ServicePlanVisibilityParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ServicePlanVisibility is the Schema for the ServicePlanVisibilities API. Provides a Cloud Foundry resource for managing the access to a service plan.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Service Plan GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/service_plans?service_offering_names=<offering>&names=<plan>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.type)",message="type is required"
+type ServicePlanVisibility struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServicePlanVisibilitySpec            `json:"spec"`
+	Status v1alpha1.ServicePlanVisibilityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServicePlanVisibilityList contains a list of ServicePlanVisibilities
+type ServicePlanVisibilityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServicePlanVisibility `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServicePlanVisibility_Kind             = "ServicePlanVisibility"
+	ServicePlanVisibility_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServicePlanVisibility_Kind}.String()
+	ServicePlanVisibility_KindAPIVersion   = ServicePlanVisibility_Kind + "." + CRDGroupVersion.String()
+	ServicePlanVisibility_GroupVersionKind = CRDGroupVersion.WithKind(ServicePlanVisibility_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServicePlanVisibility{}, &ServicePlanVisibilityList{})
+}
+
+// GetForProvider returns the desired state of the ServicePlanVisibility.
+func (mg *ServicePlanVisibility) GetForProvider() *v1alpha1.ServicePlanVisibilityParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServicePlanVisibility.
+func (mg *ServicePlanVisibility) GetAtProvider() *v1alpha1.ServicePlanVisibilityObservation {
+	return &mg.Status.AtProvider
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// ServiceRouteBindingSpec defines the desired state of a namespaced ServiceRouteBinding.
+type ServiceRouteBindingSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.ServiceRouteBindingParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ServiceRouteBinding is the Schema for the ServiceRouteBindings API. Provides a Cloud Foundry resource for binding Cloud Foundry service instances to routes.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Service Route Binding GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf <SERVICE_INSTANCE> -v` and find the GUID in the output.
+//
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROUTE",type="string",JSONPath=".status.atProvider.routeGUID",priority=1
+// +kubebuilder:printcolumn:name="SERVICE-INSTANCE",type="string",JSONPath=".status.atProvider.serviceInstanceGUID",priority=1
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:validation:XValidation:rule="has(self.spec.forProvider.serviceInstance) || has(self.spec.forProvider.serviceInstanceRef) || has(self.spec.forProvider.serviceInstanceSelector)",message="ServiceInstanceReference validation: one of serviceInstance, serviceInstanceRef, or serviceInstanceSelector must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.forProvider.serviceInstanceRef) || !has(self.spec.forProvider.serviceInstanceSelector)",message="ServiceInstanceReference validation: serviceInstanceRef and serviceInstanceSelector are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="has(self.spec.forProvider.route) || has(self.spec.forProvider.routeRef) || has(self.spec.forProvider.routeSelector)",message="RouteReference validation: one of route, routeRef, or routeSelector must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.forProvider.routeRef) || !has(self.spec.forProvider.routeSelector)",message="RouteReference validation: routeRef and routeSelector are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.spec.forProvider.route) || !has(self.spec.forProvider.route) || oldSelf.spec.forProvider.route.size() == 0 || oldSelf.spec.forProvider.route == self.spec.forProvider.route",message="ServiceRouteBinding is immutable: route GUID cannot be changed once set"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.spec.forProvider.serviceInstance) || !has(self.spec.forProvider.serviceInstance) || oldSelf.spec.forProvider.serviceInstance.size() == 0 || oldSelf.spec.forProvider.serviceInstance == self.spec.forProvider.serviceInstance",message="ServiceRouteBinding is immutable: serviceInstance GUID cannot be changed once set"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.spec.forProvider.parameters) || !has(self.spec.forProvider.parameters) || oldSelf.spec.forProvider.parameters == self.spec.forProvider.parameters",message="ServiceRouteBinding is immutable: parameters cannot be changed once set"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.spec.forProvider.paramsSecretRef) || !has(self.spec.forProvider.paramsSecretRef) || oldSelf.spec.forProvider.paramsSecretRef == self.spec.forProvider.paramsSecretRef",message="ServiceRouteBinding is immutable: paramsSecretRef cannot be changed once set"
+type ServiceRouteBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceRouteBindingSpec            `json:"spec"`
+	Status v1alpha1.ServiceRouteBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// ServiceRouteBindingList contains a list of ServiceRouteBindings
+type ServiceRouteBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceRouteBinding `json:"items"`
+}
+
+// Repository type metadata for registration.
+var (
+	ServiceRouteBinding_Kind             = "ServiceRouteBinding"
+	ServiceRouteBinding_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceRouteBinding_Kind}.String()
+	ServiceRouteBinding_KindAPIVersion   = ServiceRouteBinding_Kind + "." + CRDGroupVersion.String()
+	ServiceRouteBinding_GroupVersionKind = CRDGroupVersion.WithKind(ServiceRouteBinding_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceRouteBinding{}, &ServiceRouteBindingList{})
+}
+
+// GetForProvider returns the desired state of the ServiceRouteBinding.
+func (mg *ServiceRouteBinding) GetForProvider() *v1alpha1.ServiceRouteBindingParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServiceRouteBinding.
+func (mg *ServiceRouteBinding) GetAtProvider() *v1alpha1.ServiceRouteBindingObservation {
+	return &mg.Status.AtProvider
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// SpaceQuotaSpec defines the desired state of a namespaced SpaceQuota.
+type SpaceQuotaSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.SpaceQuotaParameters `json:"forProvider"`
+	// THIS IS A BETA FIELD. It will be honored
+	// unless the Management Policies feature flag is disabled.
+	// InitProvider holds the same fields as ForProvider, with the exception
+	// of Identifier and other resource reference fields. The fields that are
+	// in InitProvider are merged into ForProvider when the resource is created.
+	// The same fields are also added to the terraform ignore_changes hook, to
+	// avoid updating them after creation. This is useful for fields that are
+	// required on creation, but we do not desire to update them after creation,
+	// for example because of an external controller is managing them, like an
+	// autoscaler.
+	InitProvider v1alpha1.SpaceQuotaInitParameters `json:"initProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// SpaceQuota is the Schema for the SpaceQuotas API. Provides a Cloud Foundry resource to manage space quota definitions.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Space Quota GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf space-quota <QUOTA-NAME> -v` and find the GUID in the output
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+type SpaceQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpaceQuotaSpec            `json:"spec"`
+	Status v1alpha1.SpaceQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpaceQuotaList contains a list of SpaceQuotas
+type SpaceQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpaceQuota `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	SpaceQuota_Kind             = "SpaceQuota"
+	SpaceQuota_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SpaceQuota_Kind}.String()
+	SpaceQuota_KindAPIVersion   = SpaceQuota_Kind + "." + CRDGroupVersion.String()
+	SpaceQuota_GroupVersionKind = CRDGroupVersion.WithKind(SpaceQuota_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SpaceQuota{}, &SpaceQuotaList{})
+}
+
+// GetForProvider returns the desired state of the SpaceQuota.
+func (mg *SpaceQuota) GetForProvider() *v1alpha1.SpaceQuotaParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the SpaceQuota.
+func (mg *SpaceQuota) GetAtProvider() *v1alpha1.SpaceQuotaObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetInitProvider returns the state of the SpaceQuota that is only applied when it is created.
+func (mg *SpaceQuota) GetInitProvider() *v1alpha1.SpaceQuotaInitParameters {
+	return &mg.Spec.InitProvider
+}
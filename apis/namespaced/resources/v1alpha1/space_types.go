@@ -0,0 +1,89 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// SpaceSpec defines the desired state of a namespaced Space.
+type SpaceSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.SpaceParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// Space is the Schema for the Spaces API. Provides a Cloud Foundry resource for managing Cloud Foundry spaces within organizations.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Space GUID (UUID format)
+//   - How to find:
+//   - UI: Global Account → Account Explorer → Subaccounts → Select Subaccount → Spaces → Select Space → View URL: `https://<cockpit_url>/cockpit#/globalaccount/<global_account_id>/subaccount/<subaccount_id>/org/<org_id>/space/<SPACE_ID>/applications`
+//   - CLI: Use CF CLI: `cf space <SPACE> --guid`
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.orgName) || has(self.spec.forProvider.orgRef) || has(self.spec.forProvider.orgSelector))",message="OrgReference is required: exactly one of orgName, orgRef, or orgSelector must be set"
+type Space struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpaceSpec            `json:"spec"`
+	Status v1alpha1.SpaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpaceList contains a list of Spaces.
+type SpaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Space `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	Space_Kind             = "Space"
+	Space_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: Space_Kind}.String()
+	Space_KindAPIVersion   = Space_Kind + "." + CRDGroupVersion.String()
+	Space_GroupVersionKind = CRDGroupVersion.WithKind(Space_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Space{}, &SpaceList{})
+}
+
+// GetForProvider returns the desired state of the Space.
+func (mg *Space) GetForProvider() *v1alpha1.SpaceParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Space.
+func (mg *Space) GetAtProvider() *v1alpha1.SpaceObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns ID of external resource managed by this CR.
+func (r *Space) GetID() string {
+	return r.Status.AtProvider.ID
+}
+
+// implement OrgScoped interface
+func (s *Space) GetOrgRef() *v1alpha1.OrgReference {
+	return &s.Spec.ForProvider.OrgReference
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// SpaceMembersSpec defines the desired state of a namespaced SpaceMembers.
+type SpaceMembersSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.SpaceMembersParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpaceMembers is the Schema for the SpaceMembers API. Provides a Cloud Foundry Space users resource.
+//
+// External-Name Configuration:
+//   - Follows Standard: no (uses compound key `<space-guid>/<role-type>`, not a single GUID)
+//   - Format: `<space-guid>/<role-type>`
+//   - How to find:
+//   - UI: BTP Cockpit → Subaccounts → [Select Subaccount] → Cloud Foundry → Space → Space ID + Settings → Space Members
+//   - CLI: `cf space <SPACE_NAME> --guid` (field: guid) combined with spec.forProvider.roleType
+//
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.roleType)",message="roleType is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.spaceName) || has(self.spec.forProvider.spaceRef) || has(self.spec.forProvider.spaceSelector))",message="SpaceReference is required: exactly one of spaceName, spaceRef, or spaceSelector must be set"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.members) && self.spec.forProvider.members.size() >= 1)",message="Members validation: at least one member must be set"
+type SpaceMembers struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpaceMembersSpec            `json:"spec"`
+	Status v1alpha1.SpaceMembersStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpaceMembersList contains a list of SpaceMembers.
+type SpaceMembersList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpaceMembers `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	SpaceMembersKind             = "SpaceMembers"
+	SpaceMembersGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SpaceMembersKind}.String()
+	SpaceMembersKindAPIVersion   = SpaceMembersKind + "." + CRDGroupVersion.String()
+	SpaceMembersGroupVersionKind = CRDGroupVersion.WithKind(SpaceMembersKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SpaceMembers{}, &SpaceMembersList{})
+}
+
+// GetForProvider returns the desired state of the SpaceMembers.
+func (mg *SpaceMembers) GetForProvider() *v1alpha1.SpaceMembersParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the SpaceMembers.
+func (mg *SpaceMembers) GetAtProvider() *v1alpha1.RoleAssignments {
+	return &mg.Status.AtProvider
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// SpaceRoleSpec defines the desired state of a namespaced SpaceRole.
+type SpaceRoleSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.SpaceRoleParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// SpaceRole is the Schema for the OrgRoles API. Provides a Cloud Foundry resource for assigning org roles.(Updating a role is not supported according to the docs)
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Space Role GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf space-users <ORG> <SPACE> -v` and find the GUID in the output
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.type)",message="type is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.username) || has(self.spec.forProvider.userRef) || has(self.spec.forProvider.userSelector))",message="username is required: set username, userRef or userSelector"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.spaceName) || has(self.spec.forProvider.spaceRef) || has(self.spec.forProvider.spaceSelector))",message="SpaceReference is required: exactly one of spaceName, spaceRef, or spaceSelector must be set"
+type SpaceRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpaceRoleSpec            `json:"spec"`
+	Status v1alpha1.SpaceRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpaceRoleList contains a list of OrgRoles
+type SpaceRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpaceRole `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	SpaceRole_Kind             = "SpaceRole"
+	SpaceRole_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SpaceRole_Kind}.String()
+	SpaceRole_KindAPIVersion   = SpaceRole_Kind + "." + CRDGroupVersion.String()
+	SpaceRole_GroupVersionKind = CRDGroupVersion.WithKind(SpaceRole_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SpaceRole{}, &SpaceRoleList{})
+}
+
+// GetForProvider returns the desired state of the SpaceRole.
+func (mg *SpaceRole) GetForProvider() *v1alpha1.SpaceRoleParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the SpaceRole.
+func (mg *SpaceRole) GetAtProvider() *v1alpha1.SpaceRoleObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetSpaceRef returns the reference to the space
+func (s *SpaceRole) GetSpaceRef() *v1alpha1.SpaceReference {
+	return &s.Spec.ForProvider.SpaceReference
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// TaskSpec defines the desired state of a namespaced Task.
+type TaskSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.TaskParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// Task is the Schema for the Tasks API. Provides a Cloud Foundry resource for running a one-off task of an app, like `cf run-task`. The task is run once and is ready when it succeeds; it is run again when its parameters change. Deleting the resource cancels a task that is still running.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Task GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/apps/<app-guid>/tasks` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.command)",message="command is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.app) || has(self.spec.forProvider.appRef) || has(self.spec.forProvider.appSelector))",message="AppReference is required: exactly one of app, appRef, or appSelector must be set"
+type Task struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaskSpec            `json:"spec"`
+	Status v1alpha1.TaskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskList contains a list of Tasks
+type TaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Task `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	Task_Kind             = "Task"
+	Task_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: Task_Kind}.String()
+	Task_KindAPIVersion   = Task_Kind + "." + CRDGroupVersion.String()
+	Task_GroupVersionKind = CRDGroupVersion.WithKind(Task_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Task{}, &TaskList{})
+}
+
+// GetForProvider returns the desired state of the Task.
+func (mg *Task) GetForProvider() *v1alpha1.TaskParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Task.
+func (mg *Task) GetAtProvider() *v1alpha1.TaskObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns the ID of the task
+func (s *Task) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// UserSpec defines the desired state of a namespaced User.
+type UserSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              v1alpha1.UserParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// User is the Schema for the Users API. Provides a Cloud Foundry resource for creating the user record of a username, so that roles can be assigned before the user has logged in to Cloud Foundry.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: User GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl "/v3/users?usernames=<username>&origins=<origin>"` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".status.atProvider.username"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.username) || has(self.spec.forProvider.guid)",message="username or guid is required"
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserSpec            `json:"spec"`
+	Status v1alpha1.UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserList contains a list of Users
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	User_Kind             = "User"
+	User_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: User_Kind}.String()
+	User_KindAPIVersion   = User_Kind + "." + CRDGroupVersion.String()
+	User_GroupVersionKind = CRDGroupVersion.WithKind(User_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&User{}, &UserList{})
+}
+
+// GetForProvider returns the desired state of the User.
+func (mg *User) GetForProvider() *v1alpha1.UserParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the User.
+func (mg *User) GetAtProvider() *v1alpha1.UserObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetID returns the ID of the user
+func (s *User) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
+
+// GetCloudFoundryName implements Nameable interface. It returns the observed
+// username, so that references resolve only once the user exists.
+func (s *User) GetCloudFoundryName() string {
+	if s.Status.AtProvider.Username != nil {
+		return *s.Status.AtProvider.Username
+	}
+	return ""
+}
+
+// GetOrigin implements Originable interface. It returns the observed
+// identity provider of the user.
+func (s *User) GetOrigin() string {
+	if s.Status.AtProvider.Origin != nil {
+		return *s.Status.AtProvider.Origin
+	}
+	return ""
+}
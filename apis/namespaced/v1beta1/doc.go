@@ -0,0 +1,10 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+// Package v1beta1 contains the ProviderConfigs of the namespaced managed
+// resources of the cloudfoundry provider.
+// +kubebuilder:object:generate=true
+// +groupName=m.cloudfoundry.crossplane.io
+// +versionName=v1beta1
+package v1beta1
@@ -38,3 +38,20 @@ func CloudFoundryName() reference.ExtractValueFn {
 		return o.GetCloudFoundryName()
 	}
 }
+
+// Originable return the identity provider of a user for references.
+type Originable interface {
+	GetOrigin() string
+}
+
+// Origin is ExtractValueFn to retrieve the identity provider of a user.
+func Origin() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		o, ok := mg.(Originable)
+		// If the resource has no origin, return zero string
+		if !ok {
+			return ""
+		}
+		return o.GetOrigin()
+	}
+}
@@ -26,6 +26,12 @@ type AppObservation struct {
 	// The list of routes currently mapped to the application.
 	Routes []AppRouteObservation `json:"routes,omitempty"`
 
+	// The user sidecars of the application, observed when `sidecars` is set.
+	Sidecars []SidecarConfiguration `json:"sidecars,omitempty"`
+
+	// The latest deployment of the application, observed when a `strategy` is set.
+	Deployment *AppDeploymentObservation `json:"deployment,omitempty"`
+
 	ResourceMetadata `json:",inline"`
 }
 
@@ -47,6 +53,38 @@ type AppRouteObservation struct {
 	Port *int `json:"port,omitempty"`
 }
 
+// AppDeploymentObservation represents an observed deployment of the application.
+type AppDeploymentObservation struct {
+	Resource `json:",inline"`
+
+	// The strategy of the deployment, either `rolling` or `canary`.
+	Strategy string `json:"strategy,omitempty"`
+
+	// The status of the deployment, either `ACTIVE` or `FINALIZED`.
+	Status string `json:"status,omitempty"`
+
+	// The reason of the status, e.g. `DEPLOYING`, `PAUSED`, `DEPLOYED` or `CANCELED`.
+	Reason string `json:"reason,omitempty"`
+
+	// The current step of a canary deployment.
+	CurrentCanaryStep *int `json:"currentCanaryStep,omitempty"`
+
+	// The total number of steps of a canary deployment.
+	TotalCanarySteps *int `json:"totalCanarySteps,omitempty"`
+
+	// The GUID of the droplet the deployment rolls out.
+	Droplet string `json:"droplet,omitempty"`
+
+	// The GUID of the droplet the application ran before the deployment.
+	PreviousDroplet string `json:"previousDroplet,omitempty"`
+
+	// Whether the deployment rolled back to the previous droplet.
+	RolledBack bool `json:"rolledBack,omitempty"`
+
+	// The hash of the docker image and environment the deployment rolls out.
+	SpecHash string `json:"specHash,omitempty"`
+}
+
 type AppParameters struct {
 	// The `name` of the application.
 	// +kubebuilder:validation:Required
@@ -107,9 +145,20 @@ type AppParameters struct {
 	// +kubebuilder:validation:Optional
 	ReadinessHealthCheckConfiguration `json:",inline"`
 
-	// Sidecar configuration for the application.
+	// Sidecar configuration for the application. Once set, the user sidecars of the application are exactly the listed ones; an empty list removes them. Sidecars added by buildpacks are kept.
 	// +kubebuilder:validation:Optional
-	// Sidecars []SidecarConfiguration `json:"sidecars,omitempty"`
+	// +listType=map
+	// +listMapKey=name
+	Sidecars *[]SidecarConfiguration `json:"sidecars,omitempty"`
+
+	// The strategy to roll out a new docker image or environment to the started application without downtime; valid values are `rolling` and `canary`. Without a strategy, the application is restarted.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=rolling;canary
+	Strategy *string `json:"strategy,omitempty"`
+
+	// The options of the deployments that roll out changes when a `strategy` is set.
+	// +kubebuilder:validation:Optional
+	Deployment *DeploymentConfiguration `json:"deployment,omitempty"`
 
 	// A key-value mapping of environment variables to be used for the app when running
 	// +kubebuilder:validation:Optional
@@ -255,14 +304,38 @@ type SidecarConfiguration struct {
 	Name string `json:"name"`
 
 	// The command used to start the sidecar process.
-	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Required
 	Command *string `json:"command,omitempty"`
 
 	// List of processes to associate with the sidecar.
+	// +kubebuilder:validation:MinItems=1
 	ProcessTypes []string `json:"process-types"`
 
-	// Memory in MB to be allocated to the sidecar.
-	Memory *uint `json:"memory"`
+	// Memory in MB to be allocated to the sidecar. Without memory, the sidecar shares the memory of the process.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	Memory *uint `json:"memory,omitempty"`
+}
+
+// DeploymentConfiguration defines the options of the deployments of the application
+type DeploymentConfiguration struct {
+	// The maximum number of new instances started at the same time.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxInFlight *int `json:"maxInFlight,omitempty"`
+
+	// The steps of a `canary` deployment. The deployment pauses after each step until it is continued. Without steps, the deployment pauses once after starting a single canary instance.
+	// +kubebuilder:validation:Optional
+	CanarySteps []CanaryStep `json:"canarySteps,omitempty"`
+}
+
+// CanaryStep defines a step of a canary deployment
+type CanaryStep struct {
+	// The percentage of the instances that run the new version after the step.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	InstanceWeight int `json:"instanceWeight"`
 }
 
 // AppSpec defines the desired state of App
@@ -297,6 +370,8 @@ type AppStatus struct {
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
 // +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.spaceName) || has(self.spec.forProvider.spaceRef) || has(self.spec.forProvider.spaceSelector))",message="SpaceReference is required: exactly one of spaceName, spaceRef, or spaceSelector must be set"
 // +kubebuilder:validation:XValidation:rule="[has(self.spec.forProvider.spaceName), has(self.spec.forProvider.spaceRef), has(self.spec.forProvider.spaceSelector)].filter(x, x).size() <= 1",message="SpaceReference validation: only one of spaceName, spaceRef, or spaceSelector can be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.forProvider.deployment) || has(self.spec.forProvider.strategy)",message="deployment requires a strategy"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.forProvider.deployment) || !has(self.spec.forProvider.deployment.canarySteps) || self.spec.forProvider.strategy == 'canary'",message="canarySteps require the canary strategy"
 type App struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// BuildpackSource defines where the bits of a buildpack are downloaded from.
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.image)",message="exactly one of url or image must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.url) || has(self.checksum)",message="checksum is required for url"
+type BuildpackSource struct {
+	// (String) The HTTP(S) URL of the zip file with the bits of the buildpack.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL *string `json:"url,omitempty"`
+
+	// (String) The SHA-256 checksum of the zip file at `url` in the format `sha256:<hex>`. The downloaded bits are verified against it, and a new checksum uploads the bits again.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	Checksum *string `json:"checksum,omitempty"`
+
+	// (String) The reference of an OCI artifact pinned by digest, e.g. `ghcr.io/my-org/my-buildpack@sha256:<hex>`. The artifact must have a single layer with the zip file of the buildpack, and a new digest uploads the bits again.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`@sha256:[a-f0-9]{64}$`
+	Image *string `json:"image,omitempty"`
+}
+
+// BuildpackJob is the asynchronous job that processes the uploaded bits of a
+// buildpack.
+type BuildpackJob struct {
+	// (String) The GUID of the job.
+	GUID string `json:"guid,omitempty"`
+
+	// (String) The operation of the job, e.g. `buildpack.upload`.
+	Operation string `json:"operation,omitempty"`
+
+	// (String) The state of the job: `PROCESSING`, `POLLING`, `COMPLETE` or `FAILED`.
+	State string `json:"state,omitempty"`
+
+	// (String) The errors reported by a failed job.
+	Errors string `json:"errors,omitempty"`
+}
+
+type BuildpackObservation struct {
+	// (String) The GUID of the object.
+	ID *string `json:"id,omitempty"`
+
+	// (String) The name of the buildpack.
+	Name *string `json:"name,omitempty"`
+
+	// (String) The name of the stack the buildpack uses.
+	Stack *string `json:"stack,omitempty"`
+
+	// (Number) The position of the buildpack in the buildpack auto-detection order.
+	Position *int `json:"position,omitempty"`
+
+	// (Boolean) Whether the buildpack can be used for staging.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// (Boolean) Whether the bits of the buildpack are locked against updates.
+	Locked *bool `json:"locked,omitempty"`
+
+	// (String) The state of the buildpack: `AWAITING_UPLOAD` or `READY`.
+	State *string `json:"state,omitempty"`
+
+	// (String) The file name of the uploaded bits.
+	Filename *string `json:"filename,omitempty"`
+
+	// (String) The checksum of the uploaded bits: the checksum of the zip file at `url` or the digest of the OCI artifact.
+	Checksum *string `json:"checksum,omitempty"`
+
+	// (Attributes) The asynchronous job of the last upload of the bits.
+	LastJob *BuildpackJob `json:"lastJob,omitempty"`
+
+	// (String) The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	CreatedAt *string `json:"createdAt,omitempty"`
+
+	// (String) The date and time when the resource was updated in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+
+	// (Attributes) The metadata associated with the Cloud Foundry resource.
+	ResourceMetadata `json:",inline"`
+}
+
+type BuildpackParameters struct {
+	// (String) The name of the buildpack, used in the `buildpacks` of apps.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+
+	// (String) The name of the stack the buildpack uses, e.g. `cflinuxfs4`. If not set, the stack is taken from the manifest of the uploaded bits.
+	// +kubebuilder:validation:Optional
+	Stack *string `json:"stack,omitempty"`
+
+	// (Number) The position of the buildpack in the buildpack auto-detection order, starting at 1. If not set, the buildpack is added at the end.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	Position *int `json:"position,omitempty"`
+
+	// (Boolean) Whether the buildpack can be used for staging. Defaults to `true`.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// (Boolean) Whether the bits of the buildpack are locked against updates. Defaults to `false`. New bits can only be uploaded to an unlocked buildpack.
+	// +kubebuilder:validation:Optional
+	Locked *bool `json:"locked,omitempty"`
+
+	// (Attributes) The source of the bits of the buildpack. If not set, the buildpack awaits an upload of its bits.
+	// +kubebuilder:validation:Optional
+	Source *BuildpackSource `json:"source,omitempty"`
+
+	// (Attributes) The metadata associated with the Cloud Foundry resource.
+	// +kubebuilder:validation:Optional
+	ResourceMetadata `json:",inline"`
+}
+
+// BuildpackSpec defines the desired state of Buildpack
+type BuildpackSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     BuildpackParameters `json:"forProvider"`
+}
+
+// BuildpackStatus defines the observed state of Buildpack.
+type BuildpackStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        BuildpackObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// Buildpack is the Schema for the Buildpacks API. Provides a Cloud Foundry resource for managing admin buildpacks and uploading their bits.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Buildpack GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/buildpacks?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+type Buildpack struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              BuildpackSpec   `json:"spec"`
+	Status            BuildpackStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BuildpackList contains a list of Buildpacks
+type BuildpackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Buildpack `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	Buildpack_Kind             = "Buildpack"
+	Buildpack_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: Buildpack_Kind}.String()
+	Buildpack_KindAPIVersion   = Buildpack_Kind + "." + CRDGroupVersion.String()
+	Buildpack_GroupVersionKind = CRDGroupVersion.WithKind(Buildpack_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Buildpack{}, &BuildpackList{})
+}
+
+// GetID returns the ID of the buildpack
+func (s *Buildpack) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TypeCFRequest indicates whether the last request to the CF API for a managed
+// resource succeeded. The reason of a failed request is the category of its
+// error, e.g. NotFound, Conflict, QuotaExceeded, Transient, Unauthorized or
+// BrokerFailure.
+const TypeCFRequest xpv1.ConditionType = "CFRequest"
+
+// ReasonRequestSucceeded is the reason of a succeeded request.
+const ReasonRequestSucceeded xpv1.ConditionReason = "Succeeded"
+
+// RequestSucceeded returns a condition indicating that the last request to the
+// CF API succeeded.
+func RequestSucceeded() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCFRequest,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRequestSucceeded,
+	}
+}
+
+// RequestFailed returns a condition indicating that the last request to the CF
+// API failed for the given reason.
+func RequestFailed(reason xpv1.ConditionReason, err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCFRequest,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            err.Error(),
+	}
+}
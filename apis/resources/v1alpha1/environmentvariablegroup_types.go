@@ -0,0 +1,121 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+type EnvironmentVariableGroupObservation struct {
+	// (String) The type of the environment variable group, `running` or `staging`.
+	Type *string `json:"type,omitempty"`
+
+	// (List of String) The names of the environment variables in the group. The values are not recorded, as they may contain secrets.
+	VariableNames []string `json:"variableNames,omitempty"`
+
+	// (String) The date and time when the resource was updated in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+type EnvironmentVariableGroupParameters struct {
+	// (String) The type of the environment variable group: `running` for the variables of all running apps and tasks, `staging` for the variables of all app stagings.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=running;staging
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type string `json:"type,omitempty"`
+
+	// (Map of String) The environment variables of the group. They take precedence over the variables of `variablesFrom`.
+	// +kubebuilder:validation:Optional
+	Variables map[string]string `json:"variables,omitempty"`
+
+	// (Attributes List) Secrets and ConfigMaps whose keys and values are set as environment variables of the group. Later entries take precedence over earlier ones.
+	// +kubebuilder:validation:Optional
+	VariablesFrom []EnvironmentVariableSource `json:"variablesFrom,omitempty"`
+}
+
+// An EnvironmentVariableSource selects a Secret or a ConfigMap whose keys and
+// values are set as environment variables.
+// +kubebuilder:validation:XValidation:rule="has(self.secretRef) != has(self.configMapRef)",message="exactly one of secretRef or configMapRef must be set"
+type EnvironmentVariableSource struct {
+	// (Attributes) Reference to a Secret whose keys and values are set as environment variables.
+	// +kubebuilder:validation:Optional
+	SecretRef *v1.SecretReference `json:"secretRef,omitempty"`
+
+	// (Attributes) Reference to a ConfigMap whose keys and values are set as environment variables.
+	// +kubebuilder:validation:Optional
+	ConfigMapRef *ConfigMapReference `json:"configMapRef,omitempty"`
+}
+
+// A ConfigMapReference is a reference to a ConfigMap in an arbitrary namespace.
+type ConfigMapReference struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+}
+
+// EnvironmentVariableGroupSpec defines the desired state of EnvironmentVariableGroup
+type EnvironmentVariableGroupSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     EnvironmentVariableGroupParameters `json:"forProvider"`
+}
+
+// EnvironmentVariableGroupStatus defines the observed state of EnvironmentVariableGroup.
+type EnvironmentVariableGroupStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        EnvironmentVariableGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// EnvironmentVariableGroup is the Schema for the EnvironmentVariableGroups API. Provides a Cloud Foundry resource for managing the running or staging environment variable group of the foundation. The resource replaces all variables of the group and reverts changes made outside of Crossplane. Deleting the resource removes all variables from the group, unless the deletion policy is `Orphan`.
+//
+// External-Name Configuration:
+//   - Follows Standard: no (uses the type of the group, not a GUID)
+//   - Format: Group type, `running` or `staging`
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf running-environment-variable-group` or `cf staging-environment-variable-group`
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.type)",message="type is required"
+type EnvironmentVariableGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              EnvironmentVariableGroupSpec   `json:"spec"`
+	Status            EnvironmentVariableGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentVariableGroupList contains a list of EnvironmentVariableGroups
+type EnvironmentVariableGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnvironmentVariableGroup `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	EnvironmentVariableGroup_Kind             = "EnvironmentVariableGroup"
+	EnvironmentVariableGroup_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: EnvironmentVariableGroup_Kind}.String()
+	EnvironmentVariableGroup_KindAPIVersion   = EnvironmentVariableGroup_Kind + "." + CRDGroupVersion.String()
+	EnvironmentVariableGroup_GroupVersionKind = CRDGroupVersion.WithKind(EnvironmentVariableGroup_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EnvironmentVariableGroup{}, &EnvironmentVariableGroupList{})
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+type FeatureFlagObservation struct {
+	// (String) The name of the feature flag.
+	Name *string `json:"name,omitempty"`
+
+	// (Boolean) Whether the feature flag is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// (String) The error message returned by the API when a user performs an action disabled by the feature flag.
+	CustomErrorMessage *string `json:"customErrorMessage,omitempty"`
+
+	// (String) The date and time when the resource was updated in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+type FeatureFlagParameters struct {
+	// (String) The name of the feature flag; see [Feature flags](https://v3-apidocs.cloudfoundry.org/version/3.154.0/index.html#list-of-feature-flags).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9_]+$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name,omitempty"`
+
+	// (Boolean) Whether the feature flag is enabled.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// (String) The error message returned by the API when a user performs an action disabled by the feature flag. If not set, the message is left unchanged.
+	// +kubebuilder:validation:Optional
+	CustomErrorMessage *string `json:"customErrorMessage,omitempty"`
+}
+
+// FeatureFlagSpec defines the desired state of FeatureFlag
+type FeatureFlagSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     FeatureFlagParameters `json:"forProvider"`
+}
+
+// FeatureFlagStatus defines the observed state of FeatureFlag.
+type FeatureFlagStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        FeatureFlagObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// FeatureFlag is the Schema for the FeatureFlags API. Provides a Cloud Foundry resource for managing a foundation-wide feature flag. Deleting the resource resets the feature flag to its default, unless the deletion policy is `Orphan`.
+//
+// External-Name Configuration:
+//   - Follows Standard: no (uses the name of the feature flag, not a GUID)
+//   - Format: Feature flag name, e.g. `diego_docker`
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf feature-flags` (field: features)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.enabled)",message="enabled is required"
+type FeatureFlag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              FeatureFlagSpec   `json:"spec"`
+	Status            FeatureFlagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FeatureFlagList contains a list of FeatureFlags
+type FeatureFlagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FeatureFlag `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	FeatureFlag_Kind             = "FeatureFlag"
+	FeatureFlag_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: FeatureFlag_Kind}.String()
+	FeatureFlag_KindAPIVersion   = FeatureFlag_Kind + "." + CRDGroupVersion.String()
+	FeatureFlag_GroupVersionKind = CRDGroupVersion.WithKind(FeatureFlag_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FeatureFlag{}, &FeatureFlagList{})
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+type IsolationSegmentObservation struct {
+	// (String) The GUID of the object.
+	ID *string `json:"id,omitempty"`
+
+	// (String) The name of the isolation segment.
+	Name *string `json:"name,omitempty"`
+
+	// (Set of String) GUIDs of the organizations entitled to the isolation segment.
+	// +listType=set
+	Orgs []*string `json:"orgs,omitempty"`
+
+	// (String) The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	CreatedAt *string `json:"createdAt,omitempty"`
+
+	// (String) The date and time when the resource was updated in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+
+	// (Attributes) The metadata associated with the Cloud Foundry resource.
+	ResourceMetadata `json:",inline"`
+}
+
+type IsolationSegmentParameters struct {
+	// (String) The name of the isolation segment; must match the placement tag of the Diego cells of the segment.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+
+	// (Set of String) GUIDs of the organizations entitled to the isolation segment. Organizations that are not listed are revoked. This field is typically populated using references specified in `orgsRefs` or `orgsSelector`.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=github.com/SAP/crossplane-provider-cloudfoundry/apis/resources.ExternalID()
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Orgs []*string `json:"orgs,omitempty"`
+
+	// (Attributes) References to `Organization` CRs to retrieve the external GUIDs of the entitled organizations.
+	// +kubebuilder:validation:Optional
+	OrgsRefs []v1.Reference `json:"orgsRefs,omitempty"`
+
+	// (Attributes) Selector for `Organization` CRs to retrieve the external GUIDs of the entitled organizations.
+	// +kubebuilder:validation:Optional
+	OrgsSelector *v1.Selector `json:"orgsSelector,omitempty"`
+
+	// (Attributes) The metadata associated with the Cloud Foundry resource.
+	// +kubebuilder:validation:Optional
+	ResourceMetadata `json:",inline"`
+}
+
+// IsolationSegmentSpec defines the desired state of IsolationSegment
+type IsolationSegmentSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     IsolationSegmentParameters `json:"forProvider"`
+}
+
+// IsolationSegmentStatus defines the observed state of IsolationSegment.
+type IsolationSegmentStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        IsolationSegmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// IsolationSegment is the Schema for the IsolationSegments API. Provides a Cloud Foundry resource for managing isolation segments and their entitlements to organizations.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Isolation Segment GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/isolation_segments?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+type IsolationSegment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              IsolationSegmentSpec   `json:"spec"`
+	Status            IsolationSegmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IsolationSegmentList contains a list of IsolationSegments
+type IsolationSegmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IsolationSegment `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	IsolationSegment_Kind             = "IsolationSegment"
+	IsolationSegment_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: IsolationSegment_Kind}.String()
+	IsolationSegment_KindAPIVersion   = IsolationSegment_Kind + "." + CRDGroupVersion.String()
+	IsolationSegment_GroupVersionKind = CRDGroupVersion.WithKind(IsolationSegment_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&IsolationSegment{}, &IsolationSegmentList{})
+}
+
+// GetID returns the ID of the isolation segment
+func (s *IsolationSegment) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
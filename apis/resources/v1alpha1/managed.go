@@ -0,0 +1,555 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The managed resources of this package have namespaced counterparts in the
+// m.cloudfoundry.crossplane.io group, which share their parameters and
+// observations. The following interfaces are implemented by both, so that a
+// controller can reconcile either of them.
+
+// AppManaged is a cluster scoped or namespaced App.
+// +kubebuilder:object:generate=false
+type AppManaged interface {
+	resource.Managed
+
+	GetForProvider() *AppParameters
+	GetAtProvider() *AppObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// BuildpackManaged is a cluster scoped or namespaced Buildpack.
+// +kubebuilder:object:generate=false
+type BuildpackManaged interface {
+	resource.Managed
+
+	GetForProvider() *BuildpackParameters
+	GetAtProvider() *BuildpackObservation
+}
+
+// DomainManaged is a cluster scoped or namespaced Domain.
+// +kubebuilder:object:generate=false
+type DomainManaged interface {
+	resource.Managed
+
+	GetForProvider() *DomainParameters
+	GetAtProvider() *DomainObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// EnvironmentVariableGroupManaged is a cluster scoped or namespaced
+// EnvironmentVariableGroup.
+// +kubebuilder:object:generate=false
+type EnvironmentVariableGroupManaged interface {
+	resource.Managed
+
+	GetForProvider() *EnvironmentVariableGroupParameters
+	GetAtProvider() *EnvironmentVariableGroupObservation
+}
+
+// FeatureFlagManaged is a cluster scoped or namespaced FeatureFlag.
+// +kubebuilder:object:generate=false
+type FeatureFlagManaged interface {
+	resource.Managed
+
+	GetForProvider() *FeatureFlagParameters
+	GetAtProvider() *FeatureFlagObservation
+}
+
+// IsolationSegmentManaged is a cluster scoped or namespaced IsolationSegment.
+// +kubebuilder:object:generate=false
+type IsolationSegmentManaged interface {
+	resource.Managed
+
+	GetForProvider() *IsolationSegmentParameters
+	GetAtProvider() *IsolationSegmentObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// NetworkPolicyManaged is a cluster scoped or namespaced NetworkPolicy.
+// +kubebuilder:object:generate=false
+type NetworkPolicyManaged interface {
+	resource.Managed
+
+	GetForProvider() *NetworkPolicyParameters
+	GetAtProvider() *NetworkPolicyObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// OrgMembersManaged is a cluster scoped or namespaced OrgMembers.
+// +kubebuilder:object:generate=false
+type OrgMembersManaged interface {
+	resource.Managed
+
+	GetForProvider() *OrgMembersParameters
+	GetAtProvider() *RoleAssignments
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// OrgQuotaManaged is a cluster scoped or namespaced OrgQuota.
+// +kubebuilder:object:generate=false
+type OrgQuotaManaged interface {
+	resource.Managed
+
+	GetForProvider() *OrgQuotaParameters
+	GetAtProvider() *OrgQuotaObservation
+	GetInitProvider() *OrgQuotaInitParameters
+}
+
+// OrgRoleManaged is a cluster scoped or namespaced OrgRole.
+// +kubebuilder:object:generate=false
+type OrgRoleManaged interface {
+	resource.Managed
+
+	GetForProvider() *OrgRoleParameters
+	GetAtProvider() *OrgRoleObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// OrganizationManaged is a cluster scoped or namespaced Organization.
+// +kubebuilder:object:generate=false
+type OrganizationManaged interface {
+	resource.Managed
+
+	GetForProvider() *OrgParameters
+	GetAtProvider() *OrgObservation
+}
+
+// RouteManaged is a cluster scoped or namespaced Route.
+// +kubebuilder:object:generate=false
+type RouteManaged interface {
+	resource.Managed
+
+	GetForProvider() *RouteParameters
+	GetAtProvider() *RouteObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// SecurityGroupManaged is a cluster scoped or namespaced SecurityGroup.
+// +kubebuilder:object:generate=false
+type SecurityGroupManaged interface {
+	resource.Managed
+
+	GetForProvider() *SecurityGroupParameters
+	GetAtProvider() *SecurityGroupObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// ServiceBrokerManaged is a cluster scoped or namespaced ServiceBroker.
+// +kubebuilder:object:generate=false
+type ServiceBrokerManaged interface {
+	resource.Managed
+
+	GetForProvider() *ServiceBrokerParameters
+	GetAtProvider() *ServiceBrokerObservation
+	GetSpaceRef() *SpaceReference
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// ServiceCredentialBindingManaged is a cluster scoped or namespaced ServiceCredentialBinding.
+// +kubebuilder:object:generate=false
+type ServiceCredentialBindingManaged interface {
+	resource.Managed
+
+	GetForProvider() *ServiceCredentialBindingParameters
+	GetAtProvider() *ServiceCredentialBindingObservation
+	GetConnectionDetailsAsJSON() bool
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// ServiceInstanceManaged is a cluster scoped or namespaced ServiceInstance.
+// +kubebuilder:object:generate=false
+type ServiceInstanceManaged interface {
+	resource.Managed
+
+	GetForProvider() *ServiceInstanceParameters
+	GetAtProvider() *ServiceInstanceObservation
+	GetEnableParameterDriftDetection() bool
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// ServiceOfferingManaged is a cluster scoped or namespaced ServiceOffering.
+// +kubebuilder:object:generate=false
+type ServiceOfferingManaged interface {
+	resource.Managed
+
+	GetForProvider() *ServiceOfferingParameters
+	GetAtProvider() *ServiceOfferingObservation
+}
+
+// ServicePlanManaged is a cluster scoped or namespaced ServicePlan.
+// +kubebuilder:object:generate=false
+type ServicePlanManaged interface {
+	resource.Managed
+
+	GetForProvider() *ServicePlanParameters
+	GetAtProvider() *ServicePlanObservation
+}
+
+// ServicePlanVisibilityManaged is a cluster scoped or namespaced ServicePlanVisibility.
+// +kubebuilder:object:generate=false
+type ServicePlanVisibilityManaged interface {
+	resource.Managed
+
+	GetForProvider() *ServicePlanVisibilityParameters
+	GetAtProvider() *ServicePlanVisibilityObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// ServiceRouteBindingManaged is a cluster scoped or namespaced ServiceRouteBinding.
+// +kubebuilder:object:generate=false
+type ServiceRouteBindingManaged interface {
+	resource.Managed
+
+	GetForProvider() *ServiceRouteBindingParameters
+	GetAtProvider() *ServiceRouteBindingObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// SpaceManaged is a cluster scoped or namespaced Space.
+// +kubebuilder:object:generate=false
+type SpaceManaged interface {
+	resource.Managed
+
+	GetForProvider() *SpaceParameters
+	GetAtProvider() *SpaceObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// SpaceMembersManaged is a cluster scoped or namespaced SpaceMembers.
+// +kubebuilder:object:generate=false
+type SpaceMembersManaged interface {
+	resource.Managed
+
+	GetForProvider() *SpaceMembersParameters
+	GetAtProvider() *RoleAssignments
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// SpaceQuotaManaged is a cluster scoped or namespaced SpaceQuota.
+// +kubebuilder:object:generate=false
+type SpaceQuotaManaged interface {
+	resource.Managed
+
+	GetForProvider() *SpaceQuotaParameters
+	GetAtProvider() *SpaceQuotaObservation
+	GetInitProvider() *SpaceQuotaInitParameters
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// SpaceRoleManaged is a cluster scoped or namespaced SpaceRole.
+// +kubebuilder:object:generate=false
+type SpaceRoleManaged interface {
+	resource.Managed
+
+	GetForProvider() *SpaceRoleParameters
+	GetAtProvider() *SpaceRoleObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// TaskManaged is a cluster scoped or namespaced Task.
+// +kubebuilder:object:generate=false
+type TaskManaged interface {
+	resource.Managed
+
+	GetForProvider() *TaskParameters
+	GetAtProvider() *TaskObservation
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// UserManaged is a cluster scoped or namespaced User.
+// +kubebuilder:object:generate=false
+type UserManaged interface {
+	resource.Managed
+
+	GetForProvider() *UserParameters
+	GetAtProvider() *UserObservation
+}
+
+// GetForProvider returns the desired state of the App.
+func (mg *App) GetForProvider() *AppParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the App.
+func (mg *App) GetAtProvider() *AppObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the Buildpack.
+func (mg *Buildpack) GetForProvider() *BuildpackParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Buildpack.
+func (mg *Buildpack) GetAtProvider() *BuildpackObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the Domain.
+func (mg *Domain) GetForProvider() *DomainParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Domain.
+func (mg *Domain) GetAtProvider() *DomainObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the EnvironmentVariableGroup.
+func (mg *EnvironmentVariableGroup) GetForProvider() *EnvironmentVariableGroupParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the EnvironmentVariableGroup.
+func (mg *EnvironmentVariableGroup) GetAtProvider() *EnvironmentVariableGroupObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the FeatureFlag.
+func (mg *FeatureFlag) GetForProvider() *FeatureFlagParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the FeatureFlag.
+func (mg *FeatureFlag) GetAtProvider() *FeatureFlagObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the IsolationSegment.
+func (mg *IsolationSegment) GetForProvider() *IsolationSegmentParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the IsolationSegment.
+func (mg *IsolationSegment) GetAtProvider() *IsolationSegmentObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the NetworkPolicy.
+func (mg *NetworkPolicy) GetForProvider() *NetworkPolicyParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the NetworkPolicy.
+func (mg *NetworkPolicy) GetAtProvider() *NetworkPolicyObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the OrgMembers.
+func (mg *OrgMembers) GetForProvider() *OrgMembersParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the OrgMembers.
+func (mg *OrgMembers) GetAtProvider() *RoleAssignments {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the OrgQuota.
+func (mg *OrgQuota) GetForProvider() *OrgQuotaParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the OrgQuota.
+func (mg *OrgQuota) GetAtProvider() *OrgQuotaObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetInitProvider returns the state of the OrgQuota that is only applied when it is created.
+func (mg *OrgQuota) GetInitProvider() *OrgQuotaInitParameters {
+	return &mg.Spec.InitProvider
+}
+
+// GetForProvider returns the desired state of the OrgRole.
+func (mg *OrgRole) GetForProvider() *OrgRoleParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the OrgRole.
+func (mg *OrgRole) GetAtProvider() *OrgRoleObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the Organization.
+func (mg *Organization) GetForProvider() *OrgParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Organization.
+func (mg *Organization) GetAtProvider() *OrgObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the Route.
+func (mg *Route) GetForProvider() *RouteParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Route.
+func (mg *Route) GetAtProvider() *RouteObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the SecurityGroup.
+func (mg *SecurityGroup) GetForProvider() *SecurityGroupParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the SecurityGroup.
+func (mg *SecurityGroup) GetAtProvider() *SecurityGroupObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the ServiceBroker.
+func (mg *ServiceBroker) GetForProvider() *ServiceBrokerParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServiceBroker.
+func (mg *ServiceBroker) GetAtProvider() *ServiceBrokerObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the ServiceCredentialBinding.
+func (mg *ServiceCredentialBinding) GetForProvider() *ServiceCredentialBindingParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServiceCredentialBinding.
+func (mg *ServiceCredentialBinding) GetAtProvider() *ServiceCredentialBindingObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetConnectionDetailsAsJSON returns whether the connection details of the ServiceCredentialBinding are written as a single JSON value.
+func (mg *ServiceCredentialBinding) GetConnectionDetailsAsJSON() bool {
+	return mg.Spec.ConnectionDetailsAsJSON
+}
+
+// GetForProvider returns the desired state of the ServiceInstance.
+func (mg *ServiceInstance) GetForProvider() *ServiceInstanceParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServiceInstance.
+func (mg *ServiceInstance) GetAtProvider() *ServiceInstanceObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetEnableParameterDriftDetection returns whether drift of the parameters of the ServiceInstance is detected.
+func (mg *ServiceInstance) GetEnableParameterDriftDetection() bool {
+	return mg.Spec.EnableParameterDriftDetection
+}
+
+// GetForProvider returns the desired state of the ServiceOffering.
+func (mg *ServiceOffering) GetForProvider() *ServiceOfferingParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServiceOffering.
+func (mg *ServiceOffering) GetAtProvider() *ServiceOfferingObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the ServicePlan.
+func (mg *ServicePlan) GetForProvider() *ServicePlanParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServicePlan.
+func (mg *ServicePlan) GetAtProvider() *ServicePlanObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the ServicePlanVisibility.
+func (mg *ServicePlanVisibility) GetForProvider() *ServicePlanVisibilityParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServicePlanVisibility.
+func (mg *ServicePlanVisibility) GetAtProvider() *ServicePlanVisibilityObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the ServiceRouteBinding.
+func (mg *ServiceRouteBinding) GetForProvider() *ServiceRouteBindingParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the ServiceRouteBinding.
+func (mg *ServiceRouteBinding) GetAtProvider() *ServiceRouteBindingObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the Space.
+func (mg *Space) GetForProvider() *SpaceParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Space.
+func (mg *Space) GetAtProvider() *SpaceObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the SpaceMembers.
+func (mg *SpaceMembers) GetForProvider() *SpaceMembersParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the SpaceMembers.
+func (mg *SpaceMembers) GetAtProvider() *RoleAssignments {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the SpaceQuota.
+func (mg *SpaceQuota) GetForProvider() *SpaceQuotaParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the SpaceQuota.
+func (mg *SpaceQuota) GetAtProvider() *SpaceQuotaObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetInitProvider returns the state of the SpaceQuota that is only applied when it is created.
+func (mg *SpaceQuota) GetInitProvider() *SpaceQuotaInitParameters {
+	return &mg.Spec.InitProvider
+}
+
+// GetForProvider returns the desired state of the SpaceRole.
+func (mg *SpaceRole) GetForProvider() *SpaceRoleParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the SpaceRole.
+func (mg *SpaceRole) GetAtProvider() *SpaceRoleObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the Task.
+func (mg *Task) GetForProvider() *TaskParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the Task.
+func (mg *Task) GetAtProvider() *TaskObservation {
+	return &mg.Status.AtProvider
+}
+
+// GetForProvider returns the desired state of the User.
+func (mg *User) GetForProvider() *UserParameters {
+	return &mg.Spec.ForProvider
+}
+
+// GetAtProvider returns the observed state of the User.
+func (mg *User) GetAtProvider() *UserObservation {
+	return &mg.Status.AtProvider
+}
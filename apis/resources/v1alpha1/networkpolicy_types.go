@@ -0,0 +1,145 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// NetworkPolicyPorts is a range of ports.
+// +kubebuilder:validation:XValidation:rule="self.end >= self.start",message="end must not be lower than start"
+type NetworkPolicyPorts struct {
+	// (Number) The first port of the range.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Start int `json:"start"`
+
+	// (Number) The last port of the range. Set it to `start` to allow a single port.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	End int `json:"end"`
+}
+
+type NetworkPolicyObservation struct {
+	// (String) The GUID of the source app.
+	Source *string `json:"source,omitempty"`
+
+	// (String) The GUID of the destination app.
+	Destination *string `json:"destination,omitempty"`
+
+	// (String) The protocol of the allowed traffic.
+	Protocol *string `json:"protocol,omitempty"`
+
+	// (Attributes) The ports of the destination app the traffic is allowed to.
+	Ports *NetworkPolicyPorts `json:"ports,omitempty"`
+}
+
+type NetworkPolicyParameters struct {
+	// (String) The GUID of the app that sends the traffic.
+	// +crossplane:generate:reference:type=App
+	// +crossplane:generate:reference:refFieldName=SourceRef
+	// +crossplane:generate:reference:selectorFieldName=SourceSelector
+	// +kubebuilder:validation:Optional
+	Source *string `json:"source,omitempty"`
+
+	// (Attributes) Reference to an app CR to populate `source`.
+	// +kubebuilder:validation:Optional
+	SourceRef *v1.Reference `json:"sourceRef,omitempty"`
+
+	// (Attributes) Selector for an app CR to populate `source`.
+	// +kubebuilder:validation:Optional
+	SourceSelector *v1.Selector `json:"sourceSelector,omitempty"`
+
+	// (String) The GUID of the app that receives the traffic.
+	// +crossplane:generate:reference:type=App
+	// +crossplane:generate:reference:refFieldName=DestinationRef
+	// +crossplane:generate:reference:selectorFieldName=DestinationSelector
+	// +kubebuilder:validation:Optional
+	Destination *string `json:"destination,omitempty"`
+
+	// (Attributes) Reference to an app CR to populate `destination`.
+	// +kubebuilder:validation:Optional
+	DestinationRef *v1.Reference `json:"destinationRef,omitempty"`
+
+	// (Attributes) Selector for an app CR to populate `destination`.
+	// +kubebuilder:validation:Optional
+	DestinationSelector *v1.Selector `json:"destinationSelector,omitempty"`
+
+	// (String) The protocol of the allowed traffic; valid values are `tcp` and `udp`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=tcp;udp
+	// +kubebuilder:default=tcp
+	Protocol string `json:"protocol,omitempty"`
+
+	// (Attributes) The ports of the destination app the traffic is allowed to.
+	// +kubebuilder:validation:Optional
+	Ports *NetworkPolicyPorts `json:"ports,omitempty"`
+}
+
+// NetworkPolicySpec defines the desired state of NetworkPolicy
+type NetworkPolicySpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     NetworkPolicyParameters `json:"forProvider"`
+}
+
+// NetworkPolicyStatus defines the observed state of NetworkPolicy.
+type NetworkPolicyStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        NetworkPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// NetworkPolicy is the Schema for the NetworkPolicies API. Provides a Cloud Foundry resource for allowing container-to-container traffic from a source app to a destination app, like `cf add-network-policy`. Changing the parameters replaces the policy.
+//
+// External-Name Configuration:
+//   - Follows Standard: no (uses compound key `<source-guid>/<destination-guid>/<protocol>/<start>-<end>`, as a policy has no GUID)
+//   - Format: `<source-guid>/<destination-guid>/<protocol>/<start>-<end>`, e.g. `<source-guid>/<destination-guid>/tcp/8080-8080`
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf network-policies` combined with `cf app <APP_NAME> --guid` for the source and destination
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.ports)",message="ports is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.source) || has(self.spec.forProvider.sourceRef) || has(self.spec.forProvider.sourceSelector))",message="SourceReference is required: exactly one of source, sourceRef, or sourceSelector must be set"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.destination) || has(self.spec.forProvider.destinationRef) || has(self.spec.forProvider.destinationSelector))",message="DestinationReference is required: exactly one of destination, destinationRef, or destinationSelector must be set"
+type NetworkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              NetworkPolicySpec   `json:"spec"`
+	Status            NetworkPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkPolicyList contains a list of NetworkPolicies
+type NetworkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkPolicy `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	NetworkPolicy_Kind             = "NetworkPolicy"
+	NetworkPolicy_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: NetworkPolicy_Kind}.String()
+	NetworkPolicy_KindAPIVersion   = NetworkPolicy_Kind + "." + CRDGroupVersion.String()
+	NetworkPolicy_GroupVersionKind = CRDGroupVersion.WithKind(NetworkPolicy_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NetworkPolicy{}, &NetworkPolicyList{})
+}
@@ -43,12 +43,25 @@ type OrgRoleParameters struct {
 	// +kubebuilder:validation:Enum=User;Auditor;Manager;BillingManager;Users;Auditors;Managers;BillingManagers
 	Type string `json:"type,omitempty" tf:"type,omitempty"`
 
-	// (String) The identity provider for the UAA user.
+	// (String) The identity provider for the UAA user. This field is populated from the `User` CR specified in `userRef` or `userSelector` if not set.
 	// +kubebuilder:validation:Optional
 	Origin *string `json:"origin,omitempty" tf:"origin,omitempty"`
 
-	// (String) The username of the Cloud Foundry user to assign the role to.
+	// (String) The username of the Cloud Foundry user to assign the role to. This field is typically populated using references specified in `userRef` or `userSelector`.
+	// +crossplane:generate:reference:type=User
+	// +crossplane:generate:reference:extractor=github.com/SAP/crossplane-provider-cloudfoundry/apis/resources.CloudFoundryName()
+	// +crossplane:generate:reference:refFieldName=UserRef
+	// +crossplane:generate:reference:selectorFieldName=UserSelector
+	// +kubebuilder:validation:Optional
 	Username string `json:"username,omitempty" tf:"username,omitempty"`
+
+	// (Attributes) Reference to a `User` CR to retrieve the username and origin of the Cloud Foundry user. The role is assigned once the user exists.
+	// +kubebuilder:validation:Optional
+	UserRef *v1.Reference `json:"userRef,omitempty"`
+
+	// (Attributes) Selector for a `User` CR to retrieve the username and origin of the Cloud Foundry user.
+	// +kubebuilder:validation:Optional
+	UserSelector *v1.Selector `json:"userSelector,omitempty"`
 }
 
 // OrgRoleSpec defines the desired state of OrgRole
@@ -82,7 +95,7 @@ type OrgRoleStatus struct {
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
 // +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.type)",message="type is required"
-// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.username)",message="username is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.username) || has(self.spec.forProvider.userRef) || has(self.spec.forProvider.userSelector))",message="username is required: set username, userRef or userSelector"
 // +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.orgName) || has(self.spec.forProvider.orgRef) || has(self.spec.forProvider.orgSelector))",message="OrgReference is required: exactly one of orgName, orgRef, or orgSelector must be set"
 type OrgRole struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -34,6 +34,10 @@ type RouteObservation struct {
 	// +kubebuilder:validation:Optional
 	Destinations []RouteDestination `json:"destinations,omitempty"`
 
+	// (String) The GUID of the space that owns the route.
+	// +kubebuilder:validation:Optional
+	Space *string `json:"space,omitempty"`
+
 	ResourceMetadata `json:",inline"`
 }
 
@@ -58,6 +62,10 @@ type RouteParameters struct {
 	// +kubebuilder:validation:Optional
 	Options *RouteOptions `json:"options,omitempty"`
 
+	// (List of SpaceReference) List of references to Cloud Foundry spaces the route will be shared with. Apps of a shared space can be mapped to the route. If set, spaces not in the list are unshared; if omitted, the shared spaces are not managed.
+	// +kubebuilder:validation:Optional
+	SharedSpaces []SpaceReference `json:"sharedSpaces,omitempty"`
+
 	ResourceMetadata `json:",inline"`
 }
 
@@ -0,0 +1,171 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// SecurityGroupRule is an egress rule of a security group.
+type SecurityGroupRule struct {
+	// (String) The protocol of the rule.
+	// +kubebuilder:validation:Enum=tcp;udp;icmp;all
+	Protocol string `json:"protocol"`
+
+	// (String) The destination of the rule as a single IP address, an IP address range such as `10.0.0.1-10.0.0.9` or a CIDR block such as `10.0.0.0/24`.
+	Destination string `json:"destination"`
+
+	// (String) The ports of the rule as a single port, a comma separated list or a range such as `8080-8089`. Only used for `tcp` and `udp`.
+	// +kubebuilder:validation:Optional
+	Ports *string `json:"ports,omitempty"`
+
+	// (Number) The ICMP type. Only used for `icmp`.
+	// +kubebuilder:validation:Optional
+	Type *int `json:"type,omitempty"`
+
+	// (Number) The ICMP code. Only used for `icmp`.
+	// +kubebuilder:validation:Optional
+	Code *int `json:"code,omitempty"`
+
+	// (Boolean) Enables logging of the egress traffic matching the rule. Only used for `tcp`.
+	// +kubebuilder:validation:Optional
+	Log *bool `json:"log,omitempty"`
+
+	// (String) A description of the rule.
+	// +kubebuilder:validation:Optional
+	Description *string `json:"description,omitempty"`
+}
+
+// SecurityGroupGloballyEnabled controls whether a security group applies to
+// all applications of the Cloud Foundry installation.
+type SecurityGroupGloballyEnabled struct {
+	// (Boolean) Applies the security group to all running applications.
+	// +kubebuilder:validation:Optional
+	Running bool `json:"running,omitempty"`
+
+	// (Boolean) Applies the security group to all staging applications.
+	// +kubebuilder:validation:Optional
+	Staging bool `json:"staging,omitempty"`
+}
+
+type SecurityGroupObservation struct {
+	// (String) The GUID of the object.
+	ID *string `json:"id,omitempty"`
+
+	// (String) The name of the security group.
+	Name *string `json:"name,omitempty"`
+
+	// (List of Attributes) The egress rules of the security group.
+	Rules []SecurityGroupRule `json:"rules,omitempty"`
+
+	// (Attributes) Whether the security group applies to all running or staging applications.
+	GloballyEnabled SecurityGroupGloballyEnabled `json:"globallyEnabled,omitempty"`
+
+	// (Set of String) GUIDs of the spaces the security group is bound to for running applications.
+	// +listType=set
+	RunningSpaces []string `json:"runningSpaces,omitempty"`
+
+	// (Set of String) GUIDs of the spaces the security group is bound to for staging applications.
+	// +listType=set
+	StagingSpaces []string `json:"stagingSpaces,omitempty"`
+
+	// (String) The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	CreatedAt *string `json:"createdAt,omitempty"`
+
+	// (String) The date and time when the resource was updated in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+type SecurityGroupParameters struct {
+	// (String) The name of the security group.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+
+	// (List of Attributes) The egress rules of the security group.
+	// +kubebuilder:validation:Optional
+	Rules []SecurityGroupRule `json:"rules,omitempty"`
+
+	// (Attributes) Whether the security group applies to all running or staging applications.
+	// +kubebuilder:validation:Optional
+	GloballyEnabled SecurityGroupGloballyEnabled `json:"globallyEnabled,omitempty"`
+
+	// (List of SpaceReference) Spaces to bind the security group to for running applications. Spaces that are not listed are unbound.
+	// +kubebuilder:validation:Optional
+	RunningSpaces []SpaceReference `json:"runningSpaces,omitempty"`
+
+	// (List of SpaceReference) Spaces to bind the security group to for staging applications. Spaces that are not listed are unbound.
+	// +kubebuilder:validation:Optional
+	StagingSpaces []SpaceReference `json:"stagingSpaces,omitempty"`
+}
+
+// SecurityGroupSpec defines the desired state of SecurityGroup
+type SecurityGroupSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     SecurityGroupParameters `json:"forProvider"`
+}
+
+// SecurityGroupStatus defines the observed state of SecurityGroup.
+type SecurityGroupStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        SecurityGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// SecurityGroup is the Schema for the SecurityGroups API. Provides a Cloud Foundry resource for managing application security groups and their bindings to spaces.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Security Group GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/security_groups?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+type SecurityGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              SecurityGroupSpec   `json:"spec"`
+	Status            SecurityGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityGroupList contains a list of SecurityGroups
+type SecurityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityGroup `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	SecurityGroup_Kind             = "SecurityGroup"
+	SecurityGroup_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SecurityGroup_Kind}.String()
+	SecurityGroup_KindAPIVersion   = SecurityGroup_Kind + "." + CRDGroupVersion.String()
+	SecurityGroup_GroupVersionKind = CRDGroupVersion.WithKind(SecurityGroup_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SecurityGroup{}, &SecurityGroupList{})
+}
+
+// GetID returns the ID of the security group
+func (s *SecurityGroup) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
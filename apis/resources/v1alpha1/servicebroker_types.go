@@ -0,0 +1,157 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// ServiceBrokerJob is the asynchronous job of the last create or update of a
+// service broker, which synchronizes the catalog of the broker.
+type ServiceBrokerJob struct {
+	// (String) The GUID of the job.
+	GUID string `json:"guid,omitempty"`
+
+	// (String) The operation of the job, e.g. `service_broker.catalog.synchronize`.
+	Operation string `json:"operation,omitempty"`
+
+	// (String) The state of the job: `PROCESSING`, `POLLING`, `COMPLETE` or `FAILED`.
+	State string `json:"state,omitempty"`
+
+	// (String) The errors reported by a failed job.
+	Errors string `json:"errors,omitempty"`
+}
+
+type ServiceBrokerObservation struct {
+	// (String) The GUID of the object.
+	ID *string `json:"id,omitempty"`
+
+	// (String) The name of the service broker.
+	Name *string `json:"name,omitempty"`
+
+	// (String) The URL of the service broker.
+	URL *string `json:"url,omitempty"`
+
+	// (String) The GUID of the space the service broker is scoped to. Empty for a global service broker.
+	Space *string `json:"space,omitempty"`
+
+	// (Number) The number of service offerings in the synchronized catalog of the service broker.
+	ServiceOfferings int `json:"serviceOfferings,omitempty"`
+
+	// (Number) The number of service plans in the synchronized catalog of the service broker.
+	ServicePlans int `json:"servicePlans,omitempty"`
+
+	// (Attributes) The asynchronous job of the last create or update of the service broker.
+	LastJob *ServiceBrokerJob `json:"lastJob,omitempty"`
+
+	// (String) The UID and resource version of the Secret with the credentials the service broker was last created or updated with. The credentials cannot be read back from Cloud Foundry, so rotated credentials in the Secret are detected by this version.
+	CredentialsVersion *string `json:"credentialsVersion,omitempty"`
+
+	// (String) The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	CreatedAt *string `json:"createdAt,omitempty"`
+
+	// (String) The date and time when the resource was updated in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+
+	// (Attributes) The metadata associated with the Cloud Foundry resource.
+	ResourceMetadata `json:",inline"`
+}
+
+type ServiceBrokerParameters struct {
+	// (String) The name of the service broker.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+
+	// (String) The URL of the service broker.
+	// +kubebuilder:validation:Optional
+	URL string `json:"url,omitempty"`
+
+	// (Attributes) Reference to a Secret with the basic authentication credentials of the service broker in the keys `username` and `password`.
+	// +kubebuilder:validation:Required
+	CredentialsSecretRef *v1.SecretReference `json:"credentialsSecretRef"`
+
+	// (Attributes) The space to scope the service broker to. A space-scoped service broker only offers its services in that space. Leave empty for a global service broker, which requires admin permissions. The scope cannot be changed after creation.
+	SpaceReference `json:",inline"`
+
+	// (Attributes) The metadata associated with the Cloud Foundry resource.
+	// +kubebuilder:validation:Optional
+	ResourceMetadata `json:",inline"`
+}
+
+// ServiceBrokerSpec defines the desired state of ServiceBroker
+type ServiceBrokerSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     ServiceBrokerParameters `json:"forProvider"`
+}
+
+// ServiceBrokerStatus defines the observed state of ServiceBroker.
+type ServiceBrokerStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        ServiceBrokerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ServiceBroker is the Schema for the ServiceBrokers API. Provides a Cloud Foundry resource for registering global or space-scoped service brokers.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Service Broker GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/service_brokers?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.name)",message="name is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.url)",message="url is required"
+type ServiceBroker struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ServiceBrokerSpec   `json:"spec"`
+	Status            ServiceBrokerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceBrokerList contains a list of ServiceBrokers
+type ServiceBrokerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceBroker `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServiceBroker_Kind             = "ServiceBroker"
+	ServiceBroker_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceBroker_Kind}.String()
+	ServiceBroker_KindAPIVersion   = ServiceBroker_Kind + "." + CRDGroupVersion.String()
+	ServiceBroker_GroupVersionKind = CRDGroupVersion.WithKind(ServiceBroker_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceBroker{}, &ServiceBrokerList{})
+}
+
+// GetID returns the ID of the service broker
+func (s *ServiceBroker) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
+
+// GetSpaceRef returns the reference to the space
+func (s *ServiceBroker) GetSpaceRef() *SpaceReference {
+	return &s.Spec.ForProvider.SpaceReference
+}
@@ -67,6 +67,14 @@ type Managed struct {
 	// +kubebuilder:validation:Optional
 	ServicePlan *ServicePlanParameters `json:"servicePlan,omitempty"`
 
+	// (Attributes) Reference to a `ServicePlan` CR to retrieve the ID of the service plan.
+	// +kubebuilder:validation:Optional
+	ServicePlanRef *v1.Reference `json:"servicePlanRef,omitempty"`
+
+	// (Attributes) Selector for a `ServicePlan` CR to retrieve the ID of the service plan.
+	// +kubebuilder:validation:Optional
+	ServicePlanSelector *v1.Selector `json:"servicePlanSelector,omitempty"`
+
 	// (Attributes) Configuration parameters for the managed service instance, supplied as a K8S runtime.RawExtension object.
 	//
 	// Default parameters applied by the BTP cockpit may differ from those
@@ -189,9 +197,9 @@ type MaintenanceInfo struct {
 	Version *string `json:"version,omitempty" tf:"version,omitempty"`
 }
 
-// ServicePlanParameters defines a service plan for a managed service instance.
+// ServicePlanParameters identifies a service plan by ID or by offering and plan name.
 type ServicePlanParameters struct {
-	// (String) The ID of the service plan from which to create the service instance.
+	// (String) The ID of the service plan.
 	// +optional
 	ID *string `json:"id"`
 
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+type ServiceOfferingObservation struct {
+	// (String) The GUID of the service offering.
+	ID *string `json:"id,omitempty"`
+
+	// (String) The name of the service offering.
+	Name *string `json:"name,omitempty"`
+
+	// (String) The description of the service offering.
+	Description *string `json:"description,omitempty"`
+
+	// (Boolean) Whether the service offering is available.
+	Available *bool `json:"available,omitempty"`
+
+	// (List of String) Descriptive tags of the service offering.
+	Tags []string `json:"tags,omitempty"`
+
+	// (List of String) The permissions that a service instance of the offering requires; one of `syslog_drain`, `route_forwarding` or `volume_mount`.
+	Requires []string `json:"requires,omitempty"`
+
+	// (Boolean) Whether service instances of the service offering can be shared across organizations and spaces.
+	Shareable *bool `json:"shareable,omitempty"`
+
+	// (String) URL of the documentation of the service offering.
+	DocumentationURL *string `json:"documentationUrl,omitempty"`
+
+	// (String) The identifier of the service offering in the catalog of the service broker.
+	BrokerCatalogID *string `json:"brokerCatalogId,omitempty"`
+
+	// (String) The GUID of the service broker that offers the service.
+	ServiceBroker *string `json:"serviceBroker,omitempty"`
+
+	// (String) The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	CreatedAt *string `json:"createdAt,omitempty"`
+
+	// (String) The date and time when the resource was updated in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+
+	// (Attributes) The metadata associated with the Cloud Foundry resource.
+	ResourceMetadata `json:",inline"`
+}
+
+type ServiceOfferingParameters struct {
+	// (String) The name of the service offering.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// (String) The name of the service broker that offers the service. Required if several service brokers offer a service of the same name.
+	// +kubebuilder:validation:Optional
+	ServiceBrokerName *string `json:"serviceBrokerName,omitempty"`
+}
+
+// ServiceOfferingSpec defines the desired state of ServiceOffering
+type ServiceOfferingSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     ServiceOfferingParameters `json:"forProvider"`
+}
+
+// ServiceOfferingStatus defines the observed state of ServiceOffering.
+type ServiceOfferingStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        ServiceOfferingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ServiceOffering is the Schema for the ServiceOfferings API. Observes a service offering of the marketplace. Service offerings are registered by service brokers and cannot be created, updated or deleted.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Service Offering GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/service_offerings?names=<name>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+type ServiceOffering struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ServiceOfferingSpec   `json:"spec"`
+	Status            ServiceOfferingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceOfferingList contains a list of ServiceOfferings
+type ServiceOfferingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceOffering `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServiceOffering_Kind             = "ServiceOffering"
+	ServiceOffering_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceOffering_Kind}.String()
+	ServiceOffering_KindAPIVersion   = ServiceOffering_Kind + "." + CRDGroupVersion.String()
+	ServiceOffering_GroupVersionKind = CRDGroupVersion.WithKind(ServiceOffering_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceOffering{}, &ServiceOfferingList{})
+}
+
+// GetID returns the ID of the service offering
+func (s *ServiceOffering) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
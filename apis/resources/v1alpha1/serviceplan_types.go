@@ -0,0 +1,152 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// ServicePlanCost is a cost of a service plan as given in the catalog of the
+// service broker.
+type ServicePlanCost struct {
+	// (String) The pricing amount.
+	Amount string `json:"amount"`
+
+	// (String) The currency code of the amount, e.g. `USD`.
+	Currency string `json:"currency"`
+
+	// (String) The display name of the cost type, e.g. `Monthly`.
+	Unit string `json:"unit"`
+}
+
+// ServicePlanSchemas are the JSON schemas of the parameters of service
+// instances and service bindings of a service plan.
+type ServicePlanSchemas struct {
+	// (Attributes) The schema of the parameters to create a service instance.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ServiceInstanceCreate *runtime.RawExtension `json:"serviceInstanceCreate,omitempty"`
+
+	// (Attributes) The schema of the parameters to update a service instance.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ServiceInstanceUpdate *runtime.RawExtension `json:"serviceInstanceUpdate,omitempty"`
+
+	// (Attributes) The schema of the parameters to create a service binding.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ServiceBindingCreate *runtime.RawExtension `json:"serviceBindingCreate,omitempty"`
+}
+
+type ServicePlanObservation struct {
+	// (String) The GUID of the service plan.
+	ID *string `json:"id,omitempty"`
+
+	// (String) The name of the service plan.
+	Name *string `json:"name,omitempty"`
+
+	// (String) The description of the service plan.
+	Description *string `json:"description,omitempty"`
+
+	// (Boolean) Whether the service plan is available.
+	Available *bool `json:"available,omitempty"`
+
+	// (Boolean) Whether the service plan is free of charge.
+	Free *bool `json:"free,omitempty"`
+
+	// (String) The visibility of the service plan; one of `public`, `admin`, `organization` or `space`.
+	VisibilityType *string `json:"visibilityType,omitempty"`
+
+	// (List of Attributes) The costs of the service plan.
+	Costs []ServicePlanCost `json:"costs,omitempty"`
+
+	// (Attributes) Information about the current version of the service plan.
+	MaintenanceInfo MaintenanceInfo `json:"maintenanceInfo,omitempty"`
+
+	// (Attributes) The schemas of the parameters of service instances and service bindings of the service plan.
+	Schemas ServicePlanSchemas `json:"schemas,omitempty"`
+
+	// (String) The identifier of the service plan in the catalog of the service broker.
+	BrokerCatalogID *string `json:"brokerCatalogId,omitempty"`
+
+	// (String) The GUID of the service offering of the service plan.
+	ServiceOffering *string `json:"serviceOffering,omitempty"`
+
+	// (String) The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	CreatedAt *string `json:"createdAt,omitempty"`
+
+	// (String) The date and time when the resource was updated in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+
+	// (Attributes) The metadata associated with the Cloud Foundry resource.
+	ResourceMetadata `json:",inline"`
+}
+
+// ServicePlanSpec defines the desired state of ServicePlan
+type ServicePlanSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     ServicePlanParameters `json:"forProvider"`
+}
+
+// ServicePlanStatus defines the observed state of ServicePlan.
+type ServicePlanStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        ServicePlanObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ServicePlan is the Schema for the ServicePlans API. Observes a service plan of the marketplace. Service plans are registered by service brokers and cannot be created, updated or deleted.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Service Plan GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/service_plans?service_offering_names=<offering>&names=<plan>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+type ServicePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ServicePlanSpec   `json:"spec"`
+	Status            ServicePlanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServicePlanList contains a list of ServicePlans
+type ServicePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServicePlan `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServicePlan_Kind             = "ServicePlan"
+	ServicePlan_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServicePlan_Kind}.String()
+	ServicePlan_KindAPIVersion   = ServicePlan_Kind + "." + CRDGroupVersion.String()
+	ServicePlan_GroupVersionKind = CRDGroupVersion.WithKind(ServicePlan_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServicePlan{}, &ServicePlanList{})
+}
+
+// GetID returns the ID of the service plan
+func (s *ServicePlan) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
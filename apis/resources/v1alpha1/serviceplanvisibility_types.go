@@ -0,0 +1,114 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+type ServicePlanVisibilityObservation struct {
+	// (String) The GUID of the service plan.
+	ServicePlan *string `json:"servicePlan,omitempty"`
+
+	// (String) The visibility type of the service plan; one of `public`, `admin`, `organization` or `space`.
+	Type *string `json:"type,omitempty"`
+
+	// (Set of String) GUIDs of the organizations whose members can access the service plan; present if type is `organization`.
+	// +listType=set
+	Orgs []*string `json:"orgs,omitempty"`
+
+	// (String) GUID of the space whose members can access the service plan; present if type is `space`.
+	Space *string `json:"space,omitempty"`
+}
+
+type ServicePlanVisibilityParameters struct {
+	// (Attributes) The service plan whose visibility is managed, given by ID or by offering and plan name. The service plan cannot be changed after creation.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="servicePlan is immutable"
+	// +kubebuilder:validation:Optional
+	ServicePlan ServicePlanParameters `json:"servicePlan"`
+
+	// (String) The visibility type of the service plan. `public` plans are visible to everyone, `admin` plans only to admins, and `organization` plans to the members of the listed organizations. `space` is the visibility of the plans of space-scoped service brokers and cannot be assigned to other plans.
+	// +kubebuilder:validation:Enum=public;admin;organization;space
+	// +kubebuilder:validation:Optional
+	Type string `json:"type,omitempty"`
+
+	// (Set of String) GUIDs of the organizations whose members can access the service plan if type is `organization`. Organizations that are not listed are removed. This field is typically populated using references specified in `orgsRefs` or `orgsSelector`.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=github.com/SAP/crossplane-provider-cloudfoundry/apis/resources.ExternalID()
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Orgs []*string `json:"orgs,omitempty"`
+
+	// (Attributes) References to `Organization` CRs to retrieve the external GUIDs of the organizations.
+	// +kubebuilder:validation:Optional
+	OrgsRefs []v1.Reference `json:"orgsRefs,omitempty"`
+
+	// (Attributes) Selector for `Organization` CRs to retrieve the external GUIDs of the organizations.
+	// +kubebuilder:validation:Optional
+	OrgsSelector *v1.Selector `json:"orgsSelector,omitempty"`
+}
+
+// ServicePlanVisibilitySpec defines the desired state of ServicePlanVisibility
+type ServicePlanVisibilitySpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     ServicePlanVisibilityParameters `json:"forProvider"`
+}
+
+// ServicePlanVisibilityStatus defines the observed state of ServicePlanVisibility.
+type ServicePlanVisibilityStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        ServicePlanVisibilityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ServicePlanVisibility is the Schema for the ServicePlanVisibilities API. Provides a Cloud Foundry resource for managing the access to a service plan.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Service Plan GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/service_plans?service_offering_names=<offering>&names=<plan>` (field: guid)
+//
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.type)",message="type is required"
+type ServicePlanVisibility struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ServicePlanVisibilitySpec   `json:"spec"`
+	Status            ServicePlanVisibilityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServicePlanVisibilityList contains a list of ServicePlanVisibilities
+type ServicePlanVisibilityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServicePlanVisibility `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ServicePlanVisibility_Kind             = "ServicePlanVisibility"
+	ServicePlanVisibility_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServicePlanVisibility_Kind}.String()
+	ServicePlanVisibility_KindAPIVersion   = ServicePlanVisibility_Kind + "." + CRDGroupVersion.String()
+	ServicePlanVisibility_GroupVersionKind = CRDGroupVersion.WithKind(ServicePlanVisibility_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServicePlanVisibility{}, &ServicePlanVisibilityList{})
+}
@@ -24,7 +24,7 @@ type SpaceObservation struct {
 	// (String) The GUID of the object.
 	ID string `json:"id,omitempty"`
 
-	// (String) The ID of the isolation segment to assign to the space. The isolation segment must be entitled to the space's parent organization.
+	// (String) The ID of the isolation segment assigned to the space.
 	IsolationSegment *string `json:"isolationSegment,omitempty" tf:"isolation_segment,omitempty"`
 
 	// (String) The name of the space in Cloud Foundry.
@@ -50,10 +50,24 @@ type SpaceParameters struct {
 	// +kubebuilder:default=false
 	AllowSSH bool `json:"allowSsh,omitempty" tf:"allow_ssh,omitempty"`
 
-	// (String) The ID of the isolation segment to assign to the space. The isolation segment must be entitled to the space's parent organization.
+	// (String) The ID of the isolation segment to assign to the space. The isolation segment must be entitled to the space's parent organization. Set to an empty string to unassign the isolation segment. When unset, the isolation segment of the space is not managed.
+	// +crossplane:generate:reference:type=IsolationSegment
+	// +crossplane:generate:reference:extractor=github.com/SAP/crossplane-provider-cloudfoundry/apis/resources.ExternalID()
 	// +kubebuilder:validation:Optional
 	IsolationSegment *string `json:"isolationSegment,omitempty" tf:"isolation_segment,omitempty"`
 
+	// (String) The name of the isolation segment to lookup the GUID of the isolation segment. Use `isolationSegmentName` only when the referenced isolation segment is not managed by Crossplane.
+	// +kubebuilder:validation:Optional
+	IsolationSegmentName *string `json:"isolationSegmentName,omitempty" tf:"-"`
+
+	// (Attributes) Reference to an `IsolationSegment` CR to retrieve the external GUID of the isolation segment.
+	// +kubebuilder:validation:Optional
+	IsolationSegmentRef *v1.Reference `json:"isolationSegmentRef,omitempty" tf:"-"`
+
+	// (Attributes) Selector for an `IsolationSegment` CR to retrieve the external GUID of the isolation segment.
+	// +kubebuilder:validation:Optional
+	IsolationSegmentSelector *v1.Selector `json:"isolationSegmentSelector,omitempty" tf:"-"`
+
 	// (Attributes) The metadata associated with the Cloud Foundry resource.
 	// +kubebuilder:validation:Optional
 	ResourceMetadata `json:",inline"`
@@ -0,0 +1,165 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+type TaskObservation struct {
+	// (String) The GUID of the task.
+	ID *string `json:"id,omitempty"`
+
+	// (String) The name of the task.
+	Name *string `json:"name,omitempty"`
+
+	// (Number) The ID of the task, unique among the tasks of the app.
+	SequenceID *int `json:"sequenceId,omitempty"`
+
+	// (String) The state of the task: `PENDING`, `RUNNING`, `SUCCEEDED`, `CANCELING` or `FAILED`.
+	State *string `json:"state,omitempty"`
+
+	// (String) The reason the task failed, including its exit status.
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// (Number) The memory in MB allocated to the task.
+	MemoryInMB *int `json:"memoryInMB,omitempty"`
+
+	// (Number) The disk in MB allocated to the task.
+	DiskInMB *int `json:"diskInMB,omitempty"`
+
+	// (Number) The log rate limit in bytes per second of the task; -1 is unlimited.
+	LogRateLimitInBytesPerSecond *int `json:"logRateLimitInBytesPerSecond,omitempty"`
+
+	// (String) The GUID of the droplet the task runs on.
+	Droplet *string `json:"droplet,omitempty"`
+
+	// (String) The hash of the parameters the task was run with. A task is run again when the hash of its parameters changes.
+	SpecHash *string `json:"specHash,omitempty"`
+
+	// (String) The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	CreatedAt *string `json:"createdAt,omitempty"`
+
+	// (String) The date and time when the resource was updated in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+
+	ResourceMetadata `json:",inline"`
+}
+
+type TaskParameters struct {
+	// (String) The name of the task. Generated by Cloud Foundry if not set.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name,omitempty"`
+
+	// (String) The command executed by the task.
+	// +kubebuilder:validation:Optional
+	Command string `json:"command,omitempty"`
+
+	// (String) The GUID of the app the task runs for.
+	// +crossplane:generate:reference:type=App
+	// +kubebuilder:validation:Optional
+	App *string `json:"app,omitempty"`
+
+	// (Attributes) Reference to an app CR to populate `app`.
+	// +kubebuilder:validation:Optional
+	AppRef *v1.Reference `json:"appRef,omitempty"`
+
+	// (Attributes) Selector for an app CR to populate `app`.
+	// +kubebuilder:validation:Optional
+	AppSelector *v1.Selector `json:"appSelector,omitempty"`
+
+	// (Number) The memory in MB allocated to the task. Defaults to the default memory of the foundation.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MemoryInMB *int `json:"memoryInMB,omitempty"`
+
+	// (Number) The disk in MB allocated to the task. Defaults to the default disk of the foundation.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	DiskInMB *int `json:"diskInMB,omitempty"`
+
+	// (Number) The log rate limit in bytes per second of the task; -1 is unlimited.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=-1
+	LogRateLimitInBytesPerSecond *int `json:"logRateLimitInBytesPerSecond,omitempty"`
+
+	// (String) The GUID of the droplet the task runs on. Defaults to the current droplet of the app.
+	// +kubebuilder:validation:Optional
+	Droplet *string `json:"droplet,omitempty"`
+
+	ResourceMetadata `json:",inline"`
+}
+
+// TaskSpec defines the desired state of Task
+type TaskSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     TaskParameters `json:"forProvider"`
+}
+
+// TaskStatus defines the observed state of Task.
+type TaskStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        TaskObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// Task is the Schema for the Tasks API. Provides a Cloud Foundry resource for running a one-off task of an app, like `cf run-task`. The task is run once and is ready when it succeeds; it is run again when its parameters change. Deleting the resource cancels a task that is still running.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Task GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl /v3/apps/<app-guid>/tasks` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.command)",message="command is required"
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || (has(self.spec.forProvider.app) || has(self.spec.forProvider.appRef) || has(self.spec.forProvider.appSelector))",message="AppReference is required: exactly one of app, appRef, or appSelector must be set"
+type Task struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TaskSpec   `json:"spec"`
+	Status            TaskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskList contains a list of Tasks
+type TaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Task `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	Task_Kind             = "Task"
+	Task_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: Task_Kind}.String()
+	Task_KindAPIVersion   = Task_Kind + "." + CRDGroupVersion.String()
+	Task_GroupVersionKind = CRDGroupVersion.WithKind(Task_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Task{}, &TaskList{})
+}
+
+// GetID returns the ID of the task
+func (s *Task) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 SAP SE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+type UserObservation struct {
+	// (String) The GUID of the object.
+	ID *string `json:"id,omitempty"`
+
+	// (String) The username of the user at the identity provider.
+	Username *string `json:"username,omitempty"`
+
+	// (String) The identity provider of the user.
+	Origin *string `json:"origin,omitempty"`
+
+	// (String) The name displayed for the user.
+	PresentationName *string `json:"presentationName,omitempty"`
+
+	// (String) The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	CreatedAt *string `json:"createdAt,omitempty"`
+
+	// (String) The date and time when the resource was updated in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+
+	// (Attributes) The metadata associated with the Cloud Foundry resource.
+	ResourceMetadata `json:",inline"`
+}
+
+type UserParameters struct {
+	// (String) The username of the user at the identity provider.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="username is immutable"
+	Username string `json:"username,omitempty"`
+
+	// (String) The identity provider of the user. Defaults to `sap.ids`. For origins other than `uaa`, the shadow user is created in UAA if it does not exist yet.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=sap.ids
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="origin is immutable"
+	Origin string `json:"origin,omitempty"`
+
+	// (String) The GUID of an existing UAA user. When set, the Cloud Foundry user is created with this GUID instead of by username and origin, and no UAA shadow user is created.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="guid is immutable"
+	GUID *string `json:"guid,omitempty"`
+
+	// (Attributes) The metadata associated with the Cloud Foundry resource.
+	// +kubebuilder:validation:Optional
+	ResourceMetadata `json:",inline"`
+}
+
+// UserSpec defines the desired state of User
+type UserSpec struct {
+	v1.ResourceSpec `json:",inline"`
+	ForProvider     UserParameters `json:"forProvider"`
+}
+
+// UserStatus defines the observed state of User.
+type UserStatus struct {
+	v1.ResourceStatus `json:",inline"`
+	AtProvider        UserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// User is the Schema for the Users API. Provides a Cloud Foundry resource for creating the user record of a username, so that roles can be assigned before the user has logged in to Cloud Foundry.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: User GUID (UUID format)
+//   - How to find:
+//   - UI: Not available in the BTP Cockpit
+//   - CLI: Use CF CLI: `cf curl "/v3/users?usernames=<username>&origins=<origin>"` (field: guid)
+//
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".status.atProvider.username"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,cloudfoundry}
+// +kubebuilder:validation:XValidation:rule="self.spec.managementPolicies == ['Observe'] || has(self.spec.forProvider.username) || has(self.spec.forProvider.guid)",message="username or guid is required"
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              UserSpec   `json:"spec"`
+	Status            UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserList contains a list of Users
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	User_Kind             = "User"
+	User_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: User_Kind}.String()
+	User_KindAPIVersion   = User_Kind + "." + CRDGroupVersion.String()
+	User_GroupVersionKind = CRDGroupVersion.WithKind(User_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&User{}, &UserList{})
+}
+
+// GetID returns the ID of the user
+func (s *User) GetID() string {
+	if s.Status.AtProvider.ID != nil {
+		return *s.Status.AtProvider.ID
+	}
+	return ""
+}
+
+// GetCloudFoundryName implements Nameable interface. It returns the observed
+// username, so that references resolve only once the user exists.
+func (s *User) GetCloudFoundryName() string {
+	if s.Status.AtProvider.Username != nil {
+		return *s.Status.AtProvider.Username
+	}
+	return ""
+}
+
+// GetOrigin implements Originable interface. It returns the observed
+// identity provider of the user.
+func (s *User) GetOrigin() string {
+	if s.Status.AtProvider.Origin != nil {
+		return *s.Status.AtProvider.Origin
+	}
+	return ""
+}
@@ -53,6 +53,32 @@ func (in *App) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppDeploymentObservation) DeepCopyInto(out *AppDeploymentObservation) {
+	*out = *in
+	in.Resource.DeepCopyInto(&out.Resource)
+	if in.CurrentCanaryStep != nil {
+		in, out := &in.CurrentCanaryStep, &out.CurrentCanaryStep
+		*out = new(int)
+		**out = **in
+	}
+	if in.TotalCanarySteps != nil {
+		in, out := &in.TotalCanarySteps, &out.TotalCanarySteps
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppDeploymentObservation.
+func (in *AppDeploymentObservation) DeepCopy() *AppDeploymentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AppDeploymentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppList) DeepCopyInto(out *AppList) {
 	*out = *in
@@ -96,6 +122,18 @@ func (in *AppObservation) DeepCopyInto(out *AppObservation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]SidecarConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(AppDeploymentObservation)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
@@ -155,6 +193,27 @@ func (in *AppParameters) DeepCopyInto(out *AppParameters) {
 		}
 	}
 	in.ReadinessHealthCheckConfiguration.DeepCopyInto(&out.ReadinessHealthCheckConfiguration)
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = new([]SidecarConfiguration)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]SidecarConfiguration, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(DeploymentConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Environment != nil {
 		in, out := &in.Environment, &out.Environment
 		*out = make(map[string]string, len(*in))
@@ -235,93 +294,73 @@ func (in *AppStatus) DeepCopy() *AppStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Data) DeepCopyInto(out *Data) {
+func (in *Buildpack) DeepCopyInto(out *Buildpack) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Data.
-func (in *Data) DeepCopy() *Data {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Buildpack.
+func (in *Buildpack) DeepCopy() *Buildpack {
 	if in == nil {
 		return nil
 	}
-	out := new(Data)
+	out := new(Buildpack)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DockerConfiguration) DeepCopyInto(out *DockerConfiguration) {
-	*out = *in
-	if in.Credentials != nil {
-		in, out := &in.Credentials, &out.Credentials
-		*out = new(v1.SecretReference)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfiguration.
-func (in *DockerConfiguration) DeepCopy() *DockerConfiguration {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Buildpack) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(DockerConfiguration)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Domain) DeepCopyInto(out *Domain) {
+func (in *BuildpackJob) DeepCopyInto(out *BuildpackJob) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Domain.
-func (in *Domain) DeepCopy() *Domain {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackJob.
+func (in *BuildpackJob) DeepCopy() *BuildpackJob {
 	if in == nil {
 		return nil
 	}
-	out := new(Domain)
+	out := new(BuildpackJob)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Domain) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainList) DeepCopyInto(out *DomainList) {
+func (in *BuildpackList) DeepCopyInto(out *BuildpackList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Domain, len(*in))
+		*out = make([]Buildpack, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainList.
-func (in *DomainList) DeepCopy() *DomainList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackList.
+func (in *BuildpackList) DeepCopy() *BuildpackList {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainList)
+	out := new(BuildpackList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DomainList) DeepCopyObject() runtime.Object {
+func (in *BuildpackList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -329,54 +368,57 @@ func (in *DomainList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainObservation) DeepCopyInto(out *DomainObservation) {
+func (in *BuildpackObservation) DeepCopyInto(out *BuildpackObservation) {
 	*out = *in
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Internal != nil {
-		in, out := &in.Internal, &out.Internal
-		*out = new(bool)
-		**out = **in
-	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Org != nil {
-		in, out := &in.Org, &out.Org
+	if in.Stack != nil {
+		in, out := &in.Stack, &out.Stack
 		*out = new(string)
 		**out = **in
 	}
-	if in.RouterGroup != nil {
-		in, out := &in.RouterGroup, &out.RouterGroup
+	if in.Position != nil {
+		in, out := &in.Position, &out.Position
+		*out = new(int)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Locked != nil {
+		in, out := &in.Locked, &out.Locked
+		*out = new(bool)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
 		*out = new(string)
 		**out = **in
 	}
-	if in.SharedOrgs != nil {
-		in, out := &in.SharedOrgs, &out.SharedOrgs
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.Filename != nil {
+		in, out := &in.Filename, &out.Filename
+		*out = new(string)
+		**out = **in
 	}
-	if in.SupportedProtocols != nil {
-		in, out := &in.SupportedProtocols, &out.SupportedProtocols
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastJob != nil {
+		in, out := &in.LastJob, &out.LastJob
+		*out = new(BuildpackJob)
+		**out = **in
 	}
 	if in.CreatedAt != nil {
 		in, out := &in.CreatedAt, &out.CreatedAt
@@ -391,328 +433,213 @@ func (in *DomainObservation) DeepCopyInto(out *DomainObservation) {
 	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainObservation.
-func (in *DomainObservation) DeepCopy() *DomainObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackObservation.
+func (in *BuildpackObservation) DeepCopy() *BuildpackObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainObservation)
+	out := new(BuildpackObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainParameters) DeepCopyInto(out *DomainParameters) {
+func (in *BuildpackParameters) DeepCopyInto(out *BuildpackParameters) {
 	*out = *in
-	if in.Domain != nil {
-		in, out := &in.Domain, &out.Domain
+	if in.Stack != nil {
+		in, out := &in.Stack, &out.Stack
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubDomain != nil {
-		in, out := &in.SubDomain, &out.SubDomain
-		*out = new(string)
+	if in.Position != nil {
+		in, out := &in.Position, &out.Position
+		*out = new(int)
 		**out = **in
 	}
-	if in.Internal != nil {
-		in, out := &in.Internal, &out.Internal
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.RouterGroup != nil {
-		in, out := &in.RouterGroup, &out.RouterGroup
-		*out = new(string)
+	if in.Locked != nil {
+		in, out := &in.Locked, &out.Locked
+		*out = new(bool)
 		**out = **in
 	}
-	in.OrgReference.DeepCopyInto(&out.OrgReference)
-	if in.SharedOrgs != nil {
-		in, out := &in.SharedOrgs, &out.SharedOrgs
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(BuildpackSource)
+		(*in).DeepCopyInto(*out)
 	}
 	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainParameters.
-func (in *DomainParameters) DeepCopy() *DomainParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackParameters.
+func (in *BuildpackParameters) DeepCopy() *BuildpackParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainParameters)
+	out := new(BuildpackParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainReference) DeepCopyInto(out *DomainReference) {
+func (in *BuildpackSource) DeepCopyInto(out *BuildpackSource) {
 	*out = *in
-	if in.Domain != nil {
-		in, out := &in.Domain, &out.Domain
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
 		*out = new(string)
 		**out = **in
 	}
-	if in.DomainName != nil {
-		in, out := &in.DomainName, &out.DomainName
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
 		*out = new(string)
 		**out = **in
 	}
-	if in.DomainRef != nil {
-		in, out := &in.DomainRef, &out.DomainRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DomainSelector != nil {
-		in, out := &in.DomainSelector, &out.DomainSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainReference.
-func (in *DomainReference) DeepCopy() *DomainReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackSource.
+func (in *BuildpackSource) DeepCopy() *BuildpackSource {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainReference)
+	out := new(BuildpackSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
+func (in *BuildpackSpec) DeepCopyInto(out *BuildpackSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSpec.
-func (in *DomainSpec) DeepCopy() *DomainSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackSpec.
+func (in *BuildpackSpec) DeepCopy() *BuildpackSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainSpec)
+	out := new(BuildpackSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
+func (in *BuildpackStatus) DeepCopyInto(out *BuildpackStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainStatus.
-func (in *DomainStatus) DeepCopy() *DomainStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackStatus.
+func (in *BuildpackStatus) DeepCopy() *BuildpackStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainStatus)
+	out := new(BuildpackStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HealthCheckConfiguration) DeepCopyInto(out *HealthCheckConfiguration) {
+func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
 	*out = *in
-	if in.HealthCheckType != nil {
-		in, out := &in.HealthCheckType, &out.HealthCheckType
-		*out = new(string)
-		**out = **in
-	}
-	if in.HealthCheckHTTPEndpoint != nil {
-		in, out := &in.HealthCheckHTTPEndpoint, &out.HealthCheckHTTPEndpoint
-		*out = new(string)
-		**out = **in
-	}
-	if in.HealthCheckInterval != nil {
-		in, out := &in.HealthCheckInterval, &out.HealthCheckInterval
-		*out = new(uint)
-		**out = **in
-	}
-	if in.HealthCheckInvocationTimeout != nil {
-		in, out := &in.HealthCheckInvocationTimeout, &out.HealthCheckInvocationTimeout
-		*out = new(uint)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckConfiguration.
-func (in *HealthCheckConfiguration) DeepCopy() *HealthCheckConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStep.
+func (in *CanaryStep) DeepCopy() *CanaryStep {
 	if in == nil {
 		return nil
 	}
-	out := new(HealthCheckConfiguration)
+	out := new(CanaryStep)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LastOperation) DeepCopyInto(out *LastOperation) {
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastOperation.
-func (in *LastOperation) DeepCopy() *LastOperation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
 	if in == nil {
 		return nil
 	}
-	out := new(LastOperation)
+	out := new(ConfigMapReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Link) DeepCopyInto(out *Link) {
+func (in *Data) DeepCopyInto(out *Data) {
 	*out = *in
-	if in.Method != nil {
-		in, out := &in.Method, &out.Method
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Link.
-func (in *Link) DeepCopy() *Link {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Data.
+func (in *Data) DeepCopy() *Data {
 	if in == nil {
 		return nil
 	}
-	out := new(Link)
+	out := new(Data)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in Links) DeepCopyInto(out *Links) {
-	{
-		in := &in
-		*out = make(Links, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
+func (in *DeploymentConfiguration) DeepCopyInto(out *DeploymentConfiguration) {
+	*out = *in
+	if in.MaxInFlight != nil {
+		in, out := &in.MaxInFlight, &out.MaxInFlight
+		*out = new(int)
+		**out = **in
+	}
+	if in.CanarySteps != nil {
+		in, out := &in.CanarySteps, &out.CanarySteps
+		*out = make([]CanaryStep, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Links.
-func (in Links) DeepCopy() Links {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentConfiguration.
+func (in *DeploymentConfiguration) DeepCopy() *DeploymentConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(Links)
+	out := new(DeploymentConfiguration)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceInfo) DeepCopyInto(out *MaintenanceInfo) {
+func (in *DockerConfiguration) DeepCopyInto(out *DockerConfiguration) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceInfo.
-func (in *MaintenanceInfo) DeepCopy() *MaintenanceInfo {
-	if in == nil {
-		return nil
-	}
-	out := new(MaintenanceInfo)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Managed) DeepCopyInto(out *Managed) {
-	*out = *in
-	if in.ServicePlan != nil {
-		in, out := &in.ServicePlan, &out.ServicePlan
-		*out = new(ServicePlanParameters)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.JSONParams != nil {
-		in, out := &in.JSONParams, &out.JSONParams
-		*out = new(string)
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(v1.SecretReference)
 		**out = **in
 	}
-	if in.ParametersSecretRef != nil {
-		in, out := &in.ParametersSecretRef, &out.ParametersSecretRef
-		*out = new(SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
-	in.MaintenanceInfo.DeepCopyInto(&out.MaintenanceInfo)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Managed.
-func (in *Managed) DeepCopy() *Managed {
-	if in == nil {
-		return nil
-	}
-	out := new(Managed)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Member) DeepCopyInto(out *Member) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
-func (in *Member) DeepCopy() *Member {
-	if in == nil {
-		return nil
-	}
-	out := new(Member)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberList) DeepCopyInto(out *MemberList) {
-	*out = *in
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]*Member, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Member)
-				**out = **in
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
-func (in *MemberList) DeepCopy() *MemberList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfiguration.
+func (in *DockerConfiguration) DeepCopy() *DockerConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberList)
+	out := new(DockerConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgMembers) DeepCopyInto(out *OrgMembers) {
+func (in *Domain) DeepCopyInto(out *Domain) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -720,18 +647,18 @@ func (in *OrgMembers) DeepCopyInto(out *OrgMembers) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgMembers.
-func (in *OrgMembers) DeepCopy() *OrgMembers {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Domain.
+func (in *Domain) DeepCopy() *Domain {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgMembers)
+	out := new(Domain)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrgMembers) DeepCopyObject() runtime.Object {
+func (in *Domain) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -739,31 +666,31 @@ func (in *OrgMembers) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgMembersList) DeepCopyInto(out *OrgMembersList) {
+func (in *DomainList) DeepCopyInto(out *DomainList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OrgMembers, len(*in))
+		*out = make([]Domain, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgMembersList.
-func (in *OrgMembersList) DeepCopy() *OrgMembersList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainList.
+func (in *DomainList) DeepCopy() *DomainList {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgMembersList)
+	out := new(DomainList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrgMembersList) DeepCopyObject() runtime.Object {
+func (in *DomainList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -771,82 +698,58 @@ func (in *OrgMembersList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgMembersParameters) DeepCopyInto(out *OrgMembersParameters) {
-	*out = *in
-	in.MemberList.DeepCopyInto(&out.MemberList)
-	in.OrgReference.DeepCopyInto(&out.OrgReference)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgMembersParameters.
-func (in *OrgMembersParameters) DeepCopy() *OrgMembersParameters {
-	if in == nil {
-		return nil
-	}
-	out := new(OrgMembersParameters)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgMembersSpec) DeepCopyInto(out *OrgMembersSpec) {
-	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgMembersSpec.
-func (in *OrgMembersSpec) DeepCopy() *OrgMembersSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(OrgMembersSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgMembersStatus) DeepCopyInto(out *OrgMembersStatus) {
-	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgMembersStatus.
-func (in *OrgMembersStatus) DeepCopy() *OrgMembersStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(OrgMembersStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgObservation) DeepCopyInto(out *OrgObservation) {
+func (in *DomainObservation) DeepCopyInto(out *DomainObservation) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = new(string)
-		**out = **in
-	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Quota != nil {
-		in, out := &in.Quota, &out.Quota
+	if in.Org != nil {
+		in, out := &in.Org, &out.Org
 		*out = new(string)
 		**out = **in
 	}
-	if in.Suspended != nil {
-		in, out := &in.Suspended, &out.Suspended
-		*out = new(bool)
+	if in.RouterGroup != nil {
+		in, out := &in.RouterGroup, &out.RouterGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.SharedOrgs != nil {
+		in, out := &in.SharedOrgs, &out.SharedOrgs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SupportedProtocols != nil {
+		in, out := &in.SupportedProtocols, &out.SupportedProtocols
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
 		**out = **in
 	}
 	if in.UpdatedAt != nil {
@@ -857,39 +760,135 @@ func (in *OrgObservation) DeepCopyInto(out *OrgObservation) {
 	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgObservation.
-func (in *OrgObservation) DeepCopy() *OrgObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainObservation.
+func (in *DomainObservation) DeepCopy() *DomainObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgObservation)
+	out := new(DomainObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgParameters) DeepCopyInto(out *OrgParameters) {
+func (in *DomainParameters) DeepCopyInto(out *DomainParameters) {
 	*out = *in
-	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
-	if in.Suspended != nil {
-		in, out := &in.Suspended, &out.Suspended
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubDomain != nil {
+		in, out := &in.SubDomain, &out.SubDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RouterGroup != nil {
+		in, out := &in.RouterGroup, &out.RouterGroup
+		*out = new(string)
+		**out = **in
+	}
+	in.OrgReference.DeepCopyInto(&out.OrgReference)
+	if in.SharedOrgs != nil {
+		in, out := &in.SharedOrgs, &out.SharedOrgs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgParameters.
-func (in *OrgParameters) DeepCopy() *OrgParameters {
-	if in == nil {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainParameters.
+func (in *DomainParameters) DeepCopy() *DomainParameters {
+	if in == nil {
 		return nil
 	}
-	out := new(OrgParameters)
+	out := new(DomainParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgQuota) DeepCopyInto(out *OrgQuota) {
+func (in *DomainReference) DeepCopyInto(out *DomainReference) {
+	*out = *in
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainName != nil {
+		in, out := &in.DomainName, &out.DomainName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainRef != nil {
+		in, out := &in.DomainRef, &out.DomainRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DomainSelector != nil {
+		in, out := &in.DomainSelector, &out.DomainSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainReference.
+func (in *DomainReference) DeepCopy() *DomainReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSpec.
+func (in *DomainSpec) DeepCopy() *DomainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainStatus.
+func (in *DomainStatus) DeepCopy() *DomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentVariableGroup) DeepCopyInto(out *EnvironmentVariableGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -897,18 +896,18 @@ func (in *OrgQuota) DeepCopyInto(out *OrgQuota) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuota.
-func (in *OrgQuota) DeepCopy() *OrgQuota {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentVariableGroup.
+func (in *EnvironmentVariableGroup) DeepCopy() *EnvironmentVariableGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgQuota)
+	out := new(EnvironmentVariableGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrgQuota) DeepCopyObject() runtime.Object {
+func (in *EnvironmentVariableGroup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -916,117 +915,208 @@ func (in *OrgQuota) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgQuotaInitParameters) DeepCopyInto(out *OrgQuotaInitParameters) {
+func (in *EnvironmentVariableGroupList) DeepCopyInto(out *EnvironmentVariableGroupList) {
 	*out = *in
-	if in.AllowPaidServicePlans != nil {
-		in, out := &in.AllowPaidServicePlans, &out.AllowPaidServicePlans
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EnvironmentVariableGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.InstanceMemory != nil {
-		in, out := &in.InstanceMemory, &out.InstanceMemory
-		*out = new(float64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentVariableGroupList.
+func (in *EnvironmentVariableGroupList) DeepCopy() *EnvironmentVariableGroupList {
+	if in == nil {
+		return nil
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	out := new(EnvironmentVariableGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EnvironmentVariableGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentVariableGroupObservation) DeepCopyInto(out *EnvironmentVariableGroupObservation) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
 		*out = new(string)
 		**out = **in
 	}
-	if in.Orgs != nil {
-		in, out := &in.Orgs, &out.Orgs
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.VariableNames != nil {
+		in, out := &in.VariableNames, &out.VariableNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.TotalAppInstances != nil {
-		in, out := &in.TotalAppInstances, &out.TotalAppInstances
-		*out = new(float64)
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
 		**out = **in
 	}
-	if in.TotalAppLogRateLimit != nil {
-		in, out := &in.TotalAppLogRateLimit, &out.TotalAppLogRateLimit
-		*out = new(float64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentVariableGroupObservation.
+func (in *EnvironmentVariableGroupObservation) DeepCopy() *EnvironmentVariableGroupObservation {
+	if in == nil {
+		return nil
 	}
-	if in.TotalAppTasks != nil {
-		in, out := &in.TotalAppTasks, &out.TotalAppTasks
-		*out = new(float64)
-		**out = **in
+	out := new(EnvironmentVariableGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentVariableGroupParameters) DeepCopyInto(out *EnvironmentVariableGroupParameters) {
+	*out = *in
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.TotalMemory != nil {
-		in, out := &in.TotalMemory, &out.TotalMemory
-		*out = new(float64)
-		**out = **in
+	if in.VariablesFrom != nil {
+		in, out := &in.VariablesFrom, &out.VariablesFrom
+		*out = make([]EnvironmentVariableSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.TotalPrivateDomains != nil {
-		in, out := &in.TotalPrivateDomains, &out.TotalPrivateDomains
-		*out = new(float64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentVariableGroupParameters.
+func (in *EnvironmentVariableGroupParameters) DeepCopy() *EnvironmentVariableGroupParameters {
+	if in == nil {
+		return nil
 	}
-	if in.TotalRoutePorts != nil {
-		in, out := &in.TotalRoutePorts, &out.TotalRoutePorts
-		*out = new(float64)
-		**out = **in
+	out := new(EnvironmentVariableGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentVariableGroupSpec) DeepCopyInto(out *EnvironmentVariableGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentVariableGroupSpec.
+func (in *EnvironmentVariableGroupSpec) DeepCopy() *EnvironmentVariableGroupSpec {
+	if in == nil {
+		return nil
 	}
-	if in.TotalRoutes != nil {
-		in, out := &in.TotalRoutes, &out.TotalRoutes
-		*out = new(float64)
-		**out = **in
+	out := new(EnvironmentVariableGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentVariableGroupStatus) DeepCopyInto(out *EnvironmentVariableGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentVariableGroupStatus.
+func (in *EnvironmentVariableGroupStatus) DeepCopy() *EnvironmentVariableGroupStatus {
+	if in == nil {
+		return nil
 	}
-	if in.TotalServiceKeys != nil {
-		in, out := &in.TotalServiceKeys, &out.TotalServiceKeys
-		*out = new(float64)
+	out := new(EnvironmentVariableGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentVariableSource) DeepCopyInto(out *EnvironmentVariableSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretReference)
 		**out = **in
 	}
-	if in.TotalServices != nil {
-		in, out := &in.TotalServices, &out.TotalServices
-		*out = new(float64)
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapReference)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaInitParameters.
-func (in *OrgQuotaInitParameters) DeepCopy() *OrgQuotaInitParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentVariableSource.
+func (in *EnvironmentVariableSource) DeepCopy() *EnvironmentVariableSource {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgQuotaInitParameters)
+	out := new(EnvironmentVariableSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgQuotaList) DeepCopyInto(out *OrgQuotaList) {
+func (in *FeatureFlag) DeepCopyInto(out *FeatureFlag) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureFlag.
+func (in *FeatureFlag) DeepCopy() *FeatureFlag {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureFlag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FeatureFlag) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureFlagList) DeepCopyInto(out *FeatureFlagList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OrgQuota, len(*in))
+		*out = make([]FeatureFlag, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaList.
-func (in *OrgQuotaList) DeepCopy() *OrgQuotaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureFlagList.
+func (in *FeatureFlagList) DeepCopy() *FeatureFlagList {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgQuotaList)
+	out := new(FeatureFlagList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrgQuotaList) DeepCopyObject() runtime.Object {
+func (in *FeatureFlagList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1034,117 +1124,199 @@ func (in *OrgQuotaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgQuotaObservation) DeepCopyInto(out *OrgQuotaObservation) {
+func (in *FeatureFlagObservation) DeepCopyInto(out *FeatureFlagObservation) {
 	*out = *in
-	if in.AllowPaidServicePlans != nil {
-		in, out := &in.AllowPaidServicePlans, &out.AllowPaidServicePlans
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
+	if in.CustomErrorMessage != nil {
+		in, out := &in.CustomErrorMessage, &out.CustomErrorMessage
 		*out = new(string)
 		**out = **in
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
 		*out = new(string)
 		**out = **in
 	}
-	if in.InstanceMemory != nil {
-		in, out := &in.InstanceMemory, &out.InstanceMemory
-		*out = new(float64)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureFlagObservation.
+func (in *FeatureFlagObservation) DeepCopy() *FeatureFlagObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureFlagObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureFlagParameters) DeepCopyInto(out *FeatureFlagParameters) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.CustomErrorMessage != nil {
+		in, out := &in.CustomErrorMessage, &out.CustomErrorMessage
 		*out = new(string)
 		**out = **in
 	}
-	if in.Orgs != nil {
-		in, out := &in.Orgs, &out.Orgs
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureFlagParameters.
+func (in *FeatureFlagParameters) DeepCopy() *FeatureFlagParameters {
+	if in == nil {
+		return nil
 	}
-	if in.TotalAppInstances != nil {
-		in, out := &in.TotalAppInstances, &out.TotalAppInstances
-		*out = new(float64)
-		**out = **in
+	out := new(FeatureFlagParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureFlagSpec) DeepCopyInto(out *FeatureFlagSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureFlagSpec.
+func (in *FeatureFlagSpec) DeepCopy() *FeatureFlagSpec {
+	if in == nil {
+		return nil
 	}
-	if in.TotalAppLogRateLimit != nil {
-		in, out := &in.TotalAppLogRateLimit, &out.TotalAppLogRateLimit
-		*out = new(float64)
-		**out = **in
+	out := new(FeatureFlagSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureFlagStatus) DeepCopyInto(out *FeatureFlagStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureFlagStatus.
+func (in *FeatureFlagStatus) DeepCopy() *FeatureFlagStatus {
+	if in == nil {
+		return nil
 	}
-	if in.TotalAppTasks != nil {
-		in, out := &in.TotalAppTasks, &out.TotalAppTasks
-		*out = new(float64)
+	out := new(FeatureFlagStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckConfiguration) DeepCopyInto(out *HealthCheckConfiguration) {
+	*out = *in
+	if in.HealthCheckType != nil {
+		in, out := &in.HealthCheckType, &out.HealthCheckType
+		*out = new(string)
 		**out = **in
 	}
-	if in.TotalMemory != nil {
-		in, out := &in.TotalMemory, &out.TotalMemory
-		*out = new(float64)
+	if in.HealthCheckHTTPEndpoint != nil {
+		in, out := &in.HealthCheckHTTPEndpoint, &out.HealthCheckHTTPEndpoint
+		*out = new(string)
 		**out = **in
 	}
-	if in.TotalPrivateDomains != nil {
-		in, out := &in.TotalPrivateDomains, &out.TotalPrivateDomains
-		*out = new(float64)
+	if in.HealthCheckInterval != nil {
+		in, out := &in.HealthCheckInterval, &out.HealthCheckInterval
+		*out = new(uint)
 		**out = **in
 	}
-	if in.TotalRoutePorts != nil {
-		in, out := &in.TotalRoutePorts, &out.TotalRoutePorts
-		*out = new(float64)
+	if in.HealthCheckInvocationTimeout != nil {
+		in, out := &in.HealthCheckInvocationTimeout, &out.HealthCheckInvocationTimeout
+		*out = new(uint)
 		**out = **in
 	}
-	if in.TotalRoutes != nil {
-		in, out := &in.TotalRoutes, &out.TotalRoutes
-		*out = new(float64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckConfiguration.
+func (in *HealthCheckConfiguration) DeepCopy() *HealthCheckConfiguration {
+	if in == nil {
+		return nil
 	}
-	if in.TotalServiceKeys != nil {
-		in, out := &in.TotalServiceKeys, &out.TotalServiceKeys
-		*out = new(float64)
-		**out = **in
+	out := new(HealthCheckConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsolationSegment) DeepCopyInto(out *IsolationSegment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationSegment.
+func (in *IsolationSegment) DeepCopy() *IsolationSegment {
+	if in == nil {
+		return nil
 	}
-	if in.TotalServices != nil {
-		in, out := &in.TotalServices, &out.TotalServices
-		*out = new(float64)
-		**out = **in
+	out := new(IsolationSegment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IsolationSegment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.UpdatedAt != nil {
-		in, out := &in.UpdatedAt, &out.UpdatedAt
-		*out = new(string)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsolationSegmentList) DeepCopyInto(out *IsolationSegmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IsolationSegment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaObservation.
-func (in *OrgQuotaObservation) DeepCopy() *OrgQuotaObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationSegmentList.
+func (in *IsolationSegmentList) DeepCopy() *IsolationSegmentList {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgQuotaObservation)
+	out := new(IsolationSegmentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IsolationSegmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgQuotaParameters) DeepCopyInto(out *OrgQuotaParameters) {
+func (in *IsolationSegmentObservation) DeepCopyInto(out *IsolationSegmentObservation) {
 	*out = *in
-	if in.AllowPaidServicePlans != nil {
-		in, out := &in.AllowPaidServicePlans, &out.AllowPaidServicePlans
-		*out = new(bool)
-		**out = **in
-	}
-	if in.InstanceMemory != nil {
-		in, out := &in.InstanceMemory, &out.InstanceMemory
-		*out = new(float64)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
 		**out = **in
 	}
 	if in.Name != nil {
@@ -1163,135 +1335,1889 @@ func (in *OrgQuotaParameters) DeepCopyInto(out *OrgQuotaParameters) {
 			}
 		}
 	}
-	if in.TotalAppInstances != nil {
-		in, out := &in.TotalAppInstances, &out.TotalAppInstances
-		*out = new(float64)
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
 		**out = **in
 	}
-	if in.TotalAppLogRateLimit != nil {
-		in, out := &in.TotalAppLogRateLimit, &out.TotalAppLogRateLimit
-		*out = new(float64)
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
 		**out = **in
 	}
-	if in.TotalAppTasks != nil {
-		in, out := &in.TotalAppTasks, &out.TotalAppTasks
-		*out = new(float64)
-		**out = **in
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationSegmentObservation.
+func (in *IsolationSegmentObservation) DeepCopy() *IsolationSegmentObservation {
+	if in == nil {
+		return nil
 	}
-	if in.TotalMemory != nil {
-		in, out := &in.TotalMemory, &out.TotalMemory
-		*out = new(float64)
+	out := new(IsolationSegmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsolationSegmentParameters) DeepCopyInto(out *IsolationSegmentParameters) {
+	*out = *in
+	if in.Orgs != nil {
+		in, out := &in.Orgs, &out.Orgs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.OrgsRefs != nil {
+		in, out := &in.OrgsRefs, &out.OrgsRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrgsSelector != nil {
+		in, out := &in.OrgsSelector, &out.OrgsSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationSegmentParameters.
+func (in *IsolationSegmentParameters) DeepCopy() *IsolationSegmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IsolationSegmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsolationSegmentSpec) DeepCopyInto(out *IsolationSegmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationSegmentSpec.
+func (in *IsolationSegmentSpec) DeepCopy() *IsolationSegmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IsolationSegmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsolationSegmentStatus) DeepCopyInto(out *IsolationSegmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationSegmentStatus.
+func (in *IsolationSegmentStatus) DeepCopy() *IsolationSegmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IsolationSegmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastOperation) DeepCopyInto(out *LastOperation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastOperation.
+func (in *LastOperation) DeepCopy() *LastOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(LastOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Link) DeepCopyInto(out *Link) {
+	*out = *in
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Link.
+func (in *Link) DeepCopy() *Link {
+	if in == nil {
+		return nil
+	}
+	out := new(Link)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Links) DeepCopyInto(out *Links) {
+	{
+		in := &in
+		*out = make(Links, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Links.
+func (in Links) DeepCopy() Links {
+	if in == nil {
+		return nil
+	}
+	out := new(Links)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceInfo) DeepCopyInto(out *MaintenanceInfo) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceInfo.
+func (in *MaintenanceInfo) DeepCopy() *MaintenanceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Managed) DeepCopyInto(out *Managed) {
+	*out = *in
+	if in.ServicePlan != nil {
+		in, out := &in.ServicePlan, &out.ServicePlan
+		*out = new(ServicePlanParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServicePlanRef != nil {
+		in, out := &in.ServicePlanRef, &out.ServicePlanRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServicePlanSelector != nil {
+		in, out := &in.ServicePlanSelector, &out.ServicePlanSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JSONParams != nil {
+		in, out := &in.JSONParams, &out.JSONParams
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParametersSecretRef != nil {
+		in, out := &in.ParametersSecretRef, &out.ParametersSecretRef
+		*out = new(SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.MaintenanceInfo.DeepCopyInto(&out.MaintenanceInfo)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Managed.
+func (in *Managed) DeepCopy() *Managed {
+	if in == nil {
+		return nil
+	}
+	out := new(Managed)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Member) DeepCopyInto(out *Member) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
+func (in *Member) DeepCopy() *Member {
+	if in == nil {
+		return nil
+	}
+	out := new(Member)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberList) DeepCopyInto(out *MemberList) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]*Member, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Member)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
+func (in *MemberList) DeepCopy() *MemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicy.
+func (in *NetworkPolicy) DeepCopy() *NetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyList) DeepCopyInto(out *NetworkPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyList.
+func (in *NetworkPolicyList) DeepCopy() *NetworkPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyObservation) DeepCopyInto(out *NetworkPolicyObservation) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = new(NetworkPolicyPorts)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyObservation.
+func (in *NetworkPolicyObservation) DeepCopy() *NetworkPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyParameters) DeepCopyInto(out *NetworkPolicyParameters) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceRef != nil {
+		in, out := &in.SourceRef, &out.SourceRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceSelector != nil {
+		in, out := &in.SourceSelector, &out.SourceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationRef != nil {
+		in, out := &in.DestinationRef, &out.DestinationRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationSelector != nil {
+		in, out := &in.DestinationSelector, &out.DestinationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = new(NetworkPolicyPorts)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyParameters.
+func (in *NetworkPolicyParameters) DeepCopy() *NetworkPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyPorts) DeepCopyInto(out *NetworkPolicyPorts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyPorts.
+func (in *NetworkPolicyPorts) DeepCopy() *NetworkPolicyPorts {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyPorts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicySpec.
+func (in *NetworkPolicySpec) DeepCopy() *NetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyStatus) DeepCopyInto(out *NetworkPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyStatus.
+func (in *NetworkPolicyStatus) DeepCopy() *NetworkPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgMembers) DeepCopyInto(out *OrgMembers) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgMembers.
+func (in *OrgMembers) DeepCopy() *OrgMembers {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgMembers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrgMembers) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgMembersList) DeepCopyInto(out *OrgMembersList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrgMembers, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgMembersList.
+func (in *OrgMembersList) DeepCopy() *OrgMembersList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgMembersList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrgMembersList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgMembersParameters) DeepCopyInto(out *OrgMembersParameters) {
+	*out = *in
+	in.MemberList.DeepCopyInto(&out.MemberList)
+	in.OrgReference.DeepCopyInto(&out.OrgReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgMembersParameters.
+func (in *OrgMembersParameters) DeepCopy() *OrgMembersParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgMembersParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgMembersSpec) DeepCopyInto(out *OrgMembersSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgMembersSpec.
+func (in *OrgMembersSpec) DeepCopy() *OrgMembersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgMembersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgMembersStatus) DeepCopyInto(out *OrgMembersStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgMembersStatus.
+func (in *OrgMembersStatus) DeepCopy() *OrgMembersStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgMembersStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgObservation) DeepCopyInto(out *OrgObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(string)
+		**out = **in
+	}
+	if in.Suspended != nil {
+		in, out := &in.Suspended, &out.Suspended
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgObservation.
+func (in *OrgObservation) DeepCopy() *OrgObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgParameters) DeepCopyInto(out *OrgParameters) {
+	*out = *in
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
+	if in.Suspended != nil {
+		in, out := &in.Suspended, &out.Suspended
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgParameters.
+func (in *OrgParameters) DeepCopy() *OrgParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgQuota) DeepCopyInto(out *OrgQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuota.
+func (in *OrgQuota) DeepCopy() *OrgQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrgQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgQuotaInitParameters) DeepCopyInto(out *OrgQuotaInitParameters) {
+	*out = *in
+	if in.AllowPaidServicePlans != nil {
+		in, out := &in.AllowPaidServicePlans, &out.AllowPaidServicePlans
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstanceMemory != nil {
+		in, out := &in.InstanceMemory, &out.InstanceMemory
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Orgs != nil {
+		in, out := &in.Orgs, &out.Orgs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.TotalAppInstances != nil {
+		in, out := &in.TotalAppInstances, &out.TotalAppInstances
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalAppLogRateLimit != nil {
+		in, out := &in.TotalAppLogRateLimit, &out.TotalAppLogRateLimit
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalAppTasks != nil {
+		in, out := &in.TotalAppTasks, &out.TotalAppTasks
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalMemory != nil {
+		in, out := &in.TotalMemory, &out.TotalMemory
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalPrivateDomains != nil {
+		in, out := &in.TotalPrivateDomains, &out.TotalPrivateDomains
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalRoutePorts != nil {
+		in, out := &in.TotalRoutePorts, &out.TotalRoutePorts
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalRoutes != nil {
+		in, out := &in.TotalRoutes, &out.TotalRoutes
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalServiceKeys != nil {
+		in, out := &in.TotalServiceKeys, &out.TotalServiceKeys
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalServices != nil {
+		in, out := &in.TotalServices, &out.TotalServices
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaInitParameters.
+func (in *OrgQuotaInitParameters) DeepCopy() *OrgQuotaInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgQuotaInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgQuotaList) DeepCopyInto(out *OrgQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrgQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaList.
+func (in *OrgQuotaList) DeepCopy() *OrgQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrgQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgQuotaObservation) DeepCopyInto(out *OrgQuotaObservation) {
+	*out = *in
+	if in.AllowPaidServicePlans != nil {
+		in, out := &in.AllowPaidServicePlans, &out.AllowPaidServicePlans
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceMemory != nil {
+		in, out := &in.InstanceMemory, &out.InstanceMemory
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Orgs != nil {
+		in, out := &in.Orgs, &out.Orgs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.TotalAppInstances != nil {
+		in, out := &in.TotalAppInstances, &out.TotalAppInstances
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalAppLogRateLimit != nil {
+		in, out := &in.TotalAppLogRateLimit, &out.TotalAppLogRateLimit
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalAppTasks != nil {
+		in, out := &in.TotalAppTasks, &out.TotalAppTasks
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalMemory != nil {
+		in, out := &in.TotalMemory, &out.TotalMemory
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalPrivateDomains != nil {
+		in, out := &in.TotalPrivateDomains, &out.TotalPrivateDomains
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalRoutePorts != nil {
+		in, out := &in.TotalRoutePorts, &out.TotalRoutePorts
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalRoutes != nil {
+		in, out := &in.TotalRoutes, &out.TotalRoutes
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalServiceKeys != nil {
+		in, out := &in.TotalServiceKeys, &out.TotalServiceKeys
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalServices != nil {
+		in, out := &in.TotalServices, &out.TotalServices
+		*out = new(float64)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaObservation.
+func (in *OrgQuotaObservation) DeepCopy() *OrgQuotaObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgQuotaObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgQuotaParameters) DeepCopyInto(out *OrgQuotaParameters) {
+	*out = *in
+	if in.AllowPaidServicePlans != nil {
+		in, out := &in.AllowPaidServicePlans, &out.AllowPaidServicePlans
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstanceMemory != nil {
+		in, out := &in.InstanceMemory, &out.InstanceMemory
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Orgs != nil {
+		in, out := &in.Orgs, &out.Orgs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.TotalAppInstances != nil {
+		in, out := &in.TotalAppInstances, &out.TotalAppInstances
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalAppLogRateLimit != nil {
+		in, out := &in.TotalAppLogRateLimit, &out.TotalAppLogRateLimit
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalAppTasks != nil {
+		in, out := &in.TotalAppTasks, &out.TotalAppTasks
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalMemory != nil {
+		in, out := &in.TotalMemory, &out.TotalMemory
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalPrivateDomains != nil {
+		in, out := &in.TotalPrivateDomains, &out.TotalPrivateDomains
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalRoutePorts != nil {
+		in, out := &in.TotalRoutePorts, &out.TotalRoutePorts
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalRoutes != nil {
+		in, out := &in.TotalRoutes, &out.TotalRoutes
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalServiceKeys != nil {
+		in, out := &in.TotalServiceKeys, &out.TotalServiceKeys
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TotalServices != nil {
+		in, out := &in.TotalServices, &out.TotalServices
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaParameters.
+func (in *OrgQuotaParameters) DeepCopy() *OrgQuotaParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgQuotaParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgQuotaSpec) DeepCopyInto(out *OrgQuotaSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaSpec.
+func (in *OrgQuotaSpec) DeepCopy() *OrgQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgQuotaStatus) DeepCopyInto(out *OrgQuotaStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaStatus.
+func (in *OrgQuotaStatus) DeepCopy() *OrgQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgReference) DeepCopyInto(out *OrgReference) {
+	*out = *in
+	if in.Org != nil {
+		in, out := &in.Org, &out.Org
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgName != nil {
+		in, out := &in.OrgName, &out.OrgName
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgReference.
+func (in *OrgReference) DeepCopy() *OrgReference {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRole) DeepCopyInto(out *OrgRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRole.
+func (in *OrgRole) DeepCopy() *OrgRole {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrgRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRoleList) DeepCopyInto(out *OrgRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrgRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleList.
+func (in *OrgRoleList) DeepCopy() *OrgRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrgRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRoleObservation) DeepCopyInto(out *OrgRoleObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleObservation.
+func (in *OrgRoleObservation) DeepCopy() *OrgRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRoleParameters) DeepCopyInto(out *OrgRoleParameters) {
+	*out = *in
+	in.OrgReference.DeepCopyInto(&out.OrgReference)
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserRef != nil {
+		in, out := &in.UserRef, &out.UserRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleParameters.
+func (in *OrgRoleParameters) DeepCopy() *OrgRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRoleSpec) DeepCopyInto(out *OrgRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleSpec.
+func (in *OrgRoleSpec) DeepCopy() *OrgRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRoleStatus) DeepCopyInto(out *OrgRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleStatus.
+func (in *OrgRoleStatus) DeepCopy() *OrgRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgSpec) DeepCopyInto(out *OrgSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgSpec.
+func (in *OrgSpec) DeepCopy() *OrgSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgStatus) DeepCopyInto(out *OrgStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgStatus.
+func (in *OrgStatus) DeepCopy() *OrgStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Organization) DeepCopyInto(out *Organization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
+func (in *Organization) DeepCopy() *Organization {
+	if in == nil {
+		return nil
+	}
+	out := new(Organization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Organization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Organization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
+func (in *OrganizationList) DeepCopy() *OrganizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessConfiguration) DeepCopyInto(out *ProcessConfiguration) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiskQuota != nil {
+		in, out := &in.DiskQuota, &out.DiskQuota
+		*out = new(string)
+		**out = **in
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = new(uint)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(uint)
+		**out = **in
+	}
+	in.HealthCheckConfiguration.DeepCopyInto(&out.HealthCheckConfiguration)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessConfiguration.
+func (in *ProcessConfiguration) DeepCopy() *ProcessConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReadinessHealthCheckConfiguration) DeepCopyInto(out *ReadinessHealthCheckConfiguration) {
+	*out = *in
+	if in.ReadinessHealthCheckType != nil {
+		in, out := &in.ReadinessHealthCheckType, &out.ReadinessHealthCheckType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReadinessHealthCheckHTTPEndpoint != nil {
+		in, out := &in.ReadinessHealthCheckHTTPEndpoint, &out.ReadinessHealthCheckHTTPEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReadinessHealthCheckInterval != nil {
+		in, out := &in.ReadinessHealthCheckInterval, &out.ReadinessHealthCheckInterval
+		*out = new(uint)
+		**out = **in
+	}
+	if in.ReadinessHealthCheckInvocationTimeout != nil {
+		in, out := &in.ReadinessHealthCheckInvocationTimeout, &out.ReadinessHealthCheckInvocationTimeout
+		*out = new(uint)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadinessHealthCheckConfiguration.
+func (in *ReadinessHealthCheckConfiguration) DeepCopy() *ReadinessHealthCheckConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadinessHealthCheckConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Relation) DeepCopyInto(out *Relation) {
+	*out = *in
+	out.ServiceInstance = in.ServiceInstance
+	out.Route = in.Route
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Relation.
+func (in *Relation) DeepCopy() *Relation {
+	if in == nil {
+		return nil
+	}
+	out := new(Relation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Resource) DeepCopyInto(out *Resource) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resource.
+func (in *Resource) DeepCopy() *Resource {
+	if in == nil {
+		return nil
+	}
+	out := new(Resource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMetadata) DeepCopyInto(out *ResourceMetadata) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMetadata.
+func (in *ResourceMetadata) DeepCopy() *ResourceMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleAssignments) DeepCopyInto(out *RoleAssignments) {
+	*out = *in
+	if in.AssignedRoles != nil {
+		in, out := &in.AssignedRoles, &out.AssignedRoles
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleAssignments.
+func (in *RoleAssignments) DeepCopy() *RoleAssignments {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleAssignments)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RotationParameters) DeepCopyInto(out *RotationParameters) {
+	*out = *in
+	if in.Frequency != nil {
+		in, out := &in.Frequency, &out.Frequency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationParameters.
+func (in *RotationParameters) DeepCopy() *RotationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RotationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Route) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteConfiguration) DeepCopyInto(out *RouteConfiguration) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteRef != nil {
+		in, out := &in.RouteRef, &out.RouteRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouteSelector != nil {
+		in, out := &in.RouteSelector, &out.RouteSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfiguration.
+func (in *RouteConfiguration) DeepCopy() *RouteConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteDestination) DeepCopyInto(out *RouteDestination) {
+	*out = *in
+	if in.App != nil {
+		in, out := &in.App, &out.App
+		*out = new(RouteDestinationApp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteDestination.
+func (in *RouteDestination) DeepCopy() *RouteDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteDestinationApp) DeepCopyInto(out *RouteDestinationApp) {
+	*out = *in
+	if in.Process != nil {
+		in, out := &in.Process, &out.Process
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteDestinationApp.
+func (in *RouteDestinationApp) DeepCopy() *RouteDestinationApp {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteDestinationApp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteList) DeepCopyInto(out *RouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
+func (in *RouteList) DeepCopy() *RouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteObservation) DeepCopyInto(out *RouteObservation) {
+	*out = *in
+	in.Resource.DeepCopyInto(&out.Resource)
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(RouteOptions)
+		**out = **in
+	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]RouteDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Space != nil {
+		in, out := &in.Space, &out.Space
+		*out = new(string)
+		**out = **in
+	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteObservation.
+func (in *RouteObservation) DeepCopy() *RouteObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteOptions) DeepCopyInto(out *RouteOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteOptions.
+func (in *RouteOptions) DeepCopy() *RouteOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteParameters) DeepCopyInto(out *RouteParameters) {
+	*out = *in
+	in.SpaceReference.DeepCopyInto(&out.SpaceReference)
+	in.DomainReference.DeepCopyInto(&out.DomainReference)
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
 		**out = **in
 	}
-	if in.TotalPrivateDomains != nil {
-		in, out := &in.TotalPrivateDomains, &out.TotalPrivateDomains
-		*out = new(float64)
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
 		**out = **in
 	}
-	if in.TotalRoutePorts != nil {
-		in, out := &in.TotalRoutePorts, &out.TotalRoutePorts
-		*out = new(float64)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
 		**out = **in
 	}
-	if in.TotalRoutes != nil {
-		in, out := &in.TotalRoutes, &out.TotalRoutes
-		*out = new(float64)
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(RouteOptions)
 		**out = **in
 	}
-	if in.TotalServiceKeys != nil {
-		in, out := &in.TotalServiceKeys, &out.TotalServiceKeys
-		*out = new(float64)
-		**out = **in
+	if in.SharedSpaces != nil {
+		in, out := &in.SharedSpaces, &out.SharedSpaces
+		*out = make([]SpaceReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.TotalServices != nil {
-		in, out := &in.TotalServices, &out.TotalServices
-		*out = new(float64)
-		**out = **in
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteParameters.
+func (in *RouteParameters) DeepCopy() *RouteParameters {
+	if in == nil {
+		return nil
 	}
+	out := new(RouteParameters)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaParameters.
-func (in *OrgQuotaParameters) DeepCopy() *OrgQuotaParameters {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteReference) DeepCopyInto(out *RouteReference) {
+	*out = *in
+	if in.RouteRef != nil {
+		in, out := &in.RouteRef, &out.RouteRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouteSelector != nil {
+		in, out := &in.RouteSelector, &out.RouteSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteReference.
+func (in *RouteReference) DeepCopy() *RouteReference {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgQuotaParameters)
+	out := new(RouteReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgQuotaSpec) DeepCopyInto(out *OrgQuotaSpec) {
+func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
-	in.InitProvider.DeepCopyInto(&out.InitProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaSpec.
-func (in *OrgQuotaSpec) DeepCopy() *OrgQuotaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
+func (in *RouteSpec) DeepCopy() *RouteSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgQuotaSpec)
+	out := new(RouteSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgQuotaStatus) DeepCopyInto(out *OrgQuotaStatus) {
+func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgQuotaStatus.
-func (in *OrgQuotaStatus) DeepCopy() *OrgQuotaStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteStatus.
+func (in *RouteStatus) DeepCopy() *RouteStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgQuotaStatus)
+	out := new(RouteStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgReference) DeepCopyInto(out *OrgReference) {
+func (in *SCBResource) DeepCopyInto(out *SCBResource) {
 	*out = *in
-	if in.Org != nil {
-		in, out := &in.Org, &out.Org
-		*out = new(string)
-		**out = **in
-	}
-	if in.OrgName != nil {
-		in, out := &in.OrgName, &out.OrgName
-		*out = new(string)
-		**out = **in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.OrgRef != nil {
-		in, out := &in.OrgRef, &out.OrgRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCBResource.
+func (in *SCBResource) DeepCopy() *SCBResource {
+	if in == nil {
+		return nil
 	}
-	if in.OrgSelector != nil {
-		in, out := &in.OrgSelector, &out.OrgSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	out := new(SCBResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
+	*out = *in
+	if in.SecretReference != nil {
+		in, out := &in.SecretReference, &out.SecretReference
+		*out = new(v1.SecretReference)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgReference.
-func (in *OrgReference) DeepCopy() *OrgReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
+func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgReference)
+	out := new(SecretKeySelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgRole) DeepCopyInto(out *OrgRole) {
+func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1299,18 +3225,18 @@ func (in *OrgRole) DeepCopyInto(out *OrgRole) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRole.
-func (in *OrgRole) DeepCopy() *OrgRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
+func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgRole)
+	out := new(SecurityGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrgRole) DeepCopyObject() runtime.Object {
+func (in *SecurityGroup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1318,31 +3244,46 @@ func (in *OrgRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgRoleList) DeepCopyInto(out *OrgRoleList) {
+func (in *SecurityGroupGloballyEnabled) DeepCopyInto(out *SecurityGroupGloballyEnabled) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupGloballyEnabled.
+func (in *SecurityGroupGloballyEnabled) DeepCopy() *SecurityGroupGloballyEnabled {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupGloballyEnabled)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupList) DeepCopyInto(out *SecurityGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OrgRole, len(*in))
+		*out = make([]SecurityGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleList.
-func (in *OrgRoleList) DeepCopy() *OrgRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupList.
+func (in *SecurityGroupList) DeepCopy() *SecurityGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgRoleList)
+	out := new(SecurityGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrgRoleList) DeepCopyObject() runtime.Object {
+func (in *SecurityGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1350,25 +3291,38 @@ func (in *OrgRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgRoleObservation) DeepCopyInto(out *OrgRoleObservation) {
+func (in *SecurityGroupObservation) DeepCopyInto(out *SecurityGroupObservation) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = new(string)
-		**out = **in
-	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Origin != nil {
-		in, out := &in.Origin, &out.Origin
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.GloballyEnabled = in.GloballyEnabled
+	if in.RunningSpaces != nil {
+		in, out := &in.RunningSpaces, &out.RunningSpaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StagingSpaces != nil {
+		in, out := &in.StagingSpaces, &out.StagingSpaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = new(string)
 		**out = **in
 	}
@@ -1377,119 +3331,162 @@ func (in *OrgRoleObservation) DeepCopyInto(out *OrgRoleObservation) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.User != nil {
-		in, out := &in.User, &out.User
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupObservation.
+func (in *SecurityGroupObservation) DeepCopy() *SecurityGroupObservation {
+	if in == nil {
+		return nil
 	}
-	if in.Username != nil {
-		in, out := &in.Username, &out.Username
-		*out = new(string)
-		**out = **in
+	out := new(SecurityGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupParameters) DeepCopyInto(out *SecurityGroupParameters) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.GloballyEnabled = in.GloballyEnabled
+	if in.RunningSpaces != nil {
+		in, out := &in.RunningSpaces, &out.RunningSpaces
+		*out = make([]SpaceReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StagingSpaces != nil {
+		in, out := &in.StagingSpaces, &out.StagingSpaces
+		*out = make([]SpaceReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleObservation.
-func (in *OrgRoleObservation) DeepCopy() *OrgRoleObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupParameters.
+func (in *SecurityGroupParameters) DeepCopy() *SecurityGroupParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgRoleObservation)
+	out := new(SecurityGroupParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgRoleParameters) DeepCopyInto(out *OrgRoleParameters) {
+func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
 	*out = *in
-	in.OrgReference.DeepCopyInto(&out.OrgReference)
-	if in.Origin != nil {
-		in, out := &in.Origin, &out.Origin
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(int)
+		**out = **in
+	}
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(int)
+		**out = **in
+	}
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleParameters.
-func (in *OrgRoleParameters) DeepCopy() *OrgRoleParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
+func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgRoleParameters)
+	out := new(SecurityGroupRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgRoleSpec) DeepCopyInto(out *OrgRoleSpec) {
+func (in *SecurityGroupSpec) DeepCopyInto(out *SecurityGroupSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleSpec.
-func (in *OrgRoleSpec) DeepCopy() *OrgRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupSpec.
+func (in *SecurityGroupSpec) DeepCopy() *SecurityGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgRoleSpec)
+	out := new(SecurityGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgRoleStatus) DeepCopyInto(out *OrgRoleStatus) {
+func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleStatus.
-func (in *OrgRoleStatus) DeepCopy() *OrgRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupStatus.
+func (in *SecurityGroupStatus) DeepCopy() *SecurityGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgRoleStatus)
+	out := new(SecurityGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgSpec) DeepCopyInto(out *OrgSpec) {
+func (in *ServiceBindingConfiguration) DeepCopyInto(out *ServiceBindingConfiguration) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgSpec.
-func (in *OrgSpec) DeepCopy() *OrgSpec {
-	if in == nil {
-		return nil
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceInstanceRef != nil {
+		in, out := &in.ServiceInstanceRef, &out.ServiceInstanceRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(OrgSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgStatus) DeepCopyInto(out *OrgStatus) {
-	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.ServiceInstanceSelector != nil {
+		in, out := &in.ServiceInstanceSelector, &out.ServiceInstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Parameters.DeepCopyInto(&out.Parameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgStatus.
-func (in *OrgStatus) DeepCopy() *OrgStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingConfiguration.
+func (in *ServiceBindingConfiguration) DeepCopy() *ServiceBindingConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgStatus)
+	out := new(ServiceBindingConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Organization) DeepCopyInto(out *Organization) {
+func (in *ServiceBroker) DeepCopyInto(out *ServiceBroker) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1497,18 +3494,18 @@ func (in *Organization) DeepCopyInto(out *Organization) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
-func (in *Organization) DeepCopy() *Organization {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBroker.
+func (in *ServiceBroker) DeepCopy() *ServiceBroker {
 	if in == nil {
 		return nil
 	}
-	out := new(Organization)
+	out := new(ServiceBroker)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Organization) DeepCopyObject() runtime.Object {
+func (in *ServiceBroker) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1516,31 +3513,46 @@ func (in *Organization) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+func (in *ServiceBrokerJob) DeepCopyInto(out *ServiceBrokerJob) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBrokerJob.
+func (in *ServiceBrokerJob) DeepCopy() *ServiceBrokerJob {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBrokerJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBrokerList) DeepCopyInto(out *ServiceBrokerList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Organization, len(*in))
+		*out = make([]ServiceBroker, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
-func (in *OrganizationList) DeepCopy() *OrganizationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBrokerList.
+func (in *ServiceBrokerList) DeepCopy() *ServiceBrokerList {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationList)
+	out := new(ServiceBrokerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrganizationList) DeepCopyObject() runtime.Object {
+func (in *ServiceBrokerList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1548,365 +3560,362 @@ func (in *OrganizationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessConfiguration) DeepCopyInto(out *ProcessConfiguration) {
+func (in *ServiceBrokerObservation) DeepCopyInto(out *ServiceBrokerObservation) {
 	*out = *in
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Command != nil {
-		in, out := &in.Command, &out.Command
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.DiskQuota != nil {
-		in, out := &in.DiskQuota, &out.DiskQuota
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
 		*out = new(string)
 		**out = **in
 	}
-	if in.Instances != nil {
-		in, out := &in.Instances, &out.Instances
-		*out = new(uint)
-		**out = **in
-	}
-	if in.Memory != nil {
-		in, out := &in.Memory, &out.Memory
+	if in.Space != nil {
+		in, out := &in.Space, &out.Space
 		*out = new(string)
 		**out = **in
 	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(uint)
+	if in.LastJob != nil {
+		in, out := &in.LastJob, &out.LastJob
+		*out = new(ServiceBrokerJob)
 		**out = **in
 	}
-	in.HealthCheckConfiguration.DeepCopyInto(&out.HealthCheckConfiguration)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessConfiguration.
-func (in *ProcessConfiguration) DeepCopy() *ProcessConfiguration {
-	if in == nil {
-		return nil
-	}
-	out := new(ProcessConfiguration)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReadinessHealthCheckConfiguration) DeepCopyInto(out *ReadinessHealthCheckConfiguration) {
-	*out = *in
-	if in.ReadinessHealthCheckType != nil {
-		in, out := &in.ReadinessHealthCheckType, &out.ReadinessHealthCheckType
+	if in.CredentialsVersion != nil {
+		in, out := &in.CredentialsVersion, &out.CredentialsVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.ReadinessHealthCheckHTTPEndpoint != nil {
-		in, out := &in.ReadinessHealthCheckHTTPEndpoint, &out.ReadinessHealthCheckHTTPEndpoint
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = new(string)
 		**out = **in
 	}
-	if in.ReadinessHealthCheckInterval != nil {
-		in, out := &in.ReadinessHealthCheckInterval, &out.ReadinessHealthCheckInterval
-		*out = new(uint)
-		**out = **in
-	}
-	if in.ReadinessHealthCheckInvocationTimeout != nil {
-		in, out := &in.ReadinessHealthCheckInvocationTimeout, &out.ReadinessHealthCheckInvocationTimeout
-		*out = new(uint)
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
 		**out = **in
 	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadinessHealthCheckConfiguration.
-func (in *ReadinessHealthCheckConfiguration) DeepCopy() *ReadinessHealthCheckConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBrokerObservation.
+func (in *ServiceBrokerObservation) DeepCopy() *ServiceBrokerObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ReadinessHealthCheckConfiguration)
+	out := new(ServiceBrokerObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Relation) DeepCopyInto(out *Relation) {
+func (in *ServiceBrokerParameters) DeepCopyInto(out *ServiceBrokerParameters) {
 	*out = *in
-	out.ServiceInstance = in.ServiceInstance
-	out.Route = in.Route
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	in.SpaceReference.DeepCopyInto(&out.SpaceReference)
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Relation.
-func (in *Relation) DeepCopy() *Relation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBrokerParameters.
+func (in *ServiceBrokerParameters) DeepCopy() *ServiceBrokerParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(Relation)
+	out := new(ServiceBrokerParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Resource) DeepCopyInto(out *Resource) {
+func (in *ServiceBrokerSpec) DeepCopyInto(out *ServiceBrokerSpec) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = new(string)
-		**out = **in
-	}
-	if in.UpdatedAt != nil {
-		in, out := &in.UpdatedAt, &out.UpdatedAt
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resource.
-func (in *Resource) DeepCopy() *Resource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBrokerSpec.
+func (in *ServiceBrokerSpec) DeepCopy() *ServiceBrokerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Resource)
+	out := new(ServiceBrokerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceMetadata) DeepCopyInto(out *ResourceMetadata) {
+func (in *ServiceBrokerStatus) DeepCopyInto(out *ServiceBrokerStatus) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]*string, len(*in))
-		for key, val := range *in {
-			var outVal *string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = new(string)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
-	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]*string, len(*in))
-		for key, val := range *in {
-			var outVal *string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = new(string)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMetadata.
-func (in *ResourceMetadata) DeepCopy() *ResourceMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBrokerStatus.
+func (in *ServiceBrokerStatus) DeepCopy() *ServiceBrokerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceMetadata)
+	out := new(ServiceBrokerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleAssignments) DeepCopyInto(out *RoleAssignments) {
+func (in *ServiceCredentialBinding) DeepCopyInto(out *ServiceCredentialBinding) {
 	*out = *in
-	if in.AssignedRoles != nil {
-		in, out := &in.AssignedRoles, &out.AssignedRoles
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleAssignments.
-func (in *RoleAssignments) DeepCopy() *RoleAssignments {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBinding.
+func (in *ServiceCredentialBinding) DeepCopy() *ServiceCredentialBinding {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleAssignments)
+	out := new(ServiceCredentialBinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceCredentialBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RotationParameters) DeepCopyInto(out *RotationParameters) {
+func (in *ServiceCredentialBindingList) DeepCopyInto(out *ServiceCredentialBindingList) {
 	*out = *in
-	if in.Frequency != nil {
-		in, out := &in.Frequency, &out.Frequency
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.TTL != nil {
-		in, out := &in.TTL, &out.TTL
-		*out = new(metav1.Duration)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceCredentialBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationParameters.
-func (in *RotationParameters) DeepCopy() *RotationParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBindingList.
+func (in *ServiceCredentialBindingList) DeepCopy() *ServiceCredentialBindingList {
 	if in == nil {
 		return nil
 	}
-	out := new(RotationParameters)
+	out := new(ServiceCredentialBindingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceCredentialBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route) DeepCopyInto(out *Route) {
+func (in *ServiceCredentialBindingObservation) DeepCopyInto(out *ServiceCredentialBindingObservation) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.SCBResource.DeepCopyInto(&out.SCBResource)
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(LastOperation)
+		**out = **in
+	}
+	if in.RetiredKeys != nil {
+		in, out := &in.RetiredKeys, &out.RetiredKeys
+		*out = make([]*SCBResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SCBResource)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
-func (in *Route) DeepCopy() *Route {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBindingObservation.
+func (in *ServiceCredentialBindingObservation) DeepCopy() *ServiceCredentialBindingObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(Route)
+	out := new(ServiceCredentialBindingObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Route) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteConfiguration) DeepCopyInto(out *RouteConfiguration) {
+func (in *ServiceCredentialBindingParameters) DeepCopyInto(out *ServiceCredentialBindingParameters) {
 	*out = *in
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Route != nil {
-		in, out := &in.Route, &out.Route
+	if in.ServiceInstance != nil {
+		in, out := &in.ServiceInstance, &out.ServiceInstance
 		*out = new(string)
 		**out = **in
 	}
-	if in.RouteRef != nil {
-		in, out := &in.RouteRef, &out.RouteRef
+	if in.ServiceInstanceRef != nil {
+		in, out := &in.ServiceInstanceRef, &out.ServiceInstanceRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.RouteSelector != nil {
-		in, out := &in.RouteSelector, &out.RouteSelector
+	if in.ServiceInstanceSelector != nil {
+		in, out := &in.ServiceInstanceSelector, &out.ServiceInstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.App != nil {
+		in, out := &in.App, &out.App
+		*out = new(string)
+		**out = **in
+	}
+	if in.AppRef != nil {
+		in, out := &in.AppRef, &out.AppRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppSelector != nil {
+		in, out := &in.AppSelector, &out.AppSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParametersSecretRef != nil {
+		in, out := &in.ParametersSecretRef, &out.ParametersSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(RotationParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfiguration.
-func (in *RouteConfiguration) DeepCopy() *RouteConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBindingParameters.
+func (in *ServiceCredentialBindingParameters) DeepCopy() *ServiceCredentialBindingParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteConfiguration)
+	out := new(ServiceCredentialBindingParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteDestination) DeepCopyInto(out *RouteDestination) {
+func (in *ServiceCredentialBindingSpec) DeepCopyInto(out *ServiceCredentialBindingSpec) {
 	*out = *in
-	if in.App != nil {
-		in, out := &in.App, &out.App
-		*out = new(RouteDestinationApp)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteDestination.
-func (in *RouteDestination) DeepCopy() *RouteDestination {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBindingSpec.
+func (in *ServiceCredentialBindingSpec) DeepCopy() *ServiceCredentialBindingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteDestination)
+	out := new(ServiceCredentialBindingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteDestinationApp) DeepCopyInto(out *RouteDestinationApp) {
+func (in *ServiceCredentialBindingStatus) DeepCopyInto(out *ServiceCredentialBindingStatus) {
 	*out = *in
-	if in.Process != nil {
-		in, out := &in.Process, &out.Process
-		*out = new(string)
-		**out = **in
-	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int)
-		**out = **in
-	}
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
-		*out = new(string)
-		**out = **in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBindingStatus.
+func (in *ServiceCredentialBindingStatus) DeepCopy() *ServiceCredentialBindingStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(ServiceCredentialBindingStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteDestinationApp.
-func (in *RouteDestinationApp) DeepCopy() *RouteDestinationApp {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInstance) DeepCopyInto(out *ServiceInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstance.
+func (in *ServiceInstance) DeepCopy() *ServiceInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteDestinationApp)
+	out := new(ServiceInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteList) DeepCopyInto(out *RouteList) {
+func (in *ServiceInstanceList) DeepCopyInto(out *ServiceInstanceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Route, len(*in))
+		*out = make([]ServiceInstance, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
-func (in *RouteList) DeepCopy() *RouteList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceList.
+func (in *ServiceInstanceList) DeepCopy() *ServiceInstanceList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteList)
+	out := new(ServiceInstanceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteList) DeepCopyObject() runtime.Object {
+func (in *ServiceInstanceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1914,238 +3923,199 @@ func (in *RouteList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteObservation) DeepCopyInto(out *RouteObservation) {
+func (in *ServiceInstanceObservation) DeepCopyInto(out *ServiceInstanceObservation) {
 	*out = *in
-	in.Resource.DeepCopyInto(&out.Resource)
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Host != nil {
-		in, out := &in.Host, &out.Host
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
+	if in.Space != nil {
+		in, out := &in.Space, &out.Space
 		*out = new(string)
 		**out = **in
 	}
-	if in.URL != nil {
-		in, out := &in.URL, &out.URL
+	if in.ServicePlan != nil {
+		in, out := &in.ServicePlan, &out.ServicePlan
 		*out = new(string)
 		**out = **in
 	}
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = new(RouteOptions)
-		**out = **in
+	in.Parameters.DeepCopyInto(&out.Parameters)
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
 	}
-	if in.Destinations != nil {
-		in, out := &in.Destinations, &out.Destinations
-		*out = make([]RouteDestination, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LastAsyncJob != nil {
+		in, out := &in.LastAsyncJob, &out.LastAsyncJob
+		*out = new(string)
+		**out = **in
 	}
 	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteObservation.
-func (in *RouteObservation) DeepCopy() *RouteObservation {
-	if in == nil {
-		return nil
+	out.LastOperation = in.LastOperation
+	in.MaintenanceInfo.DeepCopyInto(&out.MaintenanceInfo)
+	if in.DashboardURL != nil {
+		in, out := &in.DashboardURL, &out.DashboardURL
+		*out = new(string)
+		**out = **in
 	}
-	out := new(RouteObservation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteOptions) DeepCopyInto(out *RouteOptions) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteOptions.
-func (in *RouteOptions) DeepCopy() *RouteOptions {
-	if in == nil {
-		return nil
+	if in.RouteServiceURL != nil {
+		in, out := &in.RouteServiceURL, &out.RouteServiceURL
+		*out = new(string)
+		**out = **in
 	}
-	out := new(RouteOptions)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteParameters) DeepCopyInto(out *RouteParameters) {
-	*out = *in
-	in.SpaceReference.DeepCopyInto(&out.SpaceReference)
-	in.DomainReference.DeepCopyInto(&out.DomainReference)
-	if in.Host != nil {
-		in, out := &in.Host, &out.Host
+	if in.SyslogDrainURL != nil {
+		in, out := &in.SyslogDrainURL, &out.SyslogDrainURL
 		*out = new(string)
 		**out = **in
 	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = new(string)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int)
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
 		**out = **in
 	}
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = new(RouteOptions)
+	if in.UpgradeAvailable != nil {
+		in, out := &in.UpgradeAvailable, &out.UpgradeAvailable
+		*out = new(bool)
 		**out = **in
 	}
-	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteParameters.
-func (in *RouteParameters) DeepCopy() *RouteParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceObservation.
+func (in *ServiceInstanceObservation) DeepCopy() *ServiceInstanceObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteParameters)
+	out := new(ServiceInstanceObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteReference) DeepCopyInto(out *RouteReference) {
+func (in *ServiceInstanceParameters) DeepCopyInto(out *ServiceInstanceParameters) {
 	*out = *in
-	if in.RouteRef != nil {
-		in, out := &in.RouteRef, &out.RouteRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
 	}
-	if in.RouteSelector != nil {
-		in, out := &in.RouteSelector, &out.RouteSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	in.SpaceReference.DeepCopyInto(&out.SpaceReference)
+	in.Managed.DeepCopyInto(&out.Managed)
+	in.UserProvided.DeepCopyInto(&out.UserProvided)
+	in.Timeouts.DeepCopyInto(&out.Timeouts)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteReference.
-func (in *RouteReference) DeepCopy() *RouteReference {
-	if in == nil {
-		return nil
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
+	if in.SharedSpaces != nil {
+		in, out := &in.SharedSpaces, &out.SharedSpaces
+		*out = make([]SpaceReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(RouteReference)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
-	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
-func (in *RouteSpec) DeepCopy() *RouteSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceParameters.
+func (in *ServiceInstanceParameters) DeepCopy() *ServiceInstanceParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteSpec)
+	out := new(ServiceInstanceParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
+func (in *ServiceInstanceReference) DeepCopyInto(out *ServiceInstanceReference) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteStatus.
-func (in *RouteStatus) DeepCopy() *RouteStatus {
-	if in == nil {
-		return nil
+	if in.ServiceInstanceRef != nil {
+		in, out := &in.ServiceInstanceRef, &out.ServiceInstanceRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(RouteStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SCBResource) DeepCopyInto(out *SCBResource) {
-	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
+	if in.ServiceInstanceSelector != nil {
+		in, out := &in.ServiceInstanceSelector, &out.ServiceInstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCBResource.
-func (in *SCBResource) DeepCopy() *SCBResource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceReference.
+func (in *ServiceInstanceReference) DeepCopy() *ServiceInstanceReference {
 	if in == nil {
 		return nil
 	}
-	out := new(SCBResource)
+	out := new(ServiceInstanceReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
+func (in *ServiceInstanceSpec) DeepCopyInto(out *ServiceInstanceSpec) {
 	*out = *in
-	if in.SecretReference != nil {
-		in, out := &in.SecretReference, &out.SecretReference
-		*out = new(v1.SecretReference)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
-func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceSpec.
+func (in *ServiceInstanceSpec) DeepCopy() *ServiceInstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretKeySelector)
+	out := new(ServiceInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceBindingConfiguration) DeepCopyInto(out *ServiceBindingConfiguration) {
+func (in *ServiceInstanceStatus) DeepCopyInto(out *ServiceInstanceStatus) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.ServiceInstanceRef != nil {
-		in, out := &in.ServiceInstanceRef, &out.ServiceInstanceRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ServiceInstanceSelector != nil {
-		in, out := &in.ServiceInstanceSelector, &out.ServiceInstanceSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
-	}
-	in.Parameters.DeepCopyInto(&out.Parameters)
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingConfiguration.
-func (in *ServiceBindingConfiguration) DeepCopy() *ServiceBindingConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceStatus.
+func (in *ServiceInstanceStatus) DeepCopy() *ServiceInstanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceBindingConfiguration)
+	out := new(ServiceInstanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceCredentialBinding) DeepCopyInto(out *ServiceCredentialBinding) {
+func (in *ServiceOffering) DeepCopyInto(out *ServiceOffering) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2153,18 +4123,18 @@ func (in *ServiceCredentialBinding) DeepCopyInto(out *ServiceCredentialBinding)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBinding.
-func (in *ServiceCredentialBinding) DeepCopy() *ServiceCredentialBinding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOffering.
+func (in *ServiceOffering) DeepCopy() *ServiceOffering {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceCredentialBinding)
+	out := new(ServiceOffering)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceCredentialBinding) DeepCopyObject() runtime.Object {
+func (in *ServiceOffering) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2172,31 +4142,31 @@ func (in *ServiceCredentialBinding) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceCredentialBindingList) DeepCopyInto(out *ServiceCredentialBindingList) {
+func (in *ServiceOfferingList) DeepCopyInto(out *ServiceOfferingList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ServiceCredentialBinding, len(*in))
+		*out = make([]ServiceOffering, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBindingList.
-func (in *ServiceCredentialBindingList) DeepCopy() *ServiceCredentialBindingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOfferingList.
+func (in *ServiceOfferingList) DeepCopy() *ServiceOfferingList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceCredentialBindingList)
+	out := new(ServiceOfferingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceCredentialBindingList) DeepCopyObject() runtime.Object {
+func (in *ServiceOfferingList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2204,140 +4174,137 @@ func (in *ServiceCredentialBindingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceCredentialBindingObservation) DeepCopyInto(out *ServiceCredentialBindingObservation) {
+func (in *ServiceOfferingObservation) DeepCopyInto(out *ServiceOfferingObservation) {
 	*out = *in
-	in.SCBResource.DeepCopyInto(&out.SCBResource)
-	if in.LastOperation != nil {
-		in, out := &in.LastOperation, &out.LastOperation
-		*out = new(LastOperation)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
 		**out = **in
 	}
-	if in.RetiredKeys != nil {
-		in, out := &in.RetiredKeys, &out.RetiredKeys
-		*out = make([]*SCBResource, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(SCBResource)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBindingObservation.
-func (in *ServiceCredentialBindingObservation) DeepCopy() *ServiceCredentialBindingObservation {
-	if in == nil {
-		return nil
-	}
-	out := new(ServiceCredentialBindingObservation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceCredentialBindingParameters) DeepCopyInto(out *ServiceCredentialBindingParameters) {
-	*out = *in
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.ServiceInstance != nil {
-		in, out := &in.ServiceInstance, &out.ServiceInstance
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.ServiceInstanceRef != nil {
-		in, out := &in.ServiceInstanceRef, &out.ServiceInstanceRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.Available != nil {
+		in, out := &in.Available, &out.Available
+		*out = new(bool)
+		**out = **in
 	}
-	if in.ServiceInstanceSelector != nil {
-		in, out := &in.ServiceInstanceSelector, &out.ServiceInstanceSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.App != nil {
-		in, out := &in.App, &out.App
+	if in.Requires != nil {
+		in, out := &in.Requires, &out.Requires
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Shareable != nil {
+		in, out := &in.Shareable, &out.Shareable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DocumentationURL != nil {
+		in, out := &in.DocumentationURL, &out.DocumentationURL
 		*out = new(string)
 		**out = **in
 	}
-	if in.AppRef != nil {
-		in, out := &in.AppRef, &out.AppRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.BrokerCatalogID != nil {
+		in, out := &in.BrokerCatalogID, &out.BrokerCatalogID
+		*out = new(string)
+		**out = **in
 	}
-	if in.AppSelector != nil {
-		in, out := &in.AppSelector, &out.AppSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	if in.ServiceBroker != nil {
+		in, out := &in.ServiceBroker, &out.ServiceBroker
+		*out = new(string)
+		**out = **in
 	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
 	}
-	if in.ParametersSecretRef != nil {
-		in, out := &in.ParametersSecretRef, &out.ParametersSecretRef
-		*out = new(v1.SecretReference)
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOfferingObservation.
+func (in *ServiceOfferingObservation) DeepCopy() *ServiceOfferingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceOfferingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceOfferingParameters) DeepCopyInto(out *ServiceOfferingParameters) {
+	*out = *in
+	if in.ServiceBrokerName != nil {
+		in, out := &in.ServiceBrokerName, &out.ServiceBrokerName
+		*out = new(string)
 		**out = **in
 	}
-	if in.Rotation != nil {
-		in, out := &in.Rotation, &out.Rotation
-		*out = new(RotationParameters)
-		(*in).DeepCopyInto(*out)
-	}
-	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBindingParameters.
-func (in *ServiceCredentialBindingParameters) DeepCopy() *ServiceCredentialBindingParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOfferingParameters.
+func (in *ServiceOfferingParameters) DeepCopy() *ServiceOfferingParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceCredentialBindingParameters)
+	out := new(ServiceOfferingParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceCredentialBindingSpec) DeepCopyInto(out *ServiceCredentialBindingSpec) {
+func (in *ServiceOfferingSpec) DeepCopyInto(out *ServiceOfferingSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBindingSpec.
-func (in *ServiceCredentialBindingSpec) DeepCopy() *ServiceCredentialBindingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOfferingSpec.
+func (in *ServiceOfferingSpec) DeepCopy() *ServiceOfferingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceCredentialBindingSpec)
+	out := new(ServiceOfferingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceCredentialBindingStatus) DeepCopyInto(out *ServiceCredentialBindingStatus) {
+func (in *ServiceOfferingStatus) DeepCopyInto(out *ServiceOfferingStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCredentialBindingStatus.
-func (in *ServiceCredentialBindingStatus) DeepCopy() *ServiceCredentialBindingStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOfferingStatus.
+func (in *ServiceOfferingStatus) DeepCopy() *ServiceOfferingStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceCredentialBindingStatus)
+	out := new(ServiceOfferingStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceInstance) DeepCopyInto(out *ServiceInstance) {
+func (in *ServicePlan) DeepCopyInto(out *ServicePlan) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2345,18 +4312,18 @@ func (in *ServiceInstance) DeepCopyInto(out *ServiceInstance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstance.
-func (in *ServiceInstance) DeepCopy() *ServiceInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlan.
+func (in *ServicePlan) DeepCopy() *ServicePlan {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceInstance)
+	out := new(ServicePlan)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceInstance) DeepCopyObject() runtime.Object {
+func (in *ServicePlan) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2364,31 +4331,46 @@ func (in *ServiceInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceInstanceList) DeepCopyInto(out *ServiceInstanceList) {
+func (in *ServicePlanCost) DeepCopyInto(out *ServicePlanCost) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanCost.
+func (in *ServicePlanCost) DeepCopy() *ServicePlanCost {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlanCost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlanList) DeepCopyInto(out *ServicePlanList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ServiceInstance, len(*in))
+		*out = make([]ServicePlan, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceList.
-func (in *ServiceInstanceList) DeepCopy() *ServiceInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanList.
+func (in *ServicePlanList) DeepCopy() *ServicePlanList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceInstanceList)
+	out := new(ServicePlanList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceInstanceList) DeepCopyObject() runtime.Object {
+func (in *ServicePlanList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2396,7 +4378,7 @@ func (in *ServiceInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceInstanceObservation) DeepCopyInto(out *ServiceInstanceObservation) {
+func (in *ServicePlanObservation) DeepCopyInto(out *ServicePlanObservation) {
 	*out = *in
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
@@ -2408,211 +4390,329 @@ func (in *ServiceInstanceObservation) DeepCopyInto(out *ServiceInstanceObservati
 		*out = new(string)
 		**out = **in
 	}
-	if in.Space != nil {
-		in, out := &in.Space, &out.Space
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.ServicePlan != nil {
-		in, out := &in.ServicePlan, &out.ServicePlan
+	if in.Available != nil {
+		in, out := &in.Available, &out.Available
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Free != nil {
+		in, out := &in.Free, &out.Free
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VisibilityType != nil {
+		in, out := &in.VisibilityType, &out.VisibilityType
 		*out = new(string)
 		**out = **in
 	}
-	in.Parameters.DeepCopyInto(&out.Parameters)
-	if in.Credentials != nil {
-		in, out := &in.Credentials, &out.Credentials
-		*out = make([]byte, len(*in))
+	if in.Costs != nil {
+		in, out := &in.Costs, &out.Costs
+		*out = make([]ServicePlanCost, len(*in))
 		copy(*out, *in)
 	}
-	if in.LastAsyncJob != nil {
-		in, out := &in.LastAsyncJob, &out.LastAsyncJob
+	in.MaintenanceInfo.DeepCopyInto(&out.MaintenanceInfo)
+	in.Schemas.DeepCopyInto(&out.Schemas)
+	if in.BrokerCatalogID != nil {
+		in, out := &in.BrokerCatalogID, &out.BrokerCatalogID
 		*out = new(string)
 		**out = **in
 	}
-	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
-	out.LastOperation = in.LastOperation
-	in.MaintenanceInfo.DeepCopyInto(&out.MaintenanceInfo)
-	if in.DashboardURL != nil {
-		in, out := &in.DashboardURL, &out.DashboardURL
+	if in.ServiceOffering != nil {
+		in, out := &in.ServiceOffering, &out.ServiceOffering
 		*out = new(string)
 		**out = **in
 	}
-	if in.RouteServiceURL != nil {
-		in, out := &in.RouteServiceURL, &out.RouteServiceURL
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = new(string)
 		**out = **in
 	}
-	if in.SyslogDrainURL != nil {
-		in, out := &in.SyslogDrainURL, &out.SyslogDrainURL
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
 		*out = new(string)
 		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanObservation.
+func (in *ServicePlanObservation) DeepCopy() *ServicePlanObservation {
+	if in == nil {
+		return nil
 	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
+	out := new(ServicePlanObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlanParameters) DeepCopyInto(out *ServicePlanParameters) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.UpdatedAt != nil {
-		in, out := &in.UpdatedAt, &out.UpdatedAt
+	if in.Offering != nil {
+		in, out := &in.Offering, &out.Offering
 		*out = new(string)
 		**out = **in
 	}
-	if in.UpgradeAvailable != nil {
-		in, out := &in.UpgradeAvailable, &out.UpgradeAvailable
-		*out = new(bool)
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceObservation.
-func (in *ServiceInstanceObservation) DeepCopy() *ServiceInstanceObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanParameters.
+func (in *ServicePlanParameters) DeepCopy() *ServicePlanParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceInstanceObservation)
+	out := new(ServicePlanParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceInstanceParameters) DeepCopyInto(out *ServiceInstanceParameters) {
+func (in *ServicePlanSchemas) DeepCopyInto(out *ServicePlanSchemas) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
+	if in.ServiceInstanceCreate != nil {
+		in, out := &in.ServiceInstanceCreate, &out.ServiceInstanceCreate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
 	}
-	in.SpaceReference.DeepCopyInto(&out.SpaceReference)
-	in.Managed.DeepCopyInto(&out.Managed)
-	in.UserProvided.DeepCopyInto(&out.UserProvided)
-	in.Timeouts.DeepCopyInto(&out.Timeouts)
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.ServiceInstanceUpdate != nil {
+		in, out := &in.ServiceInstanceUpdate, &out.ServiceInstanceUpdate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
 	}
-	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
-	if in.SharedSpaces != nil {
-		in, out := &in.SharedSpaces, &out.SharedSpaces
-		*out = make([]SpaceReference, len(*in))
+	if in.ServiceBindingCreate != nil {
+		in, out := &in.ServiceBindingCreate, &out.ServiceBindingCreate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanSchemas.
+func (in *ServicePlanSchemas) DeepCopy() *ServicePlanSchemas {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlanSchemas)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlanSpec) DeepCopyInto(out *ServicePlanSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanSpec.
+func (in *ServicePlanSpec) DeepCopy() *ServicePlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlanStatus) DeepCopyInto(out *ServicePlanStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanStatus.
+func (in *ServicePlanStatus) DeepCopy() *ServicePlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlanVisibility) DeepCopyInto(out *ServicePlanVisibility) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanVisibility.
+func (in *ServicePlanVisibility) DeepCopy() *ServicePlanVisibility {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlanVisibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServicePlanVisibility) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlanVisibilityList) DeepCopyInto(out *ServicePlanVisibilityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServicePlanVisibility, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceParameters.
-func (in *ServiceInstanceParameters) DeepCopy() *ServiceInstanceParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanVisibilityList.
+func (in *ServicePlanVisibilityList) DeepCopy() *ServicePlanVisibilityList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceInstanceParameters)
+	out := new(ServicePlanVisibilityList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServicePlanVisibilityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceInstanceReference) DeepCopyInto(out *ServiceInstanceReference) {
+func (in *ServicePlanVisibilityObservation) DeepCopyInto(out *ServicePlanVisibilityObservation) {
 	*out = *in
-	if in.ServiceInstanceRef != nil {
-		in, out := &in.ServiceInstanceRef, &out.ServiceInstanceRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.ServicePlan != nil {
+		in, out := &in.ServicePlan, &out.ServicePlan
+		*out = new(string)
+		**out = **in
 	}
-	if in.ServiceInstanceSelector != nil {
-		in, out := &in.ServiceInstanceSelector, &out.ServiceInstanceSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Orgs != nil {
+		in, out := &in.Orgs, &out.Orgs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Space != nil {
+		in, out := &in.Space, &out.Space
+		*out = new(string)
+		**out = **in
 	}
 }
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceReference.
-func (in *ServiceInstanceReference) DeepCopy() *ServiceInstanceReference {
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanVisibilityObservation.
+func (in *ServicePlanVisibilityObservation) DeepCopy() *ServicePlanVisibilityObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceInstanceReference)
+	out := new(ServicePlanVisibilityObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceInstanceSpec) DeepCopyInto(out *ServiceInstanceSpec) {
+func (in *ServicePlanVisibilityParameters) DeepCopyInto(out *ServicePlanVisibilityParameters) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.ServicePlan.DeepCopyInto(&out.ServicePlan)
+	if in.Orgs != nil {
+		in, out := &in.Orgs, &out.Orgs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.OrgsRefs != nil {
+		in, out := &in.OrgsRefs, &out.OrgsRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrgsSelector != nil {
+		in, out := &in.OrgsSelector, &out.OrgsSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceSpec.
-func (in *ServiceInstanceSpec) DeepCopy() *ServiceInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanVisibilityParameters.
+func (in *ServicePlanVisibilityParameters) DeepCopy() *ServicePlanVisibilityParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceInstanceSpec)
+	out := new(ServicePlanVisibilityParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceInstanceStatus) DeepCopyInto(out *ServiceInstanceStatus) {
+func (in *ServicePlanVisibilitySpec) DeepCopyInto(out *ServicePlanVisibilitySpec) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceStatus.
-func (in *ServiceInstanceStatus) DeepCopy() *ServiceInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanVisibilitySpec.
+func (in *ServicePlanVisibilitySpec) DeepCopy() *ServicePlanVisibilitySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceInstanceStatus)
+	out := new(ServicePlanVisibilitySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServicePlanParameters) DeepCopyInto(out *ServicePlanParameters) {
+func (in *ServicePlanVisibilityStatus) DeepCopyInto(out *ServicePlanVisibilityStatus) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Offering != nil {
-		in, out := &in.Offering, &out.Offering
-		*out = new(string)
-		**out = **in
-	}
-	if in.Plan != nil {
-		in, out := &in.Plan, &out.Plan
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanParameters.
-func (in *ServicePlanParameters) DeepCopy() *ServicePlanParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanVisibilityStatus.
+func (in *ServicePlanVisibilityStatus) DeepCopy() *ServicePlanVisibilityStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServicePlanParameters)
+	out := new(ServicePlanVisibilityStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3007,6 +5107,21 @@ func (in *SpaceParameters) DeepCopyInto(out *SpaceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IsolationSegmentName != nil {
+		in, out := &in.IsolationSegmentName, &out.IsolationSegmentName
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsolationSegmentRef != nil {
+		in, out := &in.IsolationSegmentRef, &out.IsolationSegmentRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IsolationSegmentSelector != nil {
+		in, out := &in.IsolationSegmentSelector, &out.IsolationSegmentSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 	in.OrgReference.DeepCopyInto(&out.OrgReference)
 }
@@ -3462,18 +5577,226 @@ func (in *SpaceReference) DeepCopyInto(out *SpaceReference) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceReference.
-func (in *SpaceReference) DeepCopy() *SpaceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceReference.
+func (in *SpaceReference) DeepCopy() *SpaceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceRole) DeepCopyInto(out *SpaceRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRole.
+func (in *SpaceRole) DeepCopy() *SpaceRole {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpaceRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceRoleList) DeepCopyInto(out *SpaceRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SpaceRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleList.
+func (in *SpaceRoleList) DeepCopy() *SpaceRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpaceRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceRoleObservation) DeepCopyInto(out *SpaceRoleObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleObservation.
+func (in *SpaceRoleObservation) DeepCopy() *SpaceRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceRoleParameters) DeepCopyInto(out *SpaceRoleParameters) {
+	*out = *in
+	in.SpaceReference.DeepCopyInto(&out.SpaceReference)
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserRef != nil {
+		in, out := &in.UserRef, &out.UserRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleParameters.
+func (in *SpaceRoleParameters) DeepCopy() *SpaceRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceRoleSpec) DeepCopyInto(out *SpaceRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleSpec.
+func (in *SpaceRoleSpec) DeepCopy() *SpaceRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceRoleStatus) DeepCopyInto(out *SpaceRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleStatus.
+func (in *SpaceRoleStatus) DeepCopy() *SpaceRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceSpec) DeepCopyInto(out *SpaceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceSpec.
+func (in *SpaceSpec) DeepCopy() *SpaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceStatus) DeepCopyInto(out *SpaceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceStatus.
+func (in *SpaceStatus) DeepCopy() *SpaceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SpaceReference)
+	out := new(SpaceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpaceRole) DeepCopyInto(out *SpaceRole) {
+func (in *Task) DeepCopyInto(out *Task) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3481,18 +5804,18 @@ func (in *SpaceRole) DeepCopyInto(out *SpaceRole) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRole.
-func (in *SpaceRole) DeepCopy() *SpaceRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Task.
+func (in *Task) DeepCopy() *Task {
 	if in == nil {
 		return nil
 	}
-	out := new(SpaceRole)
+	out := new(Task)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SpaceRole) DeepCopyObject() runtime.Object {
+func (in *Task) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3500,31 +5823,31 @@ func (in *SpaceRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpaceRoleList) DeepCopyInto(out *SpaceRoleList) {
+func (in *TaskList) DeepCopyInto(out *TaskList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SpaceRole, len(*in))
+		*out = make([]Task, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleList.
-func (in *SpaceRoleList) DeepCopy() *SpaceRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskList.
+func (in *TaskList) DeepCopy() *TaskList {
 	if in == nil {
 		return nil
 	}
-	out := new(SpaceRoleList)
+	out := new(TaskList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SpaceRoleList) DeepCopyObject() runtime.Object {
+func (in *TaskList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3532,170 +5855,323 @@ func (in *SpaceRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpaceRoleObservation) DeepCopyInto(out *SpaceRoleObservation) {
+func (in *TaskObservation) DeepCopyInto(out *TaskObservation) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Origin != nil {
-		in, out := &in.Origin, &out.Origin
+	if in.SequenceID != nil {
+		in, out := &in.SequenceID, &out.SequenceID
+		*out = new(int)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
 		*out = new(string)
 		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
 		*out = new(string)
 		**out = **in
 	}
-	if in.UpdatedAt != nil {
-		in, out := &in.UpdatedAt, &out.UpdatedAt
+	if in.MemoryInMB != nil {
+		in, out := &in.MemoryInMB, &out.MemoryInMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.DiskInMB != nil {
+		in, out := &in.DiskInMB, &out.DiskInMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.LogRateLimitInBytesPerSecond != nil {
+		in, out := &in.LogRateLimitInBytesPerSecond, &out.LogRateLimitInBytesPerSecond
+		*out = new(int)
+		**out = **in
+	}
+	if in.Droplet != nil {
+		in, out := &in.Droplet, &out.Droplet
 		*out = new(string)
 		**out = **in
 	}
-	if in.User != nil {
-		in, out := &in.User, &out.User
+	if in.SpecHash != nil {
+		in, out := &in.SpecHash, &out.SpecHash
 		*out = new(string)
 		**out = **in
 	}
-	if in.Username != nil {
-		in, out := &in.Username, &out.Username
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleObservation.
-func (in *SpaceRoleObservation) DeepCopy() *SpaceRoleObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskObservation.
+func (in *TaskObservation) DeepCopy() *TaskObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(SpaceRoleObservation)
+	out := new(TaskObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpaceRoleParameters) DeepCopyInto(out *SpaceRoleParameters) {
+func (in *TaskParameters) DeepCopyInto(out *TaskParameters) {
 	*out = *in
-	in.SpaceReference.DeepCopyInto(&out.SpaceReference)
-	if in.Origin != nil {
-		in, out := &in.Origin, &out.Origin
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.App != nil {
+		in, out := &in.App, &out.App
+		*out = new(string)
+		**out = **in
+	}
+	if in.AppRef != nil {
+		in, out := &in.AppRef, &out.AppRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppSelector != nil {
+		in, out := &in.AppSelector, &out.AppSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MemoryInMB != nil {
+		in, out := &in.MemoryInMB, &out.MemoryInMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.DiskInMB != nil {
+		in, out := &in.DiskInMB, &out.DiskInMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.LogRateLimitInBytesPerSecond != nil {
+		in, out := &in.LogRateLimitInBytesPerSecond, &out.LogRateLimitInBytesPerSecond
+		*out = new(int)
+		**out = **in
+	}
+	if in.Droplet != nil {
+		in, out := &in.Droplet, &out.Droplet
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleParameters.
-func (in *SpaceRoleParameters) DeepCopy() *SpaceRoleParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskParameters.
+func (in *TaskParameters) DeepCopy() *TaskParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(SpaceRoleParameters)
+	out := new(TaskParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpaceRoleSpec) DeepCopyInto(out *SpaceRoleSpec) {
+func (in *TaskSpec) DeepCopyInto(out *TaskSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleSpec.
-func (in *SpaceRoleSpec) DeepCopy() *SpaceRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskSpec.
+func (in *TaskSpec) DeepCopy() *TaskSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SpaceRoleSpec)
+	out := new(TaskSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpaceRoleStatus) DeepCopyInto(out *SpaceRoleStatus) {
+func (in *TaskStatus) DeepCopyInto(out *TaskStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleStatus.
-func (in *SpaceRoleStatus) DeepCopy() *SpaceRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskStatus.
+func (in *TaskStatus) DeepCopy() *TaskStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SpaceRoleStatus)
+	out := new(TaskStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpaceSpec) DeepCopyInto(out *SpaceSpec) {
+func (in *TimeoutsParameters) DeepCopyInto(out *TimeoutsParameters) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(string)
+		**out = **in
+	}
+	if in.Delete != nil {
+		in, out := &in.Delete, &out.Delete
+		*out = new(string)
+		**out = **in
+	}
+	if in.Update != nil {
+		in, out := &in.Update, &out.Update
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceSpec.
-func (in *SpaceSpec) DeepCopy() *SpaceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeoutsParameters.
+func (in *TimeoutsParameters) DeepCopy() *TimeoutsParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(SpaceSpec)
+	out := new(TimeoutsParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpaceStatus) DeepCopyInto(out *SpaceStatus) {
+func (in *User) DeepCopyInto(out *User) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceStatus.
-func (in *SpaceStatus) DeepCopy() *SpaceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
+func (in *User) DeepCopy() *User {
 	if in == nil {
 		return nil
 	}
-	out := new(SpaceStatus)
+	out := new(User)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *User) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TimeoutsParameters) DeepCopyInto(out *TimeoutsParameters) {
+func (in *UserList) DeepCopyInto(out *UserList) {
 	*out = *in
-	if in.Create != nil {
-		in, out := &in.Create, &out.Create
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]User, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
+func (in *UserList) DeepCopy() *UserList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserObservation) DeepCopyInto(out *UserObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Delete != nil {
-		in, out := &in.Delete, &out.Delete
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
 		*out = new(string)
 		**out = **in
 	}
-	if in.Update != nil {
-		in, out := &in.Update, &out.Update
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.PresentationName != nil {
+		in, out := &in.PresentationName, &out.PresentationName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeoutsParameters.
-func (in *TimeoutsParameters) DeepCopy() *TimeoutsParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserObservation.
+func (in *UserObservation) DeepCopy() *UserObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(TimeoutsParameters)
+	out := new(UserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserParameters) DeepCopyInto(out *UserParameters) {
+	*out = *in
+	if in.GUID != nil {
+		in, out := &in.GUID, &out.GUID
+		*out = new(string)
+		**out = **in
+	}
+	in.ResourceMetadata.DeepCopyInto(&out.ResourceMetadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserParameters.
+func (in *UserParameters) DeepCopy() *UserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserParameters)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3729,3 +6205,37 @@ func (in *UserProvided) DeepCopy() *UserProvided {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSpec) DeepCopyInto(out *UserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
+func (in *UserSpec) DeepCopy() *UserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserStatus) DeepCopyInto(out *UserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
+func (in *UserStatus) DeepCopy() *UserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
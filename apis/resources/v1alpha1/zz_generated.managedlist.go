@@ -29,6 +29,15 @@ func (l *AppList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this BuildpackList.
+func (l *BuildpackList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this DomainList.
 func (l *DomainList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -38,6 +47,42 @@ func (l *DomainList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this EnvironmentVariableGroupList.
+func (l *EnvironmentVariableGroupList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this FeatureFlagList.
+func (l *FeatureFlagList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this IsolationSegmentList.
+func (l *IsolationSegmentList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this NetworkPolicyList.
+func (l *NetworkPolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this OrgMembersList.
 func (l *OrgMembersList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -83,6 +128,24 @@ func (l *RouteList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this SecurityGroupList.
+func (l *SecurityGroupList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ServiceBrokerList.
+func (l *ServiceBrokerList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ServiceCredentialBindingList.
 func (l *ServiceCredentialBindingList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -101,6 +164,33 @@ func (l *ServiceInstanceList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ServiceOfferingList.
+func (l *ServiceOfferingList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ServicePlanList.
+func (l *ServicePlanList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ServicePlanVisibilityList.
+func (l *ServicePlanVisibilityList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ServiceRouteBindingList.
 func (l *ServiceRouteBindingList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -145,3 +235,21 @@ func (l *SpaceRoleList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this TaskList.
+func (l *TaskList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this UserList.
+func (l *UserList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -115,6 +115,74 @@ func (mg *Domain) ResolveReferences(ctx context.Context, c client.Reader) error
 	return nil
 }
 
+// ResolveReferences of this IsolationSegment.
+func (mg *IsolationSegment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.Orgs),
+		Extract:       resources.ExternalID(),
+		References:    mg.Spec.ForProvider.OrgsRefs,
+		Selector:      mg.Spec.ForProvider.OrgsSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Orgs")
+	}
+	mg.Spec.ForProvider.Orgs = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.OrgsRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this NetworkPolicy.
+func (mg *NetworkPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Source),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.SourceRef,
+		Selector:     mg.Spec.ForProvider.SourceSelector,
+		To: reference.To{
+			List:    &AppList{},
+			Managed: &App{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Source")
+	}
+	mg.Spec.ForProvider.Source = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Destination),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.DestinationRef,
+		Selector:     mg.Spec.ForProvider.DestinationSelector,
+		To: reference.To{
+			List:    &AppList{},
+			Managed: &App{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Destination")
+	}
+	mg.Spec.ForProvider.Destination = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DestinationRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this OrgMembers.
 func (mg *OrgMembers) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -164,6 +232,22 @@ func (mg *OrgRole) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.OrgReference.Org = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.OrgReference.OrgRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Username,
+		Extract:      resources.CloudFoundryName(),
+		Reference:    mg.Spec.ForProvider.UserRef,
+		Selector:     mg.Spec.ForProvider.UserSelector,
+		To: reference.To{
+			List:    &UserList{},
+			Managed: &User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Username")
+	}
+	mg.Spec.ForProvider.Username = rsp.ResolvedValue
+	mg.Spec.ForProvider.UserRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -206,6 +290,99 @@ func (mg *Route) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.DomainReference.Domain = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.DomainReference.DomainRef = rsp.ResolvedReference
 
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.SharedSpaces); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SharedSpaces[i3].Space),
+			Extract:      resources.ExternalID(),
+			Reference:    mg.Spec.ForProvider.SharedSpaces[i3].SpaceRef,
+			Selector:     mg.Spec.ForProvider.SharedSpaces[i3].SpaceSelector,
+			To: reference.To{
+				List:    &SpaceList{},
+				Managed: &Space{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.SharedSpaces[i3].Space")
+		}
+		mg.Spec.ForProvider.SharedSpaces[i3].Space = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.SharedSpaces[i3].SpaceRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this SecurityGroup.
+func (mg *SecurityGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.RunningSpaces); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RunningSpaces[i3].Space),
+			Extract:      resources.ExternalID(),
+			Reference:    mg.Spec.ForProvider.RunningSpaces[i3].SpaceRef,
+			Selector:     mg.Spec.ForProvider.RunningSpaces[i3].SpaceSelector,
+			To: reference.To{
+				List:    &SpaceList{},
+				Managed: &Space{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.RunningSpaces[i3].Space")
+		}
+		mg.Spec.ForProvider.RunningSpaces[i3].Space = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.RunningSpaces[i3].SpaceRef = rsp.ResolvedReference
+
+	}
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.StagingSpaces); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.StagingSpaces[i3].Space),
+			Extract:      resources.ExternalID(),
+			Reference:    mg.Spec.ForProvider.StagingSpaces[i3].SpaceRef,
+			Selector:     mg.Spec.ForProvider.StagingSpaces[i3].SpaceSelector,
+			To: reference.To{
+				List:    &SpaceList{},
+				Managed: &Space{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.StagingSpaces[i3].Space")
+		}
+		mg.Spec.ForProvider.StagingSpaces[i3].Space = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.StagingSpaces[i3].SpaceRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this ServiceBroker.
+func (mg *ServiceBroker) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SpaceReference.Space),
+		Extract:      resources.ExternalID(),
+		Reference:    mg.Spec.ForProvider.SpaceReference.SpaceRef,
+		Selector:     mg.Spec.ForProvider.SpaceReference.SpaceSelector,
+		To: reference.To{
+			List:    &SpaceList{},
+			Managed: &Space{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.SpaceReference.Space")
+	}
+	mg.Spec.ForProvider.SpaceReference.Space = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SpaceReference.SpaceRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -296,6 +473,32 @@ func (mg *ServiceInstance) ResolveReferences(ctx context.Context, c client.Reade
 	return nil
 }
 
+// ResolveReferences of this ServicePlanVisibility.
+func (mg *ServicePlanVisibility) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.Orgs),
+		Extract:       resources.ExternalID(),
+		References:    mg.Spec.ForProvider.OrgsRefs,
+		Selector:      mg.Spec.ForProvider.OrgsSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Orgs")
+	}
+	mg.Spec.ForProvider.Orgs = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.OrgsRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
 // ResolveReferences of this ServiceRouteBinding.
 func (mg *ServiceRouteBinding) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -361,6 +564,22 @@ func (mg *Space) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.OrgReference.Org = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.OrgReference.OrgRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IsolationSegment),
+		Extract:      resources.ExternalID(),
+		Reference:    mg.Spec.ForProvider.IsolationSegmentRef,
+		Selector:     mg.Spec.ForProvider.IsolationSegmentSelector,
+		To: reference.To{
+			List:    &IsolationSegmentList{},
+			Managed: &IsolationSegment{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.IsolationSegment")
+	}
+	mg.Spec.ForProvider.IsolationSegment = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IsolationSegmentRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -455,5 +674,47 @@ func (mg *SpaceRole) ResolveReferences(ctx context.Context, c client.Reader) err
 	mg.Spec.ForProvider.SpaceReference.Space = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.SpaceReference.SpaceRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Username,
+		Extract:      resources.CloudFoundryName(),
+		Reference:    mg.Spec.ForProvider.UserRef,
+		Selector:     mg.Spec.ForProvider.UserSelector,
+		To: reference.To{
+			List:    &UserList{},
+			Managed: &User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Username")
+	}
+	mg.Spec.ForProvider.Username = rsp.ResolvedValue
+	mg.Spec.ForProvider.UserRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Task.
+func (mg *Task) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.App),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.AppRef,
+		Selector:     mg.Spec.ForProvider.AppSelector,
+		To: reference.To{
+			List:    &AppList{},
+			Managed: &App{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.App")
+	}
+	mg.Spec.ForProvider.App = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AppRef = rsp.ResolvedReference
+
 	return nil
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// TypeCertificateVerified indicates whether the certificate presented by the
+// CF API could be verified.
+const TypeCertificateVerified xpv1.ConditionType = "CertificateVerified"
+
+// Reasons a certificate is or is not verified.
+const (
+	ReasonCertificateVerified            xpv1.ConditionReason = "Verified"
+	ReasonCertificateVerificationFailed  xpv1.ConditionReason = "VerificationFailed"
+	ReasonCertificateVerificationSkipped xpv1.ConditionReason = "InsecureSkipTLSVerify"
+)
+
+// CertificateVerified returns a condition indicating that the certificate
+// presented by the CF API was verified.
+func CertificateVerified() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCertificateVerified,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCertificateVerified,
+	}
+}
+
+// CertificateVerificationFailed returns a condition indicating that the
+// certificate presented by the CF API could not be verified.
+func CertificateVerificationFailed(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCertificateVerified,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCertificateVerificationFailed,
+		Message:            err.Error(),
+	}
+}
+
+// CertificateVerificationSkipped returns a condition indicating that the
+// certificate presented by the CF API is not verified, because
+// insecureSkipTLSVerify is set.
+func CertificateVerificationSkipped() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCertificateVerified,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCertificateVerificationSkipped,
+	}
+}
+
+// Condition types of the connectivity of a ProviderConfig.
+const (
+	// TypeReachable indicates whether the CF API could be reached.
+	TypeReachable xpv1.ConditionType = "Reachable"
+	// TypeAuthenticated indicates whether the credentials authenticate.
+	TypeAuthenticated xpv1.ConditionType = "Authenticated"
+)
+
+// Reasons of the connectivity of a ProviderConfig.
+const (
+	ReasonReachable            xpv1.ConditionReason = "Reachable"
+	ReasonUnreachable          xpv1.ConditionReason = "Unreachable"
+	ReasonAuthenticated        xpv1.ConditionReason = "Authenticated"
+	ReasonAuthenticationFailed xpv1.ConditionReason = "AuthenticationFailed"
+)
+
+// Reachable returns a condition indicating that the CF API could be reached.
+func Reachable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReachable,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReachable,
+	}
+}
+
+// Unreachable returns a condition indicating that the CF API could not be
+// reached.
+func Unreachable(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReachable,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnreachable,
+		Message:            err.Error(),
+	}
+}
+
+// Authenticated returns a condition indicating that the credentials
+// authenticate.
+func Authenticated() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAuthenticated,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAuthenticated,
+	}
+}
+
+// AuthenticationFailed returns a condition indicating that the credentials do
+// not authenticate.
+func AuthenticationFailed(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAuthenticated,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAuthenticationFailed,
+		Message:            err.Error(),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 )
 
 // A ProviderConfigSpec defines the desired state of a ProviderConfig.
@@ -20,6 +21,69 @@ type ProviderConfigSpec struct {
 	Endpoint *EndpointConfig `json:"endpoint"`
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+	// CABundleSecretRef references a Secret key holding PEM encoded CA certificates
+	// trusted in addition to the system roots when verifying the CF API and UAA certificates.
+	// +kubebuilder:validation:Optional
+	CABundleSecretRef *xpv1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+	// CABundleConfigMapRef references a ConfigMap key holding PEM encoded CA certificates
+	// trusted in addition to the system roots when verifying the CF API and UAA certificates.
+	// +kubebuilder:validation:Optional
+	CABundleConfigMapRef *ConfigMapKeySelector `json:"caBundleConfigMapRef,omitempty"`
+	// InsecureSkipTLSVerify disables the verification of the CF API and UAA certificates.
+	// Use it only for testing purposes.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// ProxyURL of the HTTP(S) proxy used for the CF API and UAA requests, e.g.
+	// `http://proxy.example.com:3128`. Overrides the proxy configured in the
+	// environment of the provider.
+	// +kubebuilder:validation:Optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+	// NoProxy lists the hosts that are reached without the proxy. An entry is a
+	// host name, a domain matching all its subdomains, e.g. `.example.com`, an
+	// IP address or a CIDR range. `*` disables the proxy for all hosts.
+	// +kubebuilder:validation:Optional
+	NoProxy []string `json:"noProxy,omitempty"`
+	// RequestTimeout limits the duration of a single CF API or UAA request,
+	// e.g. `30s`. Defaults to the timeout of the CF client.
+	// +kubebuilder:validation:Optional
+	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
+	// RateLimit limits the rate of the requests to the CF API and UAA and
+	// configures the retries of requests rejected by the CF API.
+	// +kubebuilder:validation:Optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+}
+
+// A RateLimit configures the client side rate limit of a ProviderConfig and
+// the retries of requests the CF API rejects with 429 or fails with 502, 503
+// or 504.
+type RateLimit struct {
+	// QPS is the number of requests per second sent to the CF API and UAA by
+	// all resources using the ProviderConfig. Unlimited by default.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	QPS *int `json:"qps,omitempty"`
+	// Burst is the number of requests sent at once before QPS applies.
+	// Defaults to QPS.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	Burst *int `json:"burst,omitempty"`
+	// MaxRetries of a single request. Requests rejected with 429 are retried
+	// once the rate limit resets, idempotent requests failed with 502, 503 or
+	// 504 after a jittered backoff. Defaults to 5.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries *int `json:"maxRetries,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+	// The key to select.
+	Key string `json:"key"`
 }
 
 // EndpointConfig is used to configure cf API endpoint.
@@ -30,23 +94,83 @@ type EndpointConfig struct {
 	xpv1.CommonCredentialSelectors `json:",inline"`
 }
 
+// CredentialsType selects the UAA grant type used to authenticate.
+type CredentialsType string
+
+const (
+	// CredentialsTypeUserPassword authenticates a user with the password grant.
+	CredentialsTypeUserPassword CredentialsType = "UserPassword"
+	// CredentialsTypeClientCredentials authenticates a UAA client with the client_credentials grant.
+	CredentialsTypeClientCredentials CredentialsType = "ClientCredentials"
+	// CredentialsTypePasscode authenticates a user once with a UAA one-time passcode and
+	// renews the resulting refresh token afterwards.
+	CredentialsTypePasscode CredentialsType = "Passcode"
+	// CredentialsTypeJWTBearer exchanges a JWT issued by a trusted identity provider,
+	// e.g. a Kubernetes service account token, with the jwt-bearer grant.
+	CredentialsTypeJWTBearer CredentialsType = "JWTBearer"
+)
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
 	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
 	Source                         xpv1.CredentialsSource `json:"source"`
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// Type of the provider credentials. `UserPassword` expects `email` and `password`,
+	// `ClientCredentials` expects `clientId` and `clientSecret` in the credentials.
+	// `Passcode` expects a one-time `passcode`, obtained from `<uaa-url>/passcode`,
+	// which is only used as long as no refresh token is stored in `refreshTokenSecretRef`.
+	// `JWTBearer` expects a JWT, which is read from `tokenFilePath` for the source
+	// `InjectedIdentity`, and is the only type supported for that source.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=UserPassword;ClientCredentials;Passcode;JWTBearer
+	// +kubebuilder:default=UserPassword
+	Type CredentialsType `json:"type,omitempty"`
+
+	// TokenEndpoint of the UAA used to request tokens. Overrides the UAA endpoint
+	// discovered from the API root, e.g. `https://uaa.cf.example.com`.
+	// +kubebuilder:validation:Optional
+	TokenEndpoint *string `json:"tokenEndpoint,omitempty"`
+
+	// RefreshTokenSecretRef references the Secret in which the provider stores the
+	// refresh token obtained with a passcode. The Secret is created if it does not exist.
+	// Required for credentials of type `Passcode`.
+	// +kubebuilder:validation:Optional
+	RefreshTokenSecretRef *xpv1.SecretReference `json:"refreshTokenSecretRef,omitempty"`
+
+	// TokenFilePath is the path of the JWT exchanged for credentials of type
+	// `JWTBearer` and the source `InjectedIdentity`, e.g. a projected service
+	// account token. The file is read again on every connect, so that a rotated
+	// token is exchanged again. Defaults to the service account token of the
+	// provider pod.
+	// +kubebuilder:validation:Optional
+	TokenFilePath *string `json:"tokenFilePath,omitempty"`
 }
 
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// CFAPIVersion is the version of the CF API v3 reported by the API root.
+	CFAPIVersion string `json:"cfApiVersion,omitempty"`
+
+	// UAAEndpoint is the UAA endpoint reported by the API root.
+	UAAEndpoint string `json:"uaaEndpoint,omitempty"`
+
+	// LastCheckTime is the time the connectivity was last checked.
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures a CloudFoundry provider.
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="REACHABLE",type="string",JSONPath=".status.conditions[?(@.type=='Reachable')].status"
+// +kubebuilder:printcolumn:name="AUTHENTICATED",type="string",JSONPath=".status.conditions[?(@.type=='Authenticated')].status"
+// +kubebuilder:printcolumn:name="CF-API-VERSION",type="string",JSONPath=".status.cfApiVersion"
+// +kubebuilder:printcolumn:name="UAA",type="string",JSONPath=".status.uaaEndpoint",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster
@@ -91,3 +215,25 @@ type ProviderConfigUsageList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []ProviderConfigUsage `json:"items"`
 }
+
+// A ProviderConfigObject is a ProviderConfig of any scope. Cluster scoped
+// managed resources use a ProviderConfig, namespaced managed resources a
+// namespaced ProviderConfig or a ClusterProviderConfig. All of them share the
+// spec and status of the ProviderConfig.
+// +kubebuilder:object:generate=false
+type ProviderConfigObject interface {
+	resource.ProviderConfig
+
+	GetSpec() *ProviderConfigSpec
+	GetStatus() *ProviderConfigStatus
+}
+
+// GetSpec of this ProviderConfig.
+func (p *ProviderConfig) GetSpec() *ProviderConfigSpec {
+	return &p.Spec
+}
+
+// GetStatus of this ProviderConfig.
+func (p *ProviderConfig) GetStatus() *ProviderConfigStatus {
+	return &p.Status
+}
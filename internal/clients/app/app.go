@@ -14,6 +14,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/deployment"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/servicecredentialbinding"
@@ -52,6 +53,8 @@ type Client struct {
 	job.Job
 	servicecredentialbinding.ServiceCredentialBinding
 	RouteFetcher
+	Deployment deployment.Client
+	Sidecars   SidecarClient
 }
 
 // NewAppClient returns a new AppClient.
@@ -62,6 +65,8 @@ func NewAppClient(client *client.Client) *Client {
 		Job:                      client.Jobs,
 		ServiceCredentialBinding: servicecredentialbinding.NewClient(client),
 		RouteFetcher:             client.Routes,
+		Deployment:               deployment.NewClient(client),
+		Sidecars:                 client.Sidecars,
 	}
 }
 
@@ -248,6 +253,24 @@ func DetectChanges(mg xpresource.Managed, spec v1alpha1.AppParameters, status v1
 		changes.ChangedFields["environment"] = struct{}{}
 	}
 
+	// Check if sidecars changed
+	if sidecarsChanged(spec, status.Sidecars) {
+		changes.ChangedFields["sidecars"] = struct{}{}
+	}
+
+	// A new docker image or environment is not rolled out again while the
+	// latest deployment holds it back
+	if deployment.IsHeldBack(spec, status.Deployment) {
+		delete(changes.ChangedFields, "docker_image")
+		delete(changes.ChangedFields, "environment")
+	}
+
+	// A known deployment action is applied and its annotation removed, an
+	// unknown one is ignored and stays on the app
+	if mg != nil && deployment.IsAction(mg.GetAnnotations()[deployment.ActionKey]) {
+		changes.ChangedFields["deployment_action"] = struct{}{}
+	}
+
 	// Check if name changed
 	if spec.Name != status.Name {
 		changes.ChangedFields["name"] = struct{}{}
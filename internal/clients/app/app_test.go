@@ -7,6 +7,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/deployment"
 )
 
 func TestDetectChanges(t *testing.T) {
@@ -46,6 +47,27 @@ func TestDetectChanges(t *testing.T) {
 			},
 			expectedFields: []string{"docker_image"},
 		},
+		{
+			name: "Docker image held back by canceled deployment",
+			spec: v1alpha1.AppParameters{
+				Name:      "test-app",
+				Lifecycle: "docker",
+				Docker: &v1alpha1.DockerConfiguration{
+					Image: "nginx:1.21",
+				},
+				Strategy: ptr.To("canary"),
+			},
+			status: v1alpha1.AppObservation{
+				Name:        "test-app",
+				AppManifest: "applications:\n- name: test-app\n  docker:\n    image: nginx:latest",
+				Deployment: &v1alpha1.AppDeploymentObservation{
+					Status:   deployment.DeploymentFinalized,
+					Reason:   deployment.ReasonCanceled,
+					SpecHash: deployment.Hash(v1alpha1.AppParameters{Docker: &v1alpha1.DockerConfiguration{Image: "nginx:1.21"}}),
+				},
+			},
+			expectedFields: []string{},
+		},
 		{
 			name: "Name changed",
 			spec: v1alpha1.AppParameters{
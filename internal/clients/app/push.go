@@ -96,6 +96,8 @@ func newManifestFromSpec(forProvider v1alpha1.AppParameters, dockerCredentials *
 
 	manifest.Processes = configProcess(forProvider)
 
+	manifest.Sidecars = configSidecars(forProvider)
+
 	if forProvider.ReadinessHealthCheckType != nil {
 		manifest.ReadinessHealthCheckType = *forProvider.ReadinessHealthCheckType
 	}
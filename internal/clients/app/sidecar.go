@@ -0,0 +1,181 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/operation"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// sidecarOriginUser is the origin of the sidecars created by users, as
+// opposed to the sidecars created by buildpacks.
+const sidecarOriginUser = "user"
+
+// SidecarClient defines the interface to communicate with Cloud Foundry Sidecar resource.
+type SidecarClient interface {
+	ListForAppAll(ctx context.Context, appGUID string, opts *client.SidecarListOptions) ([]*resource.Sidecar, error)
+	Create(ctx context.Context, appGUID string, r *resource.SidecarCreate) (*resource.Sidecar, error)
+	Update(ctx context.Context, guid string, r *resource.SidecarUpdate) (*resource.Sidecar, error)
+	Delete(ctx context.Context, guid string) error
+}
+
+// ObserveSidecars returns the user sidecars of the app.
+func (c *Client) ObserveSidecars(ctx context.Context, guid string) ([]v1alpha1.SidecarConfiguration, error) {
+	observed, err := c.Sidecars.ListForAppAll(ctx, guid, nil)
+	if err != nil {
+		return nil, err
+	}
+	var sidecars []v1alpha1.SidecarConfiguration
+	for _, s := range userSidecars(observed) {
+		sidecars = append(sidecars, sidecarFromResource(s))
+	}
+	return sidecars, nil
+}
+
+// UpdateSidecars makes the user sidecars of the app exactly the sidecars of
+// the spec: missing sidecars are created, changed sidecars are updated and
+// the others are deleted. The changes take effect when the app restarts.
+func (c *Client) UpdateSidecars(ctx context.Context, guid string, spec v1alpha1.AppParameters) error {
+	observed, err := c.Sidecars.ListForAppAll(ctx, guid, nil)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]*resource.Sidecar, len(observed))
+	for _, s := range userSidecars(observed) {
+		existing[s.Name] = s
+	}
+
+	for _, desired := range ptr.Deref(spec.Sidecars, nil) {
+		s, ok := existing[desired.Name]
+		delete(existing, desired.Name)
+		if !ok {
+			if _, err := c.Sidecars.Create(ctx, guid, newSidecarCreate(desired)); err != nil {
+				return err
+			}
+			continue
+		}
+		if isSidecarUpToDate(desired, sidecarFromResource(s)) {
+			continue
+		}
+		if _, err := c.Sidecars.Update(ctx, s.GUID, newSidecarUpdate(desired)); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range existing {
+		if err := c.Sidecars.Delete(ctx, s.GUID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sidecarsChanged returns true if the sidecars of the spec differ from the
+// observed user sidecars. Sidecars are only compared once the spec sets them,
+// an empty list desires no user sidecars.
+func sidecarsChanged(spec v1alpha1.AppParameters, observed []v1alpha1.SidecarConfiguration) bool {
+	if spec.Sidecars == nil {
+		return false
+	}
+	desired := *spec.Sidecars
+	if len(desired) != len(observed) {
+		return true
+	}
+	byName := make(map[string]v1alpha1.SidecarConfiguration, len(observed))
+	for _, s := range observed {
+		byName[s.Name] = s
+	}
+	for _, d := range desired {
+		s, ok := byName[d.Name]
+		if !ok || !isSidecarUpToDate(d, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// userSidecars returns the sidecars created by users, the sidecars created by
+// buildpacks are not managed.
+func userSidecars(sidecars []*resource.Sidecar) []*resource.Sidecar {
+	user := make([]*resource.Sidecar, 0, len(sidecars))
+	for _, s := range sidecars {
+		if s.Origin == sidecarOriginUser {
+			user = append(user, s)
+		}
+	}
+	return user
+}
+
+// isSidecarUpToDate compares the command, the process types and the memory of
+// a sidecar. The memory is only compared if it is desired.
+func isSidecarUpToDate(desired, observed v1alpha1.SidecarConfiguration) bool {
+	if ptr.Deref(desired.Command, "") != ptr.Deref(observed.Command, "") {
+		return false
+	}
+	if !reflect.DeepEqual(sortedCopy(desired.ProcessTypes), sortedCopy(observed.ProcessTypes)) {
+		return false
+	}
+	return desired.Memory == nil || ptr.Deref(observed.Memory, 0) == *desired.Memory
+}
+
+// configSidecars map the sidecars from app spec
+func configSidecars(forProvider v1alpha1.AppParameters) *operation.AppManifestSideCars {
+	if len(ptr.Deref(forProvider.Sidecars, nil)) == 0 {
+		return nil
+	}
+	sidecars := make(operation.AppManifestSideCars, 0, len(*forProvider.Sidecars))
+	for _, s := range *forProvider.Sidecars {
+		sidecar := operation.AppManifestSideCar{
+			Name:         s.Name,
+			ProcessTypes: s.ProcessTypes,
+			Command:      ptr.Deref(s.Command, ""),
+		}
+		if s.Memory != nil {
+			sidecar.Memory = fmt.Sprintf("%dM", *s.Memory)
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	return &sidecars
+}
+
+func sidecarFromResource(s *resource.Sidecar) v1alpha1.SidecarConfiguration {
+	sidecar := v1alpha1.SidecarConfiguration{
+		Name:         s.Name,
+		Command:      ptr.To(s.Command),
+		ProcessTypes: s.ProcessTypes,
+	}
+	if s.MemoryInMB > 0 {
+		sidecar.Memory = ptr.To(uint(s.MemoryInMB))
+	}
+	return sidecar
+}
+
+func newSidecarCreate(s v1alpha1.SidecarConfiguration) *resource.SidecarCreate {
+	create := resource.NewSidecarCreate(s.Name, ptr.Deref(s.Command, ""), s.ProcessTypes)
+	if s.Memory != nil {
+		create.WithMemoryInMB(int(*s.Memory))
+	}
+	return create
+}
+
+func newSidecarUpdate(s v1alpha1.SidecarConfiguration) *resource.SidecarUpdate {
+	update := resource.NewSidecarUpdate().WithCommand(ptr.Deref(s.Command, "")).WithProcessTypes(s.ProcessTypes)
+	if s.Memory != nil {
+		update.WithMemoryInMB(int(*s.Memory))
+	}
+	return update
+}
+
+func sortedCopy(s []string) []string {
+	c := append([]string(nil), s...)
+	sort.Strings(c)
+	return c
+}
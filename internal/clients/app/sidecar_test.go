@@ -0,0 +1,226 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+)
+
+func TestUpdateSidecars(t *testing.T) {
+	errBoom := errors.New("boom")
+	appGUID := "test-app-guid"
+	proxy := v1alpha1.SidecarConfiguration{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}, Memory: ptr.To(uint(64))}
+
+	cases := map[string]struct {
+		spec    v1alpha1.AppParameters
+		sidecar func() *fake.MockSidecar
+		want    error
+	}{
+		"CreateMissing": {
+			spec: v1alpha1.AppParameters{Sidecars: &[]v1alpha1.SidecarConfiguration{proxy}},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", appGUID).Return([]*resource.Sidecar{}, nil)
+				m.On("Create", appGUID, resource.NewSidecarCreate("proxy", "./proxy", []string{"web"}).WithMemoryInMB(64)).Return(&resource.Sidecar{}, nil)
+				return m
+			},
+		},
+		"UpdateChanged": {
+			spec: v1alpha1.AppParameters{Sidecars: &[]v1alpha1.SidecarConfiguration{proxy}},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", appGUID).Return([]*resource.Sidecar{
+					{Resource: resource.Resource{GUID: "proxy-guid"}, Name: "proxy", Command: "./proxy --old", ProcessTypes: []string{"web"}, MemoryInMB: 64, Origin: sidecarOriginUser},
+				}, nil)
+				m.On("Update", "proxy-guid", resource.NewSidecarUpdate().WithCommand("./proxy").WithProcessTypes([]string{"web"}).WithMemoryInMB(64)).Return(&resource.Sidecar{}, nil)
+				return m
+			},
+		},
+		"KeepUpToDate": {
+			spec: v1alpha1.AppParameters{Sidecars: &[]v1alpha1.SidecarConfiguration{proxy}},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", appGUID).Return([]*resource.Sidecar{
+					{Resource: resource.Resource{GUID: "proxy-guid"}, Name: "proxy", Command: "./proxy", ProcessTypes: []string{"web"}, MemoryInMB: 64, Origin: sidecarOriginUser},
+				}, nil)
+				return m
+			},
+		},
+		"DeleteOnlyUserSidecars": {
+			spec: v1alpha1.AppParameters{Sidecars: &[]v1alpha1.SidecarConfiguration{proxy}},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", appGUID).Return([]*resource.Sidecar{
+					{Resource: resource.Resource{GUID: "proxy-guid"}, Name: "proxy", Command: "./proxy", ProcessTypes: []string{"web"}, MemoryInMB: 64, Origin: sidecarOriginUser},
+					{Resource: resource.Resource{GUID: "old-guid"}, Name: "old", Command: "./old", ProcessTypes: []string{"web"}, Origin: sidecarOriginUser},
+					{Resource: resource.Resource{GUID: "buildpack-guid"}, Name: "agent", Command: "./agent", ProcessTypes: []string{"web"}, Origin: "buildpack"},
+				}, nil)
+				m.On("Delete", "old-guid").Return(nil)
+				return m
+			},
+		},
+		"DeleteAllUserSidecars": {
+			spec: v1alpha1.AppParameters{Sidecars: &[]v1alpha1.SidecarConfiguration{}},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", appGUID).Return([]*resource.Sidecar{
+					{Resource: resource.Resource{GUID: "proxy-guid"}, Name: "proxy", Command: "./proxy", ProcessTypes: []string{"web"}, Origin: sidecarOriginUser},
+					{Resource: resource.Resource{GUID: "buildpack-guid"}, Name: "agent", Command: "./agent", ProcessTypes: []string{"web"}, Origin: "buildpack"},
+				}, nil)
+				m.On("Delete", "proxy-guid").Return(nil)
+				return m
+			},
+		},
+		"ListError": {
+			spec: v1alpha1.AppParameters{Sidecars: &[]v1alpha1.SidecarConfiguration{proxy}},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", appGUID).Return([]*resource.Sidecar{}, errBoom)
+				return m
+			},
+			want: errBoom,
+		},
+		"CreateError": {
+			spec: v1alpha1.AppParameters{Sidecars: &[]v1alpha1.SidecarConfiguration{proxy}},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", appGUID).Return([]*resource.Sidecar{}, nil)
+				m.On("Create", appGUID, resource.NewSidecarCreate("proxy", "./proxy", []string{"web"}).WithMemoryInMB(64)).Return(fake.SidecarNil, errBoom)
+				return m
+			},
+			want: errBoom,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.sidecar()
+			c := &Client{Sidecars: m}
+
+			err := c.UpdateSidecars(context.Background(), appGUID, tc.spec)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("UpdateSidecars(...): -want error, +got error:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestObserveSidecars(t *testing.T) {
+	errBoom := errors.New("boom")
+	appGUID := "test-app-guid"
+
+	type want struct {
+		sidecars []v1alpha1.SidecarConfiguration
+		err      error
+	}
+
+	cases := map[string]struct {
+		sidecar func() *fake.MockSidecar
+		want    want
+	}{
+		"OnlyUserSidecars": {
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", appGUID).Return([]*resource.Sidecar{
+					{Resource: resource.Resource{GUID: "proxy-guid"}, Name: "proxy", Command: "./proxy", ProcessTypes: []string{"web"}, MemoryInMB: 64, Origin: sidecarOriginUser},
+					{Resource: resource.Resource{GUID: "buildpack-guid"}, Name: "agent", Command: "./agent", ProcessTypes: []string{"web"}, Origin: "buildpack"},
+				}, nil)
+				return m
+			},
+			want: want{
+				sidecars: []v1alpha1.SidecarConfiguration{{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}, Memory: ptr.To(uint(64))}},
+			},
+		},
+		"ListError": {
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", appGUID).Return([]*resource.Sidecar{}, errBoom)
+				return m
+			},
+			want: want{err: errBoom},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.sidecar()
+			c := &Client{Sidecars: m}
+
+			got, err := c.ObserveSidecars(context.Background(), appGUID)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ObserveSidecars(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.sidecars, got); diff != "" {
+				t.Errorf("ObserveSidecars(...): -want, +got:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSidecarsChanged(t *testing.T) {
+	proxy := v1alpha1.SidecarConfiguration{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}, Memory: ptr.To(uint(64))}
+
+	cases := map[string]struct {
+		sidecars *[]v1alpha1.SidecarConfiguration
+		observed []v1alpha1.SidecarConfiguration
+		want     bool
+	}{
+		"NotManaged": {
+			observed: []v1alpha1.SidecarConfiguration{proxy},
+			want:     false,
+		},
+		"UpToDate": {
+			sidecars: &[]v1alpha1.SidecarConfiguration{proxy},
+			observed: []v1alpha1.SidecarConfiguration{proxy},
+			want:     false,
+		},
+		"MemoryNotDesired": {
+			sidecars: &[]v1alpha1.SidecarConfiguration{{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}}},
+			observed: []v1alpha1.SidecarConfiguration{proxy},
+			want:     false,
+		},
+		"MemoryChanged": {
+			sidecars: &[]v1alpha1.SidecarConfiguration{{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}, Memory: ptr.To(uint(128))}},
+			observed: []v1alpha1.SidecarConfiguration{proxy},
+			want:     true,
+		},
+		"ProcessTypesChanged": {
+			sidecars: &[]v1alpha1.SidecarConfiguration{{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web", "worker"}}},
+			observed: []v1alpha1.SidecarConfiguration{proxy},
+			want:     true,
+		},
+		"Missing": {
+			sidecars: &[]v1alpha1.SidecarConfiguration{proxy},
+			want:     true,
+		},
+		"EmptyRemovesSidecars": {
+			sidecars: &[]v1alpha1.SidecarConfiguration{},
+			observed: []v1alpha1.SidecarConfiguration{proxy},
+			want:     true,
+		},
+		"EmptyUpToDate": {
+			sidecars: &[]v1alpha1.SidecarConfiguration{},
+			observed: []v1alpha1.SidecarConfiguration{},
+			want:     false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := sidecarsChanged(v1alpha1.AppParameters{Sidecars: tc.sidecars}, tc.observed)
+			if got != tc.want {
+				t.Errorf("sidecarsChanged() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
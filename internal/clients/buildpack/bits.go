@@ -0,0 +1,176 @@
+package buildpack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+const (
+	checksumPrefix = "sha256:"
+	titleKey       = "org.opencontainers.image.title"
+
+	errDownload        = "cannot download the bits of the buildpack from %s"
+	errDownloadStatus  = "cannot download the bits of the buildpack from %s: %s"
+	errChecksum        = "checksum mismatch of the bits downloaded from %s: expected %s, got %s"
+	errParseImage      = "cannot parse the image reference %s"
+	errPullImage       = "cannot pull the OCI artifact %s"
+	errImageLayers     = "the OCI artifact %s must have exactly one layer with the zip file of the buildpack, got %d"
+	errTempFile        = "cannot buffer the bits of the buildpack"
+	errNoSourceDefined = "neither url nor image is set in the source of the buildpack"
+)
+
+// Bits is the zip file with the bits of a buildpack. Close releases the
+// resources held by the bits.
+type Bits struct {
+	io.ReadCloser
+
+	// FileName is the name of the zip file.
+	FileName string
+}
+
+// A Fetcher fetches the bits of a buildpack from its source.
+type Fetcher func(ctx context.Context, source v1alpha1.BuildpackSource) (*Bits, error)
+
+// Checksum returns the checksum identifying the bits of the source: the
+// checksum of the zip file at the URL or the digest of the OCI artifact.
+func Checksum(source v1alpha1.BuildpackSource) string {
+	if source.Image != nil {
+		if i := strings.LastIndex(*source.Image, "@"); i >= 0 {
+			return (*source.Image)[i+1:]
+		}
+		return ""
+	}
+	return ptr.Deref(source.Checksum, "")
+}
+
+// NewFetcher returns the Fetcher that downloads the bits from the URL or pulls
+// them from the OCI artifact of the source with the given http.Client.
+func NewFetcher(hc *http.Client) Fetcher {
+	return func(ctx context.Context, source v1alpha1.BuildpackSource) (*Bits, error) {
+		switch {
+		case source.URL != nil:
+			return fetchURL(ctx, hc, *source.URL, ptr.Deref(source.Checksum, ""))
+		case source.Image != nil:
+			return fetchImage(ctx, hc, *source.Image)
+		default:
+			return nil, errors.New(errNoSourceDefined)
+		}
+	}
+}
+
+// fetchURL downloads the zip file into a temporary file and verifies its
+// checksum, as the bits must not be uploaded before they are verified.
+func fetchURL(ctx context.Context, hc *http.Client, rawURL, checksum string) (*Bits, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, errDownload, rawURL)
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, errDownload, rawURL)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf(errDownloadStatus, rawURL, resp.Status)
+	}
+
+	f, err := os.CreateTemp("", "buildpack-*.zip")
+	if err != nil {
+		return nil, errors.Wrap(err, errTempFile)
+	}
+	bits := &Bits{ReadCloser: &tempFile{File: f}, FileName: fileName(rawURL)}
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, h), resp.Body); err != nil {
+		bits.Close() //nolint:errcheck
+		return nil, errors.Wrapf(err, errDownload, rawURL)
+	}
+	if got := checksumPrefix + hex.EncodeToString(h.Sum(nil)); got != checksum {
+		bits.Close() //nolint:errcheck
+		return nil, errors.Errorf(errChecksum, rawURL, checksum, got)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		bits.Close() //nolint:errcheck
+		return nil, errors.Wrap(err, errTempFile)
+	}
+	return bits, nil
+}
+
+// fetchImage pulls the single layer of the OCI artifact. The registry client
+// verifies the layer against its digest while it is read.
+func fetchImage(ctx context.Context, hc *http.Client, image string) (*Bits, error) {
+	ref, err := name.NewDigest(image)
+	if err != nil {
+		return nil, errors.Wrapf(err, errParseImage, image)
+	}
+	img, err := remote.Image(ref, remote.WithContext(ctx), remote.WithTransport(hc.Transport))
+	if err != nil {
+		return nil, errors.Wrapf(err, errPullImage, image)
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, errors.Wrapf(err, errPullImage, image)
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, errors.Wrapf(err, errPullImage, image)
+	}
+	if len(layers) != 1 || len(manifest.Layers) != 1 {
+		return nil, errors.Errorf(errImageLayers, image, len(layers))
+	}
+	rc, err := layers[0].Compressed()
+	if err != nil {
+		return nil, errors.Wrapf(err, errPullImage, image)
+	}
+
+	fn := manifest.Layers[0].Annotations[titleKey]
+	if fn == "" {
+		fn = path.Base(ref.Context().RepositoryStr())
+	}
+	return &Bits{ReadCloser: rc, FileName: zipName(fn)}, nil
+}
+
+// fileName returns the name of the zip file at the URL.
+func fileName(rawURL string) string {
+	fn := "buildpack"
+	if u, err := url.Parse(rawURL); err == nil && path.Base(u.Path) != "/" && path.Base(u.Path) != "." {
+		fn = path.Base(u.Path)
+	}
+	return zipName(fn)
+}
+
+// zipName appends the .zip extension Cloud Foundry requires for the bits.
+func zipName(fn string) string {
+	if strings.HasSuffix(fn, ".zip") {
+		return fn
+	}
+	return fn + ".zip"
+}
+
+// tempFile is a temporary file that is removed when it is closed.
+type tempFile struct {
+	*os.File
+}
+
+// Close closes and removes the temporary file.
+func (f *tempFile) Close() error {
+	err := f.File.Close()
+	if rmErr := os.Remove(f.Name()); err == nil {
+		err = rmErr
+	}
+	return err
+}
@@ -0,0 +1,153 @@
+package buildpack
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpresource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+// StateAwaitingUpload is the state of a buildpack without bits.
+const StateAwaitingUpload = "AWAITING_UPLOAD"
+
+// Client is the interface that defines the methods that a Buildpack client
+// should implement.
+type Client interface {
+	Get(ctx context.Context, guid string) (*resource.Buildpack, error)
+	Single(ctx context.Context, opts *client.BuildpackListOptions) (*resource.Buildpack, error)
+	Create(ctx context.Context, r *resource.BuildpackCreateOrUpdate) (*resource.Buildpack, error)
+	Update(ctx context.Context, guid string, r *resource.BuildpackCreateOrUpdate) (*resource.Buildpack, error)
+	Delete(ctx context.Context, guid string) (string, error)
+	Upload(ctx context.Context, guid string, fileName string, zipFile io.Reader) (string, *resource.Buildpack, error)
+}
+
+// NewClient creates a new Buildpack client and the job client to track the
+// processing of uploaded bits.
+func NewClient(cf *client.Client) (Client, job.Tracker) {
+	return cf.Buildpacks, cf.Jobs
+}
+
+// FindBySpec looks up a buildpack by name and stack when external-name is
+// empty.
+func FindBySpec(ctx context.Context, c Client, spec v1alpha1.BuildpackParameters) (*resource.Buildpack, error) {
+	opts := client.NewBuildpackListOptions()
+	opts.Names.EqualTo(spec.Name)
+	if spec.Stack != nil {
+		opts.Stacks.EqualTo(*spec.Stack)
+	}
+	return c.Single(ctx, opts)
+}
+
+// GenerateCreate generates the BuildpackCreateOrUpdate from an
+// *BuildpackParameters.
+func GenerateCreate(mg xpresource.Managed, spec v1alpha1.BuildpackParameters) *resource.BuildpackCreateOrUpdate {
+	create := resource.NewBuildpackCreate(spec.Name)
+	create.Stack = spec.Stack
+	create.Position = spec.Position
+	create.Enabled = spec.Enabled
+	create.Locked = spec.Locked
+	create.Metadata = metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	return create
+}
+
+// GenerateUpdate generates the BuildpackCreateOrUpdate from an
+// *BuildpackParameters. The observed stack is kept if the stack is not set,
+// as it is taken from the uploaded bits.
+func GenerateUpdate(mg xpresource.Managed, spec v1alpha1.BuildpackParameters, observedStack *string) *resource.BuildpackCreateOrUpdate {
+	update := GenerateCreate(mg, spec)
+	if update.Stack == nil {
+		update.Stack = observedStack
+	}
+	return update
+}
+
+// GenerateObservation takes a Buildpack resource and returns
+// *BuildpackObservation.
+func GenerateObservation(o *resource.Buildpack) v1alpha1.BuildpackObservation {
+	obs := v1alpha1.BuildpackObservation{
+		ID:        ptr.To(o.GUID),
+		Name:      ptr.To(o.Name),
+		Stack:     o.Stack,
+		Position:  ptr.To(o.Position),
+		Enabled:   ptr.To(o.Enabled),
+		Locked:    ptr.To(o.Locked),
+		State:     ptr.To(o.State),
+		Filename:  o.Filename,
+		CreatedAt: ptr.To(o.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt: ptr.To(o.UpdatedAt.Format(time.RFC3339)),
+	}
+	if o.Metadata != nil {
+		obs.Labels = o.Metadata.Labels
+		obs.Annotations = o.Metadata.Annotations
+	}
+	return obs
+}
+
+// GenerateJob takes a Job resource and returns *BuildpackJob.
+func GenerateJob(j *resource.Job) *v1alpha1.BuildpackJob {
+	errs := make([]string, 0, len(j.Errors))
+	for _, e := range j.Errors {
+		errs = append(errs, e.Detail)
+	}
+	return &v1alpha1.BuildpackJob{
+		GUID:      j.GUID,
+		Operation: j.Operation,
+		State:     string(j.State),
+		Errors:    strings.Join(errs, "; "),
+	}
+}
+
+// IsUpToDate checks whether the observed buildpack matches the given set of
+// parameters. Unset parameters are not compared. The bits are compared by
+// NeedsUpload.
+func IsUpToDate(mg xpresource.Managed, spec v1alpha1.BuildpackParameters, observed *resource.Buildpack) bool {
+	if observed == nil {
+		return false
+	}
+	if spec.Name != observed.Name {
+		return false
+	}
+	if spec.Stack != nil && *spec.Stack != ptr.Deref(observed.Stack, "") {
+		return false
+	}
+	if spec.Position != nil && *spec.Position != observed.Position {
+		return false
+	}
+	if spec.Enabled != nil && *spec.Enabled != observed.Enabled {
+		return false
+	}
+	if spec.Locked != nil && *spec.Locked != observed.Locked {
+		return false
+	}
+	desired := metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	var observedLabels, observedAnnotations map[string]*string
+	if observed.Metadata != nil {
+		observedLabels = observed.Metadata.Labels
+		observedAnnotations = observed.Metadata.Annotations
+	}
+	return metadata.IsMetadataUpToDate(desired.Labels, desired.Annotations, observedLabels, observedAnnotations)
+}
+
+// NeedsUpload checks whether the bits of the source have to be uploaded: the
+// buildpack has no bits yet or the checksum of the source differs from the
+// checksum of the uploaded bits. Bits whose processing failed are uploaded
+// again only once the checksum of the source changes.
+func NeedsUpload(source *v1alpha1.BuildpackSource, observed v1alpha1.BuildpackObservation) bool {
+	if source == nil {
+		return false
+	}
+	changed := Checksum(*source) != ptr.Deref(observed.Checksum, "")
+	if observed.LastJob != nil && observed.LastJob.State == string(resource.JobStateFailed) {
+		return changed
+	}
+	return changed || ptr.Deref(observed.State, "") == StateAwaitingUpload
+}
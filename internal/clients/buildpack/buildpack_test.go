@@ -0,0 +1,214 @@
+package buildpack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+var (
+	bitsContent  = "zip file of the buildpack"
+	bitsChecksum = func() string {
+		h := sha256.Sum256([]byte(bitsContent))
+		return checksumPrefix + hex.EncodeToString(h[:])
+	}()
+	digest = "sha256:" + strings.Repeat("c", 64)
+)
+
+func TestChecksum(t *testing.T) {
+	cases := map[string]struct {
+		source v1alpha1.BuildpackSource
+		want   string
+	}{
+		"URL": {
+			source: v1alpha1.BuildpackSource{URL: ptr.To("https://example.com/bp.zip"), Checksum: ptr.To(bitsChecksum)},
+			want:   bitsChecksum,
+		},
+		"Image": {
+			source: v1alpha1.BuildpackSource{Image: ptr.To("registry.example.com/buildpacks/java@" + digest)},
+			want:   digest,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Checksum(tc.source)); diff != "" {
+				t.Errorf("Checksum(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeedsUpload(t *testing.T) {
+	source := &v1alpha1.BuildpackSource{URL: ptr.To("https://example.com/bp.zip"), Checksum: ptr.To(bitsChecksum)}
+
+	cases := map[string]struct {
+		source   *v1alpha1.BuildpackSource
+		observed v1alpha1.BuildpackObservation
+		want     bool
+	}{
+		"NoSource": {
+			observed: v1alpha1.BuildpackObservation{State: ptr.To(StateAwaitingUpload)},
+			want:     false,
+		},
+		"AwaitingUpload": {
+			source:   source,
+			observed: v1alpha1.BuildpackObservation{State: ptr.To(StateAwaitingUpload), Checksum: ptr.To(bitsChecksum)},
+			want:     true,
+		},
+		"LastUploadFailed": {
+			source: source,
+			observed: v1alpha1.BuildpackObservation{State: ptr.To(StateAwaitingUpload), Checksum: ptr.To(bitsChecksum),
+				LastJob: &v1alpha1.BuildpackJob{State: string(resource.JobStateFailed)}},
+			want: false,
+		},
+		"LastUploadFailedChecksumChanged": {
+			source: source,
+			observed: v1alpha1.BuildpackObservation{State: ptr.To(StateAwaitingUpload), Checksum: ptr.To(digest),
+				LastJob: &v1alpha1.BuildpackJob{State: string(resource.JobStateFailed)}},
+			want: true,
+		},
+		"ChecksumChanged": {
+			source:   source,
+			observed: v1alpha1.BuildpackObservation{State: ptr.To("READY"), Checksum: ptr.To(digest)},
+			want:     true,
+		},
+		"UpToDate": {
+			source:   source,
+			observed: v1alpha1.BuildpackObservation{State: ptr.To("READY"), Checksum: ptr.To(bitsChecksum)},
+			want:     false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NeedsUpload(tc.source, tc.observed)); diff != "" {
+				t.Errorf("NeedsUpload(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	md := metadata.BuildMetadata(&v1alpha1.Buildpack{}, nil, nil)
+
+	cases := map[string]struct {
+		spec     v1alpha1.BuildpackParameters
+		observed *resource.Buildpack
+		want     bool
+	}{
+		"UpToDate": {
+			spec:     v1alpha1.BuildpackParameters{Name: "java", Stack: ptr.To("cflinuxfs4"), Position: ptr.To(2), Enabled: ptr.To(true)},
+			observed: &resource.Buildpack{Name: "java", Stack: ptr.To("cflinuxfs4"), Position: 2, Enabled: true, Metadata: md},
+			want:     true,
+		},
+		"UnsetFieldsIgnored": {
+			spec:     v1alpha1.BuildpackParameters{Name: "java"},
+			observed: &resource.Buildpack{Name: "java", Stack: ptr.To("cflinuxfs4"), Position: 7, Locked: true, Metadata: md},
+			want:     true,
+		},
+		"PositionChanged": {
+			spec:     v1alpha1.BuildpackParameters{Name: "java", Position: ptr.To(1)},
+			observed: &resource.Buildpack{Name: "java", Position: 2},
+			want:     false,
+		},
+		"Disabled": {
+			spec:     v1alpha1.BuildpackParameters{Name: "java", Enabled: ptr.To(false)},
+			observed: &resource.Buildpack{Name: "java", Enabled: true},
+			want:     false,
+		},
+		"NotFound": {
+			spec: v1alpha1.BuildpackParameters{Name: "java"},
+			want: false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(&v1alpha1.Buildpack{}, tc.spec, tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFetchURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing.zip" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.WriteString(w, bitsContent)
+	}))
+	defer srv.Close()
+
+	type want struct {
+		fileName string
+		content  string
+		err      error
+	}
+
+	cases := map[string]struct {
+		url      string
+		checksum string
+		want     want
+	}{
+		"Success": {
+			url:      srv.URL + "/java_buildpack.zip",
+			checksum: bitsChecksum,
+			want:     want{fileName: "java_buildpack.zip", content: bitsContent},
+		},
+		"NoZipExtension": {
+			url:      srv.URL + "/download/java",
+			checksum: bitsChecksum,
+			want:     want{fileName: "java.zip", content: bitsContent},
+		},
+		"ChecksumMismatch": {
+			url:      srv.URL + "/java_buildpack.zip",
+			checksum: digest,
+			want:     want{err: errors.Errorf(errChecksum, srv.URL+"/java_buildpack.zip", digest, bitsChecksum)},
+		},
+		"NotFound": {
+			url:      srv.URL + "/missing.zip",
+			checksum: bitsChecksum,
+			want:     want{err: errors.Errorf(errDownloadStatus, srv.URL+"/missing.zip", "404 Not Found")},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			bits, err := fetchURL(context.Background(), srv.Client(), tc.url, tc.checksum)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("fetchURL(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			defer bits.Close() //nolint:errcheck
+			content, err := io.ReadAll(bits)
+			if err != nil {
+				t.Fatalf("ReadAll(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.fileName, bits.FileName); diff != "" {
+				t.Errorf("fetchURL(...): -want file name, +got file name:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.content, string(content)); diff != "" {
+				t.Errorf("fetchURL(...): -want content, +got content:\n%s", diff)
+			}
+		})
+	}
+}
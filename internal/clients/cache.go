@@ -0,0 +1,155 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+package clients
+
+import (
+	"context"
+	"sync"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const errHashConnectionDetails = "cannot hash connection details"
+
+var (
+	cacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "cloudfoundry_client_cache_hits_total",
+		Help: "Number of times an authenticated Cloud Foundry client was reused from the cache.",
+	})
+	cacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "cloudfoundry_client_cache_misses_total",
+		Help: "Number of times a Cloud Foundry client had to be authenticated, because none was cached for the ProviderConfig and its credentials.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(cacheHits, cacheMisses)
+}
+
+// SharedClientCache is the client cache shared by all controllers.
+var SharedClientCache = NewClientCache()
+
+// A ClientCache caches authenticated Cloud Foundry clients per ProviderConfig,
+// so that reconciles do not authenticate against UAA over and over again. The
+// cached clients refresh their tokens on their own. An entry is replaced as
+// soon as the ProviderConfig spec, its credentials or its CA bundle change.
+type ClientCache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry // by ProviderConfigRef.String
+
+	hits   uint64
+	misses uint64
+
+	// newClient creates the client of an entry, replaced in tests.
+	newClient func(ctx context.Context, kube client.Client, conn *connectionDetails) (*cfv3.Client, error)
+}
+
+// cacheEntry is the client of a ProviderConfig, authenticated with the
+// connection details identified by hash.
+type cacheEntry struct {
+	hash   string
+	once   sync.Once
+	client *cfv3.Client
+	err    error
+}
+
+// NewClientCache returns an empty ClientCache.
+func NewClientCache() *ClientCache {
+	return &ClientCache{
+		entries:   map[string]*cacheEntry{},
+		newClient: newClient,
+	}
+}
+
+// Get returns the client of the ProviderConfig referenced by the managed
+// resource. The client is authenticated only if none is cached for the
+// current connection details of the ProviderConfig.
+func (c *ClientCache) Get(ctx context.Context, kube client.Client, mg resource.Managed) (*cfv3.Client, error) {
+	ref, err := ProviderConfigRefOf(mg)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetByProviderConfig(ctx, kube, ref)
+}
+
+// GetByProviderConfig returns the client of the referenced ProviderConfig.
+func (c *ClientCache) GetByProviderConfig(ctx context.Context, kube client.Client, ref ProviderConfigRef) (*cfv3.Client, error) {
+	conn, err := getConnectionDetails(ctx, kube, ref)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := conn.hash()
+	if err != nil {
+		return nil, errors.Wrap(err, errHashConnectionDetails)
+	}
+
+	e := c.entry(ref.String(), hash)
+	e.once.Do(func() {
+		e.client, e.err = c.newClient(ctx, kube, conn)
+	})
+	if e.err != nil {
+		// do not cache failures, the next reconcile tries again
+		c.remove(ref.String(), e)
+		return nil, e.err
+	}
+	return e.client, nil
+}
+
+// Invalidate removes the client of the referenced ProviderConfig from the
+// cache.
+func (c *ClientCache) Invalidate(ref ProviderConfigRef) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, ref.String())
+}
+
+// Stats returns the number of cache hits and misses.
+func (c *ClientCache) Stats() (hits, misses uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits, c.misses
+}
+
+// entry returns the entry of the named ProviderConfig for the given hash and
+// replaces an entry with an outdated hash.
+func (c *ClientCache) entry(name, hash string) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[name]; ok && e.hash == hash {
+		c.hits++
+		cacheHits.Inc()
+		return e
+	}
+	c.misses++
+	cacheMisses.Inc()
+	e := &cacheEntry{hash: hash}
+	c.entries[name] = e
+	return e
+}
+
+// remove removes the entry of the named ProviderConfig, unless it has been
+// replaced in the meantime.
+func (c *ClientCache) remove(name string, e *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[name] == e {
+		delete(c.entries, name)
+	}
+}
+
+// newClient authenticates a new client with the connection details.
+func newClient(ctx context.Context, kube client.Client, conn *connectionDetails) (*cfv3.Client, error) {
+	cfg, err := conn.newConfig(ctx, kube)
+	if err != nil {
+		return nil, err
+	}
+	return cfv3.New(cfg)
+}
@@ -0,0 +1,128 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+func TestClientCache(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type step struct {
+		credentials string
+		apiEndpoint string
+		invalidate  bool
+		buildErr    error
+	}
+	type want struct {
+		builds int
+		hits   uint64
+		misses uint64
+	}
+
+	cases := map[string]struct {
+		steps []step
+		want  want
+	}{
+		"ReuseClient": {
+			steps: []step{
+				{credentials: `{"email": "a", "password": "b"}`},
+				{credentials: `{"email": "a", "password": "b"}`},
+				{credentials: `{"email": "a", "password": "b"}`},
+			},
+			want: want{builds: 1, hits: 2, misses: 1},
+		},
+		"SecretChanged": {
+			steps: []step{
+				{credentials: `{"email": "a", "password": "b"}`},
+				{credentials: `{"email": "a", "password": "c"}`},
+				{credentials: `{"email": "a", "password": "c"}`},
+			},
+			want: want{builds: 2, hits: 1, misses: 2},
+		},
+		"ProviderConfigChanged": {
+			steps: []step{
+				{credentials: `{"email": "a", "password": "b"}`},
+				{credentials: `{"email": "a", "password": "b"}`, apiEndpoint: "https://api.other.example.com"},
+			},
+			want: want{builds: 2, hits: 0, misses: 2},
+		},
+		"Invalidated": {
+			steps: []step{
+				{credentials: `{"email": "a", "password": "b"}`},
+				{credentials: `{"email": "a", "password": "b"}`, invalidate: true},
+			},
+			want: want{builds: 2, hits: 0, misses: 2},
+		},
+		"FailuresAreNotCached": {
+			steps: []step{
+				{credentials: `{"email": "a", "password": "b"}`, buildErr: errBoom},
+				{credentials: `{"email": "a", "password": "b"}`},
+				{credentials: `{"email": "a", "password": "b"}`},
+			},
+			want: want{builds: 2, hits: 1, misses: 2},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			c := NewClientCache()
+			builds := 0
+			var buildErr error
+			c.newClient = func(context.Context, k8s.Client, *connectionDetails) (*cfv3.Client, error) {
+				builds++
+				if buildErr != nil {
+					return nil, buildErr
+				}
+				return &cfv3.Client{}, nil
+			}
+
+			for i, s := range tc.steps {
+				endpoint := "https://api.example.com"
+				if s.apiEndpoint != "" {
+					endpoint = s.apiEndpoint
+				}
+				kube := &fakeKube{
+					pc:      fakeProviderConfig(endpoint, "", nil),
+					secrets: map[string]map[string][]byte{credentialsSecret: {"credentials": []byte(s.credentials)}},
+				}
+				if s.invalidate {
+					c.Invalidate(ProviderConfigRef{Kind: v1beta1.ProviderConfigKind, Name: "default"})
+				}
+				buildErr = s.buildErr
+
+				_, err := c.Get(context.Background(), kube.client(), fakeManaged())
+				if diff := cmp.Diff(s.buildErr, err, cmpErrors()); diff != "" {
+					t.Fatalf("step %d: Get(...): -want error, +got error:\n%s", i, diff)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.builds, builds); diff != "" {
+				t.Errorf("builds: -want, +got:\n%s", diff)
+			}
+			hits, misses := c.Stats()
+			if diff := cmp.Diff(tc.want.hits, hits); diff != "" {
+				t.Errorf("hits: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.misses, misses); diff != "" {
+				t.Errorf("misses: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func cmpErrors() cmp.Option {
+	return cmp.Comparer(func(a, b error) bool {
+		if a == nil || b == nil {
+			return a == nil && b == nil
+		}
+		return a.Error() == b.Error()
+	})
+}
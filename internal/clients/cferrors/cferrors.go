@@ -0,0 +1,189 @@
+// Package cferrors classifies the errors of the Cloud Foundry API, so that
+// controllers can report and handle them by their category instead of by their
+// message.
+package cferrors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"golang.org/x/oauth2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
+)
+
+// A Category groups CF API errors that are handled alike.
+type Category string
+
+const (
+	// CategoryNone is the category of a nil error.
+	CategoryNone Category = ""
+	// CategoryNotFound errors report that a resource does not exist.
+	CategoryNotFound Category = "NotFound"
+	// CategoryConflict errors report that a request conflicts with the
+	// state of the foundation, e.g. because a name is taken.
+	CategoryConflict Category = "Conflict"
+	// CategoryQuota errors report that a quota of the org or space is exceeded.
+	CategoryQuota Category = "QuotaExceeded"
+	// CategoryTransient errors are expected to go away on their own, e.g. rate
+	// limits, unavailable components or operations in progress.
+	CategoryTransient Category = "Transient"
+	// CategoryAuth errors report that the credentials of the ProviderConfig are
+	// invalid or lack permissions.
+	CategoryAuth Category = "Unauthorized"
+	// CategoryBroker errors report that a service broker failed.
+	CategoryBroker Category = "BrokerFailure"
+	// CategoryInvalid errors report that a request is invalid.
+	CategoryInvalid Category = "Invalid"
+	// CategoryUnknown errors could not be classified.
+	CategoryUnknown Category = "Unknown"
+)
+
+// titleRe finds the title of a CF error in the message of a failed job. The CF
+// errors of a job lose their type, so this is the only error message that is
+// searched for a title.
+var titleRe = regexp.MustCompile(`CF-[A-Za-z_]+`)
+
+// Classify returns the category of the error.
+func Classify(err error) Category {
+	if err == nil {
+		return CategoryNone
+	}
+	if errors.Is(err, cfv3.ErrNoResultsReturned) || errors.Is(err, cfv3.ErrExactlyOneResultNotReturned) {
+		return CategoryNotFound
+	}
+
+	var cfErr cfresource.CloudFoundryError
+	if errors.As(err, &cfErr) {
+		if c := classifyTitle(cfErr.Title); c != CategoryUnknown {
+			return c
+		}
+	}
+	var cfErrs cfresource.CloudFoundryErrors
+	if errors.As(err, &cfErrs) && len(cfErrs.Errors) > 0 {
+		return Classify(cfErrs.Errors[0])
+	}
+	var httpErr cfresource.CloudFoundryHTTPError
+	if errors.As(err, &httpErr) {
+		return classifyStatus(httpErr.StatusCode)
+	}
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		if retrieveErr.Response != nil && retrieveErr.Response.StatusCode >= http.StatusInternalServerError {
+			return CategoryTransient
+		}
+		return CategoryAuth
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) {
+		return CategoryTransient
+	}
+
+	var jobErr *job.FailedError
+	if errors.As(err, &jobErr) {
+		return classifyTitle(titleRe.FindString(jobErr.Error()))
+	}
+	return CategoryUnknown
+}
+
+// classifyTitle returns the category of a CF error by its title, e.g.
+// CF-ResourceNotFound. The titles follow naming patterns, so that the
+// categories cover the CF error codes without listing all of them.
+func classifyTitle(title string) Category {
+	t := strings.TrimPrefix(title, "CF-")
+	switch {
+	case strings.Contains(t, "RateLimit"),
+		strings.HasSuffix(t, "Unavailable"),
+		strings.HasSuffix(t, "InProgress"),
+		strings.HasSuffix(t, "Timeout"),
+		strings.HasSuffix(t, "ConcurrencyError"),
+		t == "ServerError", t == "DatabaseError", t == "ServiceUnavailable", t == "BlobstoreError":
+		return CategoryTransient
+	case strings.HasSuffix(t, "NotFound"):
+		return CategoryNotFound
+	case t == "InvalidAuthToken", t == "NotAuthenticated", t == "NotAuthorized", t == "InsufficientScope",
+		strings.HasSuffix(t, "NotAuthorized"):
+		return CategoryAuth
+	case strings.Contains(t, "Quota") && strings.HasSuffix(t, "Exceeded"),
+		strings.HasPrefix(t, "Total") && strings.HasSuffix(t, "Exceeded"),
+		t == "InsufficientResources", t == "InsufficientRunningResourcesAvailable":
+		return CategoryQuota
+	case strings.HasSuffix(t, "Taken"),
+		strings.HasSuffix(t, "AlreadyExists"),
+		strings.Contains(t, "AlreadyBound"),
+		t == "UnprocessableEntity", t == "AssociationNotEmpty", t == "UnableToPerform",
+		strings.HasSuffix(t, "SharesExists"), strings.HasSuffix(t, "NotRemovable"):
+		return CategoryConflict
+	case strings.HasPrefix(t, "ServiceBroker"),
+		strings.HasPrefix(t, "ServiceGateway"),
+		strings.HasSuffix(t, "ProvisionFailed"),
+		strings.HasSuffix(t, "DeprovisionFailed"):
+		return CategoryBroker
+	case strings.HasSuffix(t, "Invalid"),
+		strings.HasSuffix(t, "BadRequest"),
+		strings.HasSuffix(t, "ParseError"),
+		strings.HasSuffix(t, "InvalidRequest"),
+		strings.HasSuffix(t, "BadQueryParameter"),
+		strings.HasSuffix(t, "ParameterInvalid"),
+		strings.HasSuffix(t, "Empty"),
+		strings.HasSuffix(t, "TooLong"),
+		strings.HasSuffix(t, "Disabled"):
+		return CategoryInvalid
+	}
+	return CategoryUnknown
+}
+
+// classifyStatus returns the category of an HTTP error without a CF error
+// body by its status code.
+func classifyStatus(code int) Category {
+	switch {
+	case code == http.StatusNotFound:
+		return CategoryNotFound
+	case code == http.StatusUnauthorized, code == http.StatusForbidden:
+		return CategoryAuth
+	case code == http.StatusConflict, code == http.StatusUnprocessableEntity:
+		return CategoryConflict
+	case code == http.StatusTooManyRequests, code >= http.StatusInternalServerError:
+		return CategoryTransient
+	case code >= http.StatusBadRequest:
+		return CategoryInvalid
+	}
+	return CategoryUnknown
+}
+
+// IsNotFound returns true if the error reports that a resource does not exist.
+func IsNotFound(err error) bool {
+	return Classify(err) == CategoryNotFound
+}
+
+// IgnoreNotFound returns nil if the error reports that a resource does not
+// exist and the error otherwise.
+func IgnoreNotFound(err error) error {
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// IsRetryable returns true if sending the same request again soon may succeed.
+// Errors of the Auth, Invalid and Quota categories persist until the managed
+// resource, the ProviderConfig or the foundation changes.
+func (c Category) IsRetryable() bool {
+	switch c {
+	case CategoryAuth, CategoryInvalid, CategoryQuota:
+		return false
+	}
+	return true
+}
+
+// Reason returns the condition reason of a request that failed with the error.
+func Reason(err error) xpv1.ConditionReason {
+	return xpv1.ConditionReason(Classify(err))
+}
@@ -0,0 +1,204 @@
+package cferrors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
+)
+
+func cfError(code int, title string) cfresource.CloudFoundryError {
+	return cfresource.CloudFoundryError{Code: code, Title: title, Detail: "detail"}
+}
+
+func TestClassify(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want Category
+	}{
+		"Nil": {
+			err:  nil,
+			want: CategoryNone,
+		},
+		"NoResults": {
+			err:  cfv3.ErrNoResultsReturned,
+			want: CategoryNotFound,
+		},
+		"ExactlyOneResultNotReturned": {
+			err:  errors.Wrap(cfv3.ErrExactlyOneResultNotReturned, "cannot get space"),
+			want: CategoryNotFound,
+		},
+		"ResourceNotFound": {
+			err:  cfresource.NewResourceNotFoundError(),
+			want: CategoryNotFound,
+		},
+		"ServiceBindingNotFound": {
+			err:  cfError(90004, "CF-ServiceBindingNotFound"),
+			want: CategoryNotFound,
+		},
+		"WrappedResourceNotFound": {
+			err:  fmt.Errorf("cannot delete app: %w", cfresource.NewResourceNotFoundError()),
+			want: CategoryNotFound,
+		},
+		"UnprocessableEntity": {
+			err:  cfError(10008, "CF-UnprocessableEntity"),
+			want: CategoryConflict,
+		},
+		"SpaceNameTaken": {
+			err:  cfError(40002, "CF-SpaceNameTaken"),
+			want: CategoryConflict,
+		},
+		"AssociationNotEmpty": {
+			err:  cfError(10006, "CF-AssociationNotEmpty"),
+			want: CategoryConflict,
+		},
+		"QuotaExceeded": {
+			err:  cfError(100005, "CF-AppMemoryQuotaExceeded"),
+			want: CategoryQuota,
+		},
+		"ServiceInstanceQuotaExceeded": {
+			err:  cfError(60005, "CF-ServiceInstanceQuotaExceeded"),
+			want: CategoryQuota,
+		},
+		"RateLimitExceeded": {
+			err:  cfError(10013, "CF-RateLimitExceeded"),
+			want: CategoryTransient,
+		},
+		"AsyncServiceInstanceOperationInProgress": {
+			err:  cfError(60016, "CF-AsyncServiceInstanceOperationInProgress"),
+			want: CategoryTransient,
+		},
+		"ServiceUnavailable": {
+			err:  cfError(10015, "CF-ServiceUnavailable"),
+			want: CategoryTransient,
+		},
+		"InvalidAuthToken": {
+			err:  cfError(1000, "CF-InvalidAuthToken"),
+			want: CategoryAuth,
+		},
+		"NotAuthorized": {
+			err:  cfError(10003, "CF-NotAuthorized"),
+			want: CategoryAuth,
+		},
+		"ServiceBrokerBadResponse": {
+			err:  cfError(10001, "CF-ServiceBrokerBadResponse"),
+			want: CategoryBroker,
+		},
+		"ServiceBrokerRequestRejected": {
+			err:  cfError(10001, "CF-ServiceBrokerRequestRejected"),
+			want: CategoryBroker,
+		},
+		"MessageParseError": {
+			err:  cfError(1001, "CF-MessageParseError"),
+			want: CategoryInvalid,
+		},
+		"UnknownTitle": {
+			err:  cfError(1, "CF-SomethingNew"),
+			want: CategoryUnknown,
+		},
+		"Errors": {
+			err:  cfresource.CloudFoundryErrors{Errors: []cfresource.CloudFoundryError{cfError(10008, "CF-UnprocessableEntity")}},
+			want: CategoryConflict,
+		},
+		"HTTPNotFound": {
+			err:  cfresource.CloudFoundryHTTPError{StatusCode: http.StatusNotFound},
+			want: CategoryNotFound,
+		},
+		"HTTPForbidden": {
+			err:  cfresource.CloudFoundryHTTPError{StatusCode: http.StatusForbidden},
+			want: CategoryAuth,
+		},
+		"HTTPTooManyRequests": {
+			err:  cfresource.CloudFoundryHTTPError{StatusCode: http.StatusTooManyRequests},
+			want: CategoryTransient,
+		},
+		"HTTPBadGateway": {
+			err:  cfresource.CloudFoundryHTTPError{StatusCode: http.StatusBadGateway},
+			want: CategoryTransient,
+		},
+		"HTTPBadRequest": {
+			err:  cfresource.CloudFoundryHTTPError{StatusCode: http.StatusBadRequest},
+			want: CategoryInvalid,
+		},
+		"TokenRejected": {
+			err:  &oauth2.RetrieveError{Response: &http.Response{StatusCode: http.StatusUnauthorized}},
+			want: CategoryAuth,
+		},
+		"TokenServerError": {
+			err:  &oauth2.RetrieveError{Response: &http.Response{StatusCode: http.StatusBadGateway}},
+			want: CategoryTransient,
+		},
+		"DeadlineExceeded": {
+			err:  errors.Wrap(context.DeadlineExceeded, "cannot get org"),
+			want: CategoryTransient,
+		},
+		"FailedJob": {
+			err:  errors.Wrap(&job.FailedError{Err: errors.New("received state FAILED while waiting for async process: \nCF-ServiceBrokerCatalogInvalid: Service broker catalog is invalid")}, "cannot create service broker"),
+			want: CategoryBroker,
+		},
+		"TitleInMessage": {
+			err:  errors.New("cannot create space: CF-SpaceNameTaken"),
+			want: CategoryUnknown,
+		},
+		"Message": {
+			err:  errors.New("NotFound"),
+			want: CategoryUnknown,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Classify(tc.err)); diff != "" {
+				t.Errorf("Classify(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	cases := map[string]struct {
+		c    Category
+		want bool
+	}{
+		"Transient": {
+			c:    CategoryTransient,
+			want: true,
+		},
+		"Unknown": {
+			c:    CategoryUnknown,
+			want: true,
+		},
+		"Conflict": {
+			c:    CategoryConflict,
+			want: true,
+		},
+		"Auth": {
+			c:    CategoryAuth,
+			want: false,
+		},
+		"Invalid": {
+			c:    CategoryInvalid,
+			want: false,
+		},
+		"Quota": {
+			c:    CategoryQuota,
+			want: false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.c.IsRetryable()); diff != "" {
+				t.Errorf("IsRetryable(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package cferrors
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// NewConnector returns an ExternalConnector whose external clients report the
+// outcome of their requests in the CFRequest condition of the managed resource.
+// Deleting a resource that does not exist anymore succeeds.
+func NewConnector(c managed.ExternalConnector) managed.ExternalConnector {
+	return &connector{ExternalConnector: c}
+}
+
+type connector struct {
+	managed.ExternalConnector
+}
+
+// Connect connects the wrapped external client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ext, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		report(mg, err)
+		return nil, err
+	}
+	return &external{ExternalClient: ext}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+}
+
+// Observe the external resource.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := e.ExternalClient.Observe(ctx, mg)
+	report(mg, err)
+	return obs, err
+}
+
+// Create the external resource.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cre, err := e.ExternalClient.Create(ctx, mg)
+	report(mg, err)
+	return cre, err
+}
+
+// Update the external resource.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	upd, err := e.ExternalClient.Update(ctx, mg)
+	report(mg, err)
+	return upd, err
+}
+
+// Delete the external resource. A resource that does not exist anymore has
+// been deleted, there is no point in retrying.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	del, err := e.ExternalClient.Delete(ctx, mg)
+	if IsNotFound(err) {
+		err = nil
+	}
+	report(mg, err)
+	return del, err
+}
+
+// report sets the CFRequest condition of the managed resource.
+func report(mg resource.Managed, err error) {
+	if err == nil {
+		mg.SetConditions(v1alpha1.RequestSucceeded())
+		return
+	}
+	mg.SetConditions(v1alpha1.RequestFailed(Reason(err), err))
+}
@@ -0,0 +1,70 @@
+package cferrors
+
+import (
+	"context"
+	"testing"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+func TestConnectorDelete(t *testing.T) {
+	errNameTaken := cfError(40002, "CF-SpaceNameTaken")
+
+	type want struct {
+		err  error
+		cond xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"Success": {
+			want: want{cond: v1alpha1.RequestSucceeded()},
+		},
+		"NotFound": {
+			err:  cfresource.NewResourceNotFoundError(),
+			want: want{cond: v1alpha1.RequestSucceeded()},
+		},
+		"Conflict": {
+			err: errors.Wrap(errNameTaken, "cannot delete space"),
+			want: want{
+				err:  errors.Wrap(errNameTaken, "cannot delete space"),
+				cond: v1alpha1.RequestFailed("Conflict", errors.Wrap(errNameTaken, "cannot delete space")),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			c := NewConnector(managed.ExternalConnectorFn(func(context.Context, resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					DeleteFn: func(context.Context, resource.Managed) (managed.ExternalDelete, error) {
+						return managed.ExternalDelete{}, tc.err
+					},
+				}, nil
+			}))
+			cr := &v1alpha1.Space{}
+			ext, err := c.Connect(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Connect(...): unexpected error: %v", err)
+			}
+
+			_, err = ext.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cond, cr.GetCondition(v1alpha1.TypeCFRequest), test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package cferrors
+
+import (
+	"context"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// NewReconciler returns a Reconciler that requeues a managed resource whose last
+// CF request failed with an error that is not retryable after the poll
+// interval, instead of retrying it with the backoff of the managed reconciler.
+// A change of the managed resource still reconciles it right away.
+func NewReconciler(kube client.Client, of resource.ManagedKind, r reconcile.Reconciler, pollInterval time.Duration) reconcile.Reconciler {
+	return &reconciler{Reconciler: r, kube: kube, of: of, pollInterval: pollInterval}
+}
+
+type reconciler struct {
+	reconcile.Reconciler
+	kube         client.Client
+	of           resource.ManagedKind
+	pollInterval time.Duration
+}
+
+// Reconcile the managed resource.
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.Reconciler.Reconcile(ctx, req)
+	if err != nil || !res.Requeue {
+		return res, err
+	}
+
+	obj, err := r.kube.Scheme().New(schema.GroupVersionKind(r.of))
+	if err != nil {
+		return res, nil
+	}
+	mg, ok := obj.(resource.Managed)
+	if !ok || r.kube.Get(ctx, req.NamespacedName, mg) != nil {
+		return res, nil
+	}
+	c := mg.GetCondition(v1alpha1.TypeCFRequest)
+	if c.Status != corev1.ConditionFalse || Category(c.Reason).IsRetryable() {
+		return res, nil
+	}
+	return reconcile.Result{RequeueAfter: r.pollInterval}, nil
+}
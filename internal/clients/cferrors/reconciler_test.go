@@ -0,0 +1,94 @@
+package cferrors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+func TestReconciler(t *testing.T) {
+	errBoom := errors.New("boom")
+	pollInterval := time.Minute
+
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %v", err)
+	}
+
+	type want struct {
+		res reconcile.Result
+		err error
+	}
+
+	cases := map[string]struct {
+		res  reconcile.Result
+		err  error
+		cond xpv1.Condition
+		want want
+	}{
+		"Error": {
+			err:  errBoom,
+			want: want{err: errBoom},
+		},
+		"RequeueAfter": {
+			res:  reconcile.Result{RequeueAfter: time.Hour},
+			cond: v1alpha1.RequestFailed(xpv1.ConditionReason(CategoryAuth), errBoom),
+			want: want{res: reconcile.Result{RequeueAfter: time.Hour}},
+		},
+		"RequestSucceeded": {
+			res:  reconcile.Result{Requeue: true},
+			cond: v1alpha1.RequestSucceeded(),
+			want: want{res: reconcile.Result{Requeue: true}},
+		},
+		"Transient": {
+			res:  reconcile.Result{Requeue: true},
+			cond: v1alpha1.RequestFailed(xpv1.ConditionReason(CategoryTransient), errBoom),
+			want: want{res: reconcile.Result{Requeue: true}},
+		},
+		"Auth": {
+			res:  reconcile.Result{Requeue: true},
+			cond: v1alpha1.RequestFailed(xpv1.ConditionReason(CategoryAuth), errBoom),
+			want: want{res: reconcile.Result{RequeueAfter: pollInterval}},
+		},
+		"Quota": {
+			res:  reconcile.Result{Requeue: true},
+			cond: v1alpha1.RequestFailed(xpv1.ConditionReason(CategoryQuota), errBoom),
+			want: want{res: reconcile.Result{RequeueAfter: pollInterval}},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(resource.Managed).SetConditions(tc.cond)
+					return nil
+				},
+				MockScheme: test.NewMockSchemeFn(s),
+			}
+			inner := reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				return tc.res, tc.err
+			})
+
+			r := NewReconciler(kube, resource.ManagedKind(v1alpha1.Space_GroupVersionKind), inner, pollInterval)
+			res, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.res, res); diff != "" {
+				t.Errorf("Reconcile(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package deployment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// Deployment strategies.
+const (
+	StrategyRolling = "rolling"
+	StrategyCanary  = "canary"
+)
+
+// Status values and reasons of a deployment.
+const (
+	DeploymentActive    = "ACTIVE"
+	DeploymentFinalized = "FINALIZED"
+	ReasonDeploying     = "DEPLOYING"
+	ReasonPaused        = "PAUSED"
+	ReasonDeployed      = "DEPLOYED"
+	ReasonCanceled      = "CANCELED"
+)
+
+// ActionKey is the annotation that continues, cancels or rolls back
+// the latest deployment of an App.
+const ActionKey = "app.cloudfoundry.crossplane.io/deployment-action"
+
+// Deployment actions.
+const (
+	ActionContinue = "continue"
+	ActionCancel   = "cancel"
+	ActionRollback = "rollback"
+)
+
+// The annotations of the deployments created by the provider.
+const (
+	specHashKey   = "cloudfoundry.crossplane.io/spec-hash"
+	rolledBackKey = "cloudfoundry.crossplane.io/rolled-back"
+)
+
+const (
+	errEncodeDeployment = "cannot encode the deployment"
+	errDecodeDeployment = "cannot decode the deployment"
+	errStage            = "cannot stage the docker image"
+)
+
+// Deployment is a deployment of the v3 API. Unlike resource.Deployment, it
+// includes the options and the canary status of the deployment.
+type Deployment struct {
+	resource.Resource `json:",inline"`
+	Strategy          string                 `json:"strategy"`
+	Status            DeploymentStatus       `json:"status"`
+	Options           *DeploymentOptions     `json:"options,omitempty"`
+	Droplet           *resource.Relationship `json:"droplet,omitempty"`
+	PreviousDroplet   *resource.Relationship `json:"previous_droplet,omitempty"`
+	Metadata          *resource.Metadata     `json:"metadata,omitempty"`
+}
+
+// DeploymentStatus is the status of a deployment.
+type DeploymentStatus struct {
+	Value  string        `json:"value"`
+	Reason string        `json:"reason"`
+	Canary *CanaryStatus `json:"canary,omitempty"`
+}
+
+// CanaryStatus is the progress of a canary deployment.
+type CanaryStatus struct {
+	Steps struct {
+		Current int `json:"current"`
+		Total   int `json:"total"`
+	} `json:"steps"`
+}
+
+// DeploymentOptions are the options of a deployment.
+type DeploymentOptions struct {
+	MaxInFlight *int           `json:"max_in_flight,omitempty"`
+	Canary      *CanaryOptions `json:"canary,omitempty"`
+}
+
+// CanaryOptions are the options of a canary deployment.
+type CanaryOptions struct {
+	Steps []CanaryStepOptions `json:"steps"`
+}
+
+// CanaryStepOptions is a step of a canary deployment.
+type CanaryStepOptions struct {
+	InstanceWeight int `json:"instance_weight"`
+}
+
+// DeploymentCreate is the request to create a deployment.
+type DeploymentCreate struct {
+	Relationships resource.AppRelationship `json:"relationships"`
+	Droplet       *resource.Relationship   `json:"droplet,omitempty"`
+	Strategy      string                   `json:"strategy,omitempty"`
+	Options       *DeploymentOptions       `json:"options,omitempty"`
+	Metadata      *resource.Metadata       `json:"metadata,omitempty"`
+}
+
+type deploymentList struct {
+	Resources []*Deployment `json:"resources"`
+}
+
+// Client defines the interface to roll out changes to a started
+// application with deployments.
+type Client interface {
+	// Latest returns the latest deployment of the app, or nil if the app has
+	// no deployment.
+	Latest(ctx context.Context, appGUID string) (*Deployment, error)
+	Create(ctx context.Context, r *DeploymentCreate) (*Deployment, error)
+	Continue(ctx context.Context, guid string) error
+	Cancel(ctx context.Context, guid string) error
+	// Stage stages a droplet of the docker image for the app without making
+	// it the current droplet, and returns its GUID.
+	Stage(ctx context.Context, appGUID string, image string, dockerCredentials *resource.DockerCredentials) (string, error)
+}
+
+// deploymentClient sends its own deployment requests, as the deployments of
+// the CF client have neither options nor the continue action.
+type deploymentClient struct {
+	cf *cfv3.Client
+}
+
+// NewClient creates a new Deployment client
+func NewClient(cf *cfv3.Client) Client {
+	return &deploymentClient{cf: cf}
+}
+
+// Latest returns the latest deployment of the app.
+func (c *deploymentClient) Latest(ctx context.Context, appGUID string) (*Deployment, error) {
+	q := url.Values{}
+	q.Set("app_guids", appGUID)
+	q.Set("order_by", "-created_at")
+	q.Set("per_page", "1")
+	l := &deploymentList{}
+	if err := c.do(ctx, http.MethodGet, "/v3/deployments?"+q.Encode(), nil, l); err != nil {
+		return nil, err
+	}
+	if len(l.Resources) == 0 {
+		return nil, nil
+	}
+	return l.Resources[0], nil
+}
+
+// Create creates a deployment.
+func (c *deploymentClient) Create(ctx context.Context, r *DeploymentCreate) (*Deployment, error) {
+	d := &Deployment{}
+	if err := c.do(ctx, http.MethodPost, "/v3/deployments", r, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Continue continues a paused canary deployment.
+func (c *deploymentClient) Continue(ctx context.Context, guid string) error {
+	return c.do(ctx, http.MethodPost, "/v3/deployments/"+guid+"/actions/continue", nil, nil)
+}
+
+// Cancel cancels an active deployment and rolls back to the previous droplet.
+func (c *deploymentClient) Cancel(ctx context.Context, guid string) error {
+	return c.cf.Deployments.Cancel(ctx, guid)
+}
+
+// Stage stages a droplet of the docker image for the app.
+func (c *deploymentClient) Stage(ctx context.Context, appGUID string, image string, dockerCredentials *resource.DockerCredentials) (string, error) {
+	create := resource.NewDockerPackageCreate(appGUID, image, "", "")
+	if dockerCredentials != nil {
+		create = resource.NewDockerPackageCreate(appGUID, image, dockerCredentials.Username, dockerCredentials.Password)
+	}
+	pkg, err := c.cf.Packages.Create(ctx, create)
+	if err != nil {
+		return "", errors.Wrap(err, errStage)
+	}
+	build := resource.NewBuildCreate(pkg.GUID)
+	build.Lifecycle = &resource.Lifecycle{Type: resource.LifecycleDocker.String()}
+	b, err := c.cf.Builds.Create(ctx, build)
+	if err != nil {
+		return "", errors.Wrap(err, errStage)
+	}
+	if err := c.cf.Builds.PollStaged(ctx, b.GUID, nil); err != nil {
+		return "", errors.Wrap(err, errStage)
+	}
+	b, err = c.cf.Builds.Get(ctx, b.GUID)
+	if err != nil {
+		return "", errors.Wrap(err, errStage)
+	}
+	if b.Droplet == nil {
+		return "", errors.New(errStage)
+	}
+	return b.Droplet.GUID, nil
+}
+
+func (c *deploymentClient) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		buf, err := json.Marshal(in)
+		if err != nil {
+			return errors.Wrap(err, errEncodeDeployment)
+		}
+		body = bytes.NewReader(buf)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.cf.ApiURL(path), body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.cf.ExecuteAuthRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if out == nil {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(out), errDecodeDeployment)
+}
+
+// Hash returns the hash of the docker image and the environment a
+// deployment rolls out.
+func Hash(spec v1alpha1.AppParameters) string {
+	var image string
+	if spec.Docker != nil {
+		image = spec.Docker.Image
+	}
+	buf, _ := json.Marshal(struct { //nolint:errchkjson
+		Image       string            `json:"image"`
+		Environment map[string]string `json:"environment"`
+	}{image, spec.Environment})
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateCreate generates the DeploymentCreate that rolls out the
+// droplet with the strategy of the spec. Without a droplet, the current
+// droplet is rolled out with the current environment of the app.
+func GenerateCreate(appGUID string, dropletGUID string, spec v1alpha1.AppParameters) *DeploymentCreate {
+	create := &DeploymentCreate{
+		Relationships: resource.AppRelationship{
+			App: resource.ToOneRelationship{Data: &resource.Relationship{GUID: appGUID}},
+		},
+		Strategy: ptr.Deref(spec.Strategy, StrategyRolling),
+		Metadata: &resource.Metadata{
+			Annotations: map[string]*string{specHashKey: ptr.To(Hash(spec))},
+		},
+	}
+	if dropletGUID != "" {
+		create.Droplet = &resource.Relationship{GUID: dropletGUID}
+	}
+	if d := spec.Deployment; d != nil {
+		create.Options = &DeploymentOptions{MaxInFlight: d.MaxInFlight}
+		if len(d.CanarySteps) > 0 {
+			create.Options.Canary = &CanaryOptions{}
+			for _, s := range d.CanarySteps {
+				create.Options.Canary.Steps = append(create.Options.Canary.Steps, CanaryStepOptions{InstanceWeight: s.InstanceWeight})
+			}
+		}
+	}
+	return create
+}
+
+// GenerateRollback generates the DeploymentCreate that rolls the app back to
+// the previous droplet of the observed deployment. The rollback keeps the
+// hash of the spec, so that the rolled back version is not deployed again
+// until the spec changes.
+func GenerateRollback(appGUID string, observed *v1alpha1.AppDeploymentObservation, spec v1alpha1.AppParameters) *DeploymentCreate {
+	create := GenerateCreate(appGUID, observed.PreviousDroplet, spec)
+	create.Options = nil
+	if create.Strategy == StrategyCanary {
+		create.Strategy = StrategyRolling
+	}
+	create.Metadata.Annotations[rolledBackKey] = ptr.To("true")
+	return create
+}
+
+// GenerateObservation takes a Deployment and returns
+// *AppDeploymentObservation.
+func GenerateObservation(d *Deployment) *v1alpha1.AppDeploymentObservation {
+	if d == nil {
+		return nil
+	}
+	obs := &v1alpha1.AppDeploymentObservation{
+		Strategy: d.Strategy,
+		Status:   d.Status.Value,
+		Reason:   d.Status.Reason,
+	}
+	obs.GUID = d.GUID
+	obs.CreatedAt = ptr.To(d.CreatedAt.Format(time.RFC3339))
+	obs.UpdatedAt = ptr.To(d.UpdatedAt.Format(time.RFC3339))
+	if d.Status.Canary != nil {
+		obs.CurrentCanaryStep = ptr.To(d.Status.Canary.Steps.Current)
+		obs.TotalCanarySteps = ptr.To(d.Status.Canary.Steps.Total)
+	}
+	if d.Droplet != nil {
+		obs.Droplet = d.Droplet.GUID
+	}
+	if d.PreviousDroplet != nil {
+		obs.PreviousDroplet = d.PreviousDroplet.GUID
+	}
+	if d.Metadata != nil {
+		obs.SpecHash = ptr.Deref(d.Metadata.Annotations[specHashKey], "")
+		obs.RolledBack = ptr.Deref(d.Metadata.Annotations[rolledBackKey], "") == "true"
+	}
+	return obs
+}
+
+// IsAction checks whether the value of the deployment action annotation is a
+// known action.
+func IsAction(action string) bool {
+	switch action {
+	case ActionContinue, ActionCancel, ActionRollback:
+		return true
+	}
+	return false
+}
+
+// IsActive checks whether the observed deployment is still rolling
+// out.
+func IsActive(observed *v1alpha1.AppDeploymentObservation) bool {
+	return observed != nil && observed.Status == DeploymentActive
+}
+
+// IsHeldBack checks whether the docker image and environment of the spec are
+// held back by the latest deployment: a deployment of the spec that is still
+// rolling out, was canceled or was rolled back is not deployed again until the
+// spec changes.
+func IsHeldBack(spec v1alpha1.AppParameters, observed *v1alpha1.AppDeploymentObservation) bool {
+	if spec.Strategy == nil || observed == nil || observed.SpecHash != Hash(spec) {
+		return false
+	}
+	return IsActive(observed) || observed.Reason == ReasonCanceled || observed.RolledBack
+}
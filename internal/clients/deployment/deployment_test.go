@@ -0,0 +1,183 @@
+package deployment
+
+import (
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+const appGUID = "2d8b0d04-d537-4e4e-8c6f-f09ca0e7f56f"
+
+func TestGenerateCreate(t *testing.T) {
+	cases := map[string]struct {
+		droplet string
+		spec    v1alpha1.AppParameters
+		want    *DeploymentCreate
+	}{
+		"Rolling": {
+			spec: v1alpha1.AppParameters{
+				Strategy:   ptr.To(StrategyRolling),
+				Deployment: &v1alpha1.DeploymentConfiguration{MaxInFlight: ptr.To(2)},
+			},
+			want: &DeploymentCreate{
+				Strategy: StrategyRolling,
+				Options:  &DeploymentOptions{MaxInFlight: ptr.To(2)},
+			},
+		},
+		"CanaryWithDroplet": {
+			droplet: "droplet-guid",
+			spec: v1alpha1.AppParameters{
+				Strategy: ptr.To(StrategyCanary),
+				Deployment: &v1alpha1.DeploymentConfiguration{
+					CanarySteps: []v1alpha1.CanaryStep{{InstanceWeight: 10}, {InstanceWeight: 50}},
+				},
+			},
+			want: &DeploymentCreate{
+				Droplet:  &resource.Relationship{GUID: "droplet-guid"},
+				Strategy: StrategyCanary,
+				Options: &DeploymentOptions{
+					Canary: &CanaryOptions{Steps: []CanaryStepOptions{{InstanceWeight: 10}, {InstanceWeight: 50}}},
+				},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateCreate(appGUID, tc.droplet, tc.spec)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(DeploymentCreate{}, "Relationships", "Metadata")); diff != "" {
+				t.Errorf("GenerateCreate(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(appGUID, got.Relationships.App.Data.GUID); diff != "" {
+				t.Errorf("GenerateCreate(...): -want app, +got app:\n%s", diff)
+			}
+			if diff := cmp.Diff(ptr.To(Hash(tc.spec)), got.Metadata.Annotations[specHashKey]); diff != "" {
+				t.Errorf("GenerateCreate(...): -want hash, +got hash:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	d := &Deployment{
+		Resource: resource.Resource{GUID: "deployment-guid"},
+		Strategy: StrategyCanary,
+		Status: DeploymentStatus{
+			Value:  DeploymentActive,
+			Reason: ReasonPaused,
+			Canary: &CanaryStatus{},
+		},
+		Droplet:         &resource.Relationship{GUID: "droplet-guid"},
+		PreviousDroplet: &resource.Relationship{GUID: "previous-droplet-guid"},
+		Metadata: &resource.Metadata{Annotations: map[string]*string{
+			specHashKey:   ptr.To("hash"),
+			rolledBackKey: ptr.To("true"),
+		}},
+	}
+	d.Status.Canary.Steps.Current = 1
+	d.Status.Canary.Steps.Total = 2
+
+	want := &v1alpha1.AppDeploymentObservation{
+		Strategy:          StrategyCanary,
+		Status:            DeploymentActive,
+		Reason:            ReasonPaused,
+		CurrentCanaryStep: ptr.To(1),
+		TotalCanarySteps:  ptr.To(2),
+		Droplet:           "droplet-guid",
+		PreviousDroplet:   "previous-droplet-guid",
+		RolledBack:        true,
+		SpecHash:          "hash",
+	}
+	want.GUID = "deployment-guid"
+
+	if diff := cmp.Diff(want, GenerateObservation(d), cmpopts.IgnoreFields(v1alpha1.Resource{}, "CreatedAt", "UpdatedAt")); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+	if GenerateObservation(nil) != nil {
+		t.Errorf("GenerateObservation(nil): want nil")
+	}
+}
+
+func TestIsHeldBack(t *testing.T) {
+	spec := v1alpha1.AppParameters{
+		Strategy:    ptr.To(StrategyRolling),
+		Docker:      &v1alpha1.DockerConfiguration{Image: "cloudfoundry/test-app:v2"},
+		Environment: map[string]string{"MY_VAR": "hello"},
+	}
+	hash := Hash(spec)
+
+	cases := map[string]struct {
+		spec     v1alpha1.AppParameters
+		observed *v1alpha1.AppDeploymentObservation
+		want     bool
+	}{
+		"NoDeployment": {
+			spec: spec,
+			want: false,
+		},
+		"NoStrategy": {
+			spec:     v1alpha1.AppParameters{Docker: spec.Docker, Environment: spec.Environment},
+			observed: &v1alpha1.AppDeploymentObservation{Status: DeploymentActive, SpecHash: hash},
+			want:     false,
+		},
+		"Active": {
+			spec:     spec,
+			observed: &v1alpha1.AppDeploymentObservation{Status: DeploymentActive, Reason: ReasonDeploying, SpecHash: hash},
+			want:     true,
+		},
+		"Canceled": {
+			spec:     spec,
+			observed: &v1alpha1.AppDeploymentObservation{Status: DeploymentFinalized, Reason: ReasonCanceled, SpecHash: hash},
+			want:     true,
+		},
+		"RolledBack": {
+			spec:     spec,
+			observed: &v1alpha1.AppDeploymentObservation{Status: DeploymentFinalized, Reason: ReasonDeployed, SpecHash: hash, RolledBack: true},
+			want:     true,
+		},
+		"Deployed": {
+			spec:     spec,
+			observed: &v1alpha1.AppDeploymentObservation{Status: DeploymentFinalized, Reason: ReasonDeployed, SpecHash: hash},
+			want:     false,
+		},
+		"SpecChanged": {
+			spec:     spec,
+			observed: &v1alpha1.AppDeploymentObservation{Status: DeploymentFinalized, Reason: ReasonCanceled, SpecHash: "other"},
+			want:     false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if got := IsHeldBack(tc.spec, tc.observed); got != tc.want {
+				t.Errorf("IsHeldBack(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsAction(t *testing.T) {
+	cases := map[string]struct {
+		action string
+		want   bool
+	}{
+		"Continue": {action: ActionContinue, want: true},
+		"Cancel":   {action: ActionCancel, want: true},
+		"Rollback": {action: ActionRollback, want: true},
+		"Unknown":  {action: "promote", want: false},
+		"Empty":    {action: "", want: false},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if got := IsAction(tc.action); got != tc.want {
+				t.Errorf("IsAction(%q): want %t, got %t", tc.action, tc.want, got)
+			}
+		})
+	}
+}
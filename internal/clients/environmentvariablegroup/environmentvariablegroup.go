@@ -0,0 +1,130 @@
+package environmentvariablegroup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+const (
+	errEncodeUpdate = "cannot encode the update of the environment variable group"
+	errDecodeGroup  = "cannot decode the environment variable group"
+)
+
+// Client is the interface that defines the methods that an
+// EnvironmentVariableGroup client should implement.
+type Client interface {
+	Get(ctx context.Context, name string) (*resource.EnvVarGroup, error)
+	Update(ctx context.Context, name string, vars map[string]*string) (*resource.EnvVarGroup, error)
+}
+
+// NewClient creates a new EnvironmentVariableGroup client
+func NewClient(cf *client.Client) Client {
+	return &envVarGroupClient{cf: cf}
+}
+
+// envVarGroupClient gets the groups with the CF client, but sends its own
+// update: the API merges the variables of an update into the group and only
+// removes variables set to null, which resource.EnvVarGroupUpdate cannot
+// express.
+type envVarGroupClient struct {
+	cf *client.Client
+}
+
+// Get retrieves the running or staging environment variable group.
+func (c *envVarGroupClient) Get(ctx context.Context, name string) (*resource.EnvVarGroup, error) {
+	return c.cf.EnvVarGroups.Get(ctx, name)
+}
+
+// Update sets the variables of the running or staging environment variable
+// group. Variables with a nil value are removed from the group.
+func (c *envVarGroupClient) Update(ctx context.Context, name string, vars map[string]*string) (*resource.EnvVarGroup, error) {
+	body, err := json.Marshal(map[string]map[string]*string{"var": vars})
+	if err != nil {
+		return nil, errors.Wrap(err, errEncodeUpdate)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.cf.ApiURL("/v3/environment_variable_groups/"+name), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.cf.ExecuteAuthRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	g := &resource.EnvVarGroup{}
+	if err := json.NewDecoder(resp.Body).Decode(g); err != nil {
+		return nil, errors.Wrap(err, errDecodeGroup)
+	}
+	return g, nil
+}
+
+// GenerateUpdate generates the variables that replace the observed variables
+// of the group with the desired ones. Observed variables that are not desired
+// are removed.
+func GenerateUpdate(desired map[string]string, observedNames []string) map[string]*string {
+	vars := make(map[string]*string, len(desired)+len(observedNames))
+	for _, k := range observedNames {
+		vars[k] = nil
+	}
+	for k, v := range desired {
+		vars[k] = ptr.To(v)
+	}
+	return vars
+}
+
+// GenerateReset generates the variables that remove all observed variables
+// from the group.
+func GenerateReset(observedNames []string) map[string]*string {
+	return GenerateUpdate(nil, observedNames)
+}
+
+// GenerateObservation takes an EnvVarGroup resource and returns
+// *EnvironmentVariableGroupObservation. Only the names of the variables are
+// observed, as their values may be secret.
+func GenerateObservation(o *resource.EnvVarGroup) v1alpha1.EnvironmentVariableGroupObservation {
+	var names []string
+	for k := range o.Var {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return v1alpha1.EnvironmentVariableGroupObservation{
+		Type:          ptr.To(o.Name),
+		VariableNames: names,
+		UpdatedAt:     ptr.To(o.UpdatedAt.Format(time.RFC3339)),
+	}
+}
+
+// IsEmpty checks whether the group has no variables.
+func IsEmpty(observed *resource.EnvVarGroup) bool {
+	return len(observed.Var) == 0
+}
+
+// IsUpToDate checks whether the observed variables of the group are exactly
+// the desired ones.
+func IsUpToDate(desired map[string]string, observed *resource.EnvVarGroup) bool {
+	if observed == nil {
+		return false
+	}
+	if desired == nil {
+		desired = map[string]string{}
+	}
+	current := observed.Var
+	if current == nil {
+		current = map[string]string{}
+	}
+	return reflect.DeepEqual(desired, current)
+}
@@ -0,0 +1,97 @@
+package environmentvariablegroup
+
+import (
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+func TestGenerateUpdate(t *testing.T) {
+	cases := map[string]struct {
+		desired       map[string]string
+		observedNames []string
+		want          map[string]*string
+	}{
+		"AddVariables": {
+			desired: map[string]string{"HTTP_PROXY": "http://proxy:3128"},
+			want:    map[string]*string{"HTTP_PROXY": ptr.To("http://proxy:3128")},
+		},
+		"RemoveUndesiredVariables": {
+			desired:       map[string]string{"HTTP_PROXY": "http://proxy:3128"},
+			observedNames: []string{"HTTP_PROXY", "FOO"},
+			want:          map[string]*string{"HTTP_PROXY": ptr.To("http://proxy:3128"), "FOO": nil},
+		},
+		"RemoveAllVariables": {
+			observedNames: []string{"HTTP_PROXY"},
+			want:          map[string]*string{"HTTP_PROXY": nil},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdate(tc.desired, tc.observedNames)); diff != "" {
+				t.Errorf("GenerateUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	got := GenerateObservation(&resource.EnvVarGroup{Name: "staging", Var: map[string]string{"B": "secret", "A": "secret"}})
+	want := v1alpha1.EnvironmentVariableGroupObservation{
+		Type:          ptr.To("staging"),
+		VariableNames: []string{"A", "B"},
+		UpdatedAt:     ptr.To("0001-01-01T00:00:00Z"),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  map[string]string
+		observed *resource.EnvVarGroup
+		want     bool
+	}{
+		"UpToDate": {
+			desired:  map[string]string{"HTTP_PROXY": "http://proxy:3128"},
+			observed: &resource.EnvVarGroup{Var: map[string]string{"HTTP_PROXY": "http://proxy:3128"}},
+			want:     true,
+		},
+		"BothEmpty": {
+			observed: &resource.EnvVarGroup{},
+			want:     true,
+		},
+		"ValueChanged": {
+			desired:  map[string]string{"HTTP_PROXY": "http://proxy:3128"},
+			observed: &resource.EnvVarGroup{Var: map[string]string{"HTTP_PROXY": "http://other:3128"}},
+			want:     false,
+		},
+		"ExtraVariable": {
+			desired:  map[string]string{"HTTP_PROXY": "http://proxy:3128"},
+			observed: &resource.EnvVarGroup{Var: map[string]string{"HTTP_PROXY": "http://proxy:3128", "FOO": "bar"}},
+			want:     false,
+		},
+		"MissingVariable": {
+			desired:  map[string]string{"HTTP_PROXY": "http://proxy:3128"},
+			observed: &resource.EnvVarGroup{},
+			want:     false,
+		},
+		"NotFound": {
+			want: false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.desired, tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
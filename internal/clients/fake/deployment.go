@@ -0,0 +1,50 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/deployment"
+)
+
+// MockDeployment mocks Client interfaces
+type MockDeployment struct {
+	mock.Mock
+}
+
+// Latest mocks Client.Latest
+func (m *MockDeployment) Latest(ctx context.Context, appGUID string) (*deployment.Deployment, error) {
+	args := m.Called(appGUID)
+	return args.Get(0).(*deployment.Deployment), args.Error(1)
+}
+
+// Create mocks Client.Create
+func (m *MockDeployment) Create(ctx context.Context, r *deployment.DeploymentCreate) (*deployment.Deployment, error) {
+	args := m.Called(r)
+	return args.Get(0).(*deployment.Deployment), args.Error(1)
+}
+
+// Continue mocks Client.Continue
+func (m *MockDeployment) Continue(ctx context.Context, guid string) error {
+	args := m.Called(guid)
+	return args.Error(0)
+}
+
+// Cancel mocks Client.Cancel
+func (m *MockDeployment) Cancel(ctx context.Context, guid string) error {
+	args := m.Called(guid)
+	return args.Error(0)
+}
+
+// Stage mocks Client.Stage
+func (m *MockDeployment) Stage(ctx context.Context, appGUID string, image string, dockerCredentials *resource.DockerCredentials) (string, error) {
+	args := m.Called(appGUID, image)
+	return args.String(0), args.Error(1)
+}
+
+// DeploymentNil is a nil Deployment
+var (
+	DeploymentNil *deployment.Deployment
+)
@@ -0,0 +1,31 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEnvironmentVariableGroup mocks EnvironmentVariableGroup interfaces
+type MockEnvironmentVariableGroup struct {
+	mock.Mock
+}
+
+// Get mocks EnvironmentVariableGroup.Get
+func (m *MockEnvironmentVariableGroup) Get(ctx context.Context, name string) (*resource.EnvVarGroup, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.EnvVarGroup), args.Error(1)
+}
+
+// Update mocks EnvironmentVariableGroup.Update
+func (m *MockEnvironmentVariableGroup) Update(ctx context.Context, name string, vars map[string]*string) (*resource.EnvVarGroup, error) {
+	args := m.Called(name, vars)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.EnvVarGroup), args.Error(1)
+}
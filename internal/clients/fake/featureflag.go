@@ -0,0 +1,31 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFeatureFlag mocks FeatureFlag interfaces
+type MockFeatureFlag struct {
+	mock.Mock
+}
+
+// Get mocks FeatureFlag.Get
+func (m *MockFeatureFlag) Get(ctx context.Context, name string) (*resource.FeatureFlag, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.FeatureFlag), args.Error(1)
+}
+
+// Update mocks FeatureFlag.Update
+func (m *MockFeatureFlag) Update(ctx context.Context, name string, r *resource.FeatureFlagUpdate) (*resource.FeatureFlag, error) {
+	args := m.Called(name, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.FeatureFlag), args.Error(1)
+}
@@ -0,0 +1,107 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockIsolationSegment mocks IsolationSegment interfaces
+type MockIsolationSegment struct {
+	mock.Mock
+}
+
+// Get mocks IsolationSegment.Get
+func (m *MockIsolationSegment) Get(ctx context.Context, guid string) (*resource.IsolationSegment, error) {
+	args := m.Called(guid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.IsolationSegment), args.Error(1)
+}
+
+// Single mocks IsolationSegment.Single
+func (m *MockIsolationSegment) Single(ctx context.Context, opts *client.IsolationSegmentListOptions) (*resource.IsolationSegment, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.IsolationSegment), args.Error(1)
+}
+
+// Create mocks IsolationSegment.Create
+func (m *MockIsolationSegment) Create(ctx context.Context, r *resource.IsolationSegmentCreate) (*resource.IsolationSegment, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.IsolationSegment), args.Error(1)
+}
+
+// Update mocks IsolationSegment.Update
+func (m *MockIsolationSegment) Update(ctx context.Context, guid string, r *resource.IsolationSegmentUpdate) (*resource.IsolationSegment, error) {
+	args := m.Called(guid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.IsolationSegment), args.Error(1)
+}
+
+// Delete mocks IsolationSegment.Delete
+func (m *MockIsolationSegment) Delete(ctx context.Context, guid string) error {
+	args := m.Called(guid)
+	return args.Error(0)
+}
+
+// ListOrganizationRelationships mocks IsolationSegment.ListOrganizationRelationships
+func (m *MockIsolationSegment) ListOrganizationRelationships(ctx context.Context, guid string) ([]string, error) {
+	args := m.Called(guid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// EntitleOrganizations mocks IsolationSegment.EntitleOrganizations
+func (m *MockIsolationSegment) EntitleOrganizations(ctx context.Context, guid string, organizationGUIDs []string) (*resource.IsolationSegmentRelationship, error) {
+	args := m.Called(guid, organizationGUIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.IsolationSegmentRelationship), args.Error(1)
+}
+
+// RevokeOrganizations mocks IsolationSegment.RevokeOrganizations
+func (m *MockIsolationSegment) RevokeOrganizations(ctx context.Context, guid string, organizationGUIDs []string) error {
+	args := m.Called(guid, organizationGUIDs)
+	return args.Error(0)
+}
+
+// IsolationSegmentNil is a nil IsolationSegment
+var (
+	IsolationSegmentNil *resource.IsolationSegment
+)
+
+// IsolationSegment is an IsolationSegment object
+type IsolationSegment struct {
+	resource.IsolationSegment
+}
+
+// NewIsolationSegment generate a new IsolationSegment
+func NewIsolationSegment() *IsolationSegment {
+	return &IsolationSegment{}
+}
+
+// SetName assigns IsolationSegment name
+func (s *IsolationSegment) SetName(name string) *IsolationSegment {
+	s.Name = name
+	return s
+}
+
+// SetGUID assigns IsolationSegment GUID
+func (s *IsolationSegment) SetGUID(guid string) *IsolationSegment {
+	s.GUID = guid
+	return s
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -12,6 +13,15 @@ type MockJob struct {
 	mock.Mock
 }
 
+// Get mocks Job.Get
+func (m *MockJob) Get(ctx context.Context, job string) (*resource.Job, error) {
+	args := m.Called(job)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.Job), args.Error(1)
+}
+
 // PollComplete mocks Job.PollComplete
 func (m *MockJob) PollComplete(ctx context.Context, job string, opt *client.PollingOptions) error {
 	args := m.Called()
@@ -13,17 +13,17 @@ type MockKeyRotator struct {
 	mock.Mock
 }
 
-func (m *MockKeyRotator) RetireBinding(cr *v1alpha1.ServiceCredentialBinding, serviceBinding *cfresource.ServiceCredentialBinding) bool {
+func (m *MockKeyRotator) RetireBinding(cr v1alpha1.ServiceCredentialBindingManaged, serviceBinding *cfresource.ServiceCredentialBinding) bool {
 	args := m.Called(cr, serviceBinding)
 	return args.Bool(0)
 }
 
-func (m *MockKeyRotator) HasExpiredKeys(cr *v1alpha1.ServiceCredentialBinding) bool {
+func (m *MockKeyRotator) HasExpiredKeys(cr v1alpha1.ServiceCredentialBindingManaged) bool {
 	args := m.Called(cr)
 	return args.Bool(0)
 }
 
-func (m *MockKeyRotator) DeleteExpiredKeys(ctx context.Context, cr *v1alpha1.ServiceCredentialBinding) ([]*v1alpha1.SCBResource, error) {
+func (m *MockKeyRotator) DeleteExpiredKeys(ctx context.Context, cr v1alpha1.ServiceCredentialBindingManaged) ([]*v1alpha1.SCBResource, error) {
 	args := m.Called(ctx, cr)
 	if len(args) == 2 {
 		return args.Get(0).([]*v1alpha1.SCBResource), args.Error(1)
@@ -31,7 +31,7 @@ func (m *MockKeyRotator) DeleteExpiredKeys(ctx context.Context, cr *v1alpha1.Ser
 	return nil, args.Error(0)
 }
 
-func (m *MockKeyRotator) DeleteRetiredKeys(ctx context.Context, cr *v1alpha1.ServiceCredentialBinding) error {
+func (m *MockKeyRotator) DeleteRetiredKeys(ctx context.Context, cr v1alpha1.ServiceCredentialBindingManaged) error {
 	args := m.Called(ctx, cr)
 	return args.Error(0)
 }
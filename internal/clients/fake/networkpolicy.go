@@ -0,0 +1,32 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/networkpolicy"
+)
+
+// MockNetworkPolicy mocks NetworkPolicy interfaces
+type MockNetworkPolicy struct {
+	mock.Mock
+}
+
+// List mocks NetworkPolicy.List
+func (m *MockNetworkPolicy) List(ctx context.Context, appGUID string) ([]networkpolicy.Policy, error) {
+	args := m.Called(appGUID)
+	return args.Get(0).([]networkpolicy.Policy), args.Error(1)
+}
+
+// Create mocks NetworkPolicy.Create
+func (m *MockNetworkPolicy) Create(ctx context.Context, policies ...networkpolicy.Policy) error {
+	args := m.Called(policies)
+	return args.Error(0)
+}
+
+// Delete mocks NetworkPolicy.Delete
+func (m *MockNetworkPolicy) Delete(ctx context.Context, policies ...networkpolicy.Policy) error {
+	args := m.Called(policies)
+	return args.Error(0)
+}
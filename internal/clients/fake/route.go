@@ -43,6 +43,36 @@ func (m *MockRoute) Delete(ctx context.Context, guid string) (string, error) {
 	return args.Get(0).(string), args.Error(1)
 }
 
+// GetSharedSpacesRelationships mocks Route.GetSharedSpacesRelationships
+func (m *MockRoute) GetSharedSpacesRelationships(ctx context.Context, guid string) (*resource.RouteSharedSpaceRelationships, error) {
+	args := m.Called(guid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.RouteSharedSpaceRelationships), args.Error(1)
+}
+
+// ShareWithSpaces mocks Route.ShareWithSpaces
+func (m *MockRoute) ShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) (*resource.RouteSharedSpaceRelationships, error) {
+	args := m.Called(guid, spaceGUIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.RouteSharedSpaceRelationships), args.Error(1)
+}
+
+// UnShareWithSpaces mocks Route.UnShareWithSpaces
+func (m *MockRoute) UnShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) error {
+	args := m.Called(guid, spaceGUIDs)
+	return args.Error(0)
+}
+
+// TransferOwnership mocks Route.TransferOwnership
+func (m *MockRoute) TransferOwnership(ctx context.Context, guid string, spaceGUID string) error {
+	args := m.Called(guid, spaceGUID)
+	return args.Error(0)
+}
+
 // RouteNil is a nil Route
 var (
 	RouteNil *resource.Route
@@ -0,0 +1,131 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSecurityGroup mocks SecurityGroup interfaces
+type MockSecurityGroup struct {
+	mock.Mock
+}
+
+// Get mocks SecurityGroup.Get
+func (m *MockSecurityGroup) Get(ctx context.Context, guid string) (*resource.SecurityGroup, error) {
+	args := m.Called(guid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.SecurityGroup), args.Error(1)
+}
+
+// Single mocks SecurityGroup.Single
+func (m *MockSecurityGroup) Single(ctx context.Context, opts *client.SecurityGroupListOptions) (*resource.SecurityGroup, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.SecurityGroup), args.Error(1)
+}
+
+// Create mocks SecurityGroup.Create
+func (m *MockSecurityGroup) Create(ctx context.Context, r *resource.SecurityGroupCreate) (*resource.SecurityGroup, error) {
+	args := m.Called(r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.SecurityGroup), args.Error(1)
+}
+
+// Update mocks SecurityGroup.Update
+func (m *MockSecurityGroup) Update(ctx context.Context, guid string, r *resource.SecurityGroupUpdate) (*resource.SecurityGroup, error) {
+	args := m.Called(guid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.SecurityGroup), args.Error(1)
+}
+
+// Delete mocks SecurityGroup.Delete
+func (m *MockSecurityGroup) Delete(ctx context.Context, guid string) (string, error) {
+	args := m.Called(guid)
+	return args.String(0), args.Error(1)
+}
+
+// BindRunningSecurityGroup mocks SecurityGroup.BindRunningSecurityGroup
+func (m *MockSecurityGroup) BindRunningSecurityGroup(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error) {
+	args := m.Called(guid, spaceGUIDs)
+	return spaceGUIDs, args.Error(0)
+}
+
+// BindStagingSecurityGroup mocks SecurityGroup.BindStagingSecurityGroup
+func (m *MockSecurityGroup) BindStagingSecurityGroup(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error) {
+	args := m.Called(guid, spaceGUIDs)
+	return spaceGUIDs, args.Error(0)
+}
+
+// UnBindRunningSecurityGroup mocks SecurityGroup.UnBindRunningSecurityGroup
+func (m *MockSecurityGroup) UnBindRunningSecurityGroup(ctx context.Context, guid string, spaceGUID string) error {
+	args := m.Called(guid, spaceGUID)
+	return args.Error(0)
+}
+
+// UnBindStagingSecurityGroup mocks SecurityGroup.UnBindStagingSecurityGroup
+func (m *MockSecurityGroup) UnBindStagingSecurityGroup(ctx context.Context, guid string, spaceGUID string) error {
+	args := m.Called(guid, spaceGUID)
+	return args.Error(0)
+}
+
+// ClearRules mocks SecurityGroup.ClearRules
+func (m *MockSecurityGroup) ClearRules(ctx context.Context, guid string) error {
+	args := m.Called(guid)
+	return args.Error(0)
+}
+
+// SecurityGroupNil is a nil SecurityGroup
+var (
+	SecurityGroupNil *resource.SecurityGroup
+)
+
+// SecurityGroup is a SecurityGroup object
+type SecurityGroup struct {
+	resource.SecurityGroup
+}
+
+// NewSecurityGroup generate a new SecurityGroup
+func NewSecurityGroup() *SecurityGroup {
+	return &SecurityGroup{}
+}
+
+// SetName assigns SecurityGroup name
+func (s *SecurityGroup) SetName(name string) *SecurityGroup {
+	s.Name = name
+	return s
+}
+
+// SetGUID assigns SecurityGroup GUID
+func (s *SecurityGroup) SetGUID(guid string) *SecurityGroup {
+	s.GUID = guid
+	return s
+}
+
+// SetRules assigns SecurityGroup rules
+func (s *SecurityGroup) SetRules(rules ...resource.SecurityGroupRule) *SecurityGroup {
+	s.Rules = rules
+	return s
+}
+
+// SetRunningSpaces assigns the spaces SecurityGroup is bound to for running applications
+func (s *SecurityGroup) SetRunningSpaces(guids ...string) *SecurityGroup {
+	s.Relationships.RunningSpaces = *resource.NewToManyRelationships(guids)
+	return s
+}
+
+// SetStagingSpaces assigns the spaces SecurityGroup is bound to for staging applications
+func (s *SecurityGroup) SetStagingSpaces(guids ...string) *SecurityGroup {
+	s.Relationships.StagingSpaces = *resource.NewToManyRelationships(guids)
+	return s
+}
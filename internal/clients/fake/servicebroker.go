@@ -0,0 +1,114 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockServiceBroker mocks ServiceBroker interfaces
+type MockServiceBroker struct {
+	mock.Mock
+}
+
+// Get mocks ServiceBroker.Get
+func (m *MockServiceBroker) Get(ctx context.Context, guid string) (*resource.ServiceBroker, error) {
+	args := m.Called(guid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.ServiceBroker), args.Error(1)
+}
+
+// Single mocks ServiceBroker.Single
+func (m *MockServiceBroker) Single(ctx context.Context, opts *client.ServiceBrokerListOptions) (*resource.ServiceBroker, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.ServiceBroker), args.Error(1)
+}
+
+// Create mocks ServiceBroker.Create
+func (m *MockServiceBroker) Create(ctx context.Context, r *resource.ServiceBrokerCreate) (string, error) {
+	args := m.Called(r)
+	return args.String(0), args.Error(1)
+}
+
+// Update mocks ServiceBroker.Update
+func (m *MockServiceBroker) Update(ctx context.Context, guid string, r *resource.ServiceBrokerUpdate) (string, *resource.ServiceBroker, error) {
+	args := m.Called(guid, r)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*resource.ServiceBroker), args.Error(2)
+}
+
+// Delete mocks ServiceBroker.Delete
+func (m *MockServiceBroker) Delete(ctx context.Context, guid string) (string, error) {
+	args := m.Called(guid)
+	return args.String(0), args.Error(1)
+}
+
+// MockServiceOfferingList mocks the List method of ServiceOffering interfaces
+type MockServiceOfferingList struct {
+	mock.Mock
+}
+
+// List mocks ServiceOffering.List
+func (m *MockServiceOfferingList) List(ctx context.Context, opts *client.ServiceOfferingListOptions) ([]*resource.ServiceOffering, *client.Pager, error) {
+	args := m.Called()
+	return nil, &client.Pager{TotalResults: args.Int(0)}, args.Error(1)
+}
+
+// MockServicePlanList mocks the List method of ServicePlan interfaces
+type MockServicePlanList struct {
+	mock.Mock
+}
+
+// List mocks ServicePlan.List
+func (m *MockServicePlanList) List(ctx context.Context, opts *client.ServicePlanListOptions) ([]*resource.ServicePlan, *client.Pager, error) {
+	args := m.Called()
+	return nil, &client.Pager{TotalResults: args.Int(0)}, args.Error(1)
+}
+
+// ServiceBrokerNil is a nil ServiceBroker
+var (
+	ServiceBrokerNil *resource.ServiceBroker
+)
+
+// ServiceBroker is a ServiceBroker object
+type ServiceBroker struct {
+	resource.ServiceBroker
+}
+
+// NewServiceBroker generate a new ServiceBroker
+func NewServiceBroker() *ServiceBroker {
+	return &ServiceBroker{}
+}
+
+// SetName assigns ServiceBroker name
+func (s *ServiceBroker) SetName(name string) *ServiceBroker {
+	s.Name = name
+	return s
+}
+
+// SetGUID assigns ServiceBroker GUID
+func (s *ServiceBroker) SetGUID(guid string) *ServiceBroker {
+	s.GUID = guid
+	return s
+}
+
+// SetURL assigns ServiceBroker URL
+func (s *ServiceBroker) SetURL(url string) *ServiceBroker {
+	s.URL = url
+	return s
+}
+
+// SetMetadata assigns ServiceBroker metadata
+func (s *ServiceBroker) SetMetadata(m *resource.Metadata) *ServiceBroker {
+	s.Metadata = m
+	return s
+}
@@ -0,0 +1,32 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockServiceOffering mocks ServiceOffering interfaces
+type MockServiceOffering struct {
+	mock.Mock
+}
+
+// Get mocks ServiceOffering.Get
+func (m *MockServiceOffering) Get(ctx context.Context, guid string) (*resource.ServiceOffering, error) {
+	args := m.Called(guid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.ServiceOffering), args.Error(1)
+}
+
+// Single mocks ServiceOffering.Single
+func (m *MockServiceOffering) Single(ctx context.Context, opts *client.ServiceOfferingListOptions) (*resource.ServiceOffering, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.ServiceOffering), args.Error(1)
+}
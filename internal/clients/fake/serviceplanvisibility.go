@@ -0,0 +1,46 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockServicePlanVisibility mocks ServicePlanVisibility interfaces
+type MockServicePlanVisibility struct {
+	mock.Mock
+}
+
+// Get mocks ServicePlanVisibility.Get
+func (m *MockServicePlanVisibility) Get(ctx context.Context, servicePlanGUID string) (*resource.ServicePlanVisibility, error) {
+	args := m.Called(servicePlanGUID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.ServicePlanVisibility), args.Error(1)
+}
+
+// Apply mocks ServicePlanVisibility.Apply
+func (m *MockServicePlanVisibility) Apply(ctx context.Context, servicePlanGUID string, r *resource.ServicePlanVisibility) (*resource.ServicePlanVisibility, error) {
+	args := m.Called(servicePlanGUID, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.ServicePlanVisibility), args.Error(1)
+}
+
+// Update mocks ServicePlanVisibility.Update
+func (m *MockServicePlanVisibility) Update(ctx context.Context, servicePlanGUID string, r *resource.ServicePlanVisibility) (*resource.ServicePlanVisibility, error) {
+	args := m.Called(servicePlanGUID, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.ServicePlanVisibility), args.Error(1)
+}
+
+// Delete mocks ServicePlanVisibility.Delete
+func (m *MockServicePlanVisibility) Delete(ctx context.Context, servicePlanGUID, organizationGUID string) error {
+	args := m.Called(servicePlanGUID, organizationGUID)
+	return args.Error(0)
+}
@@ -0,0 +1,43 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSidecar mocks Sidecar interfaces
+type MockSidecar struct {
+	mock.Mock
+}
+
+// ListForAppAll mocks Sidecar.ListForAppAll
+func (m *MockSidecar) ListForAppAll(ctx context.Context, appGUID string, opts *client.SidecarListOptions) ([]*resource.Sidecar, error) {
+	args := m.Called(appGUID)
+	return args.Get(0).([]*resource.Sidecar), args.Error(1)
+}
+
+// Create mocks Sidecar.Create
+func (m *MockSidecar) Create(ctx context.Context, appGUID string, r *resource.SidecarCreate) (*resource.Sidecar, error) {
+	args := m.Called(appGUID, r)
+	return args.Get(0).(*resource.Sidecar), args.Error(1)
+}
+
+// Update mocks Sidecar.Update
+func (m *MockSidecar) Update(ctx context.Context, guid string, r *resource.SidecarUpdate) (*resource.Sidecar, error) {
+	args := m.Called(guid, r)
+	return args.Get(0).(*resource.Sidecar), args.Error(1)
+}
+
+// Delete mocks Sidecar.Delete
+func (m *MockSidecar) Delete(ctx context.Context, guid string) error {
+	args := m.Called(guid)
+	return args.Error(0)
+}
+
+// SidecarNil is a nil Sidecar
+var (
+	SidecarNil *resource.Sidecar
+)
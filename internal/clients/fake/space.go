@@ -60,6 +60,18 @@ func (m *MockSpace) Delete(ctx context.Context, guid string) (string, error) {
 	return args.String(0), args.Error(1)
 }
 
+// AssignIsolationSegment mocks Space.AssignIsolationSegment
+func (m *MockSpace) AssignIsolationSegment(ctx context.Context, guid, isolationSegmentGUID string) error {
+	args := m.Called(guid, isolationSegmentGUID)
+	return args.Error(0)
+}
+
+// GetAssignedIsolationSegment mocks Space.GetAssignedIsolationSegment
+func (m *MockSpace) GetAssignedIsolationSegment(ctx context.Context, guid string) (string, error) {
+	args := m.Called(guid)
+	return args.String(0), args.Error(1)
+}
+
 // Space is a nil Space
 var (
 	SpaceNil *resource.Space
@@ -0,0 +1,42 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTask mocks Task interfaces
+type MockTask struct {
+	mock.Mock
+}
+
+// Get mocks Task.Get
+func (m *MockTask) Get(ctx context.Context, guid string) (*resource.Task, error) {
+	args := m.Called(guid)
+	return args.Get(0).(*resource.Task), args.Error(1)
+}
+
+// Create mocks Task.Create
+func (m *MockTask) Create(ctx context.Context, appGUID string, r *resource.TaskCreate) (*resource.Task, error) {
+	args := m.Called(appGUID, r)
+	return args.Get(0).(*resource.Task), args.Error(1)
+}
+
+// Update mocks Task.Update
+func (m *MockTask) Update(ctx context.Context, guid string, r *resource.TaskUpdate) (*resource.Task, error) {
+	args := m.Called(guid, r)
+	return args.Get(0).(*resource.Task), args.Error(1)
+}
+
+// Cancel mocks Task.Cancel
+func (m *MockTask) Cancel(ctx context.Context, guid string) (*resource.Task, error) {
+	args := m.Called(guid)
+	return args.Get(0).(*resource.Task), args.Error(1)
+}
+
+// TaskNil is a nil Task
+var (
+	TaskNil *resource.Task
+)
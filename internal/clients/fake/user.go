@@ -0,0 +1,65 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockUser mocks User interfaces
+type MockUser struct {
+	mock.Mock
+}
+
+// Get mocks User.Get
+func (m *MockUser) Get(ctx context.Context, guid string) (*resource.User, error) {
+	args := m.Called(guid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.User), args.Error(1)
+}
+
+// Single mocks User.Single
+func (m *MockUser) Single(ctx context.Context, opts *client.UserListOptions) (*resource.User, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.User), args.Error(1)
+}
+
+// Create mocks User.Create
+func (m *MockUser) Create(ctx context.Context, r *resource.UserCreate) (*resource.User, error) {
+	args := m.Called(r.GUID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.User), args.Error(1)
+}
+
+// CreateWithUsername mocks User.CreateWithUsername
+func (m *MockUser) CreateWithUsername(ctx context.Context, r *resource.UserCreateWithUsername) (*resource.User, error) {
+	args := m.Called(r.Username, r.Origin)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.User), args.Error(1)
+}
+
+// Update mocks User.Update
+func (m *MockUser) Update(ctx context.Context, guid string, r *resource.UserUpdate) (*resource.User, error) {
+	args := m.Called(guid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*resource.User), args.Error(1)
+}
+
+// Delete mocks User.Delete
+func (m *MockUser) Delete(ctx context.Context, guid string) (string, error) {
+	args := m.Called(guid)
+	return args.String(0), args.Error(1)
+}
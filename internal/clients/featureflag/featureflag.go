@@ -0,0 +1,152 @@
+package featureflag
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+const errDecodeFeatureFlag = "cannot decode the feature flag"
+
+// Client is the interface that defines the methods that a FeatureFlag client
+// should implement.
+type Client interface {
+	Get(ctx context.Context, name string) (*resource.FeatureFlag, error)
+	Update(ctx context.Context, name string, r *resource.FeatureFlagUpdate) (*resource.FeatureFlag, error)
+}
+
+// NewClient creates a new FeatureFlag client
+func NewClient(cf *client.Client) Client {
+	return &featureFlagClient{cf: cf}
+}
+
+// featureFlagClient sends its own requests, as the CF client only knows the
+// feature flags of its version, while a foundation may have more.
+type featureFlagClient struct {
+	cf *client.Client
+}
+
+// Get retrieves the feature flag of the given name.
+func (c *featureFlagClient) Get(ctx context.Context, name string) (*resource.FeatureFlag, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.cf.ApiURL("/v3/feature_flags/"+url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+// Update sets the attributes of the feature flag of the given name.
+func (c *featureFlagClient) Update(ctx context.Context, name string, r *resource.FeatureFlagUpdate) (*resource.FeatureFlag, error) {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.cf.ApiURL("/v3/feature_flags/"+url.PathEscape(name)), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req)
+}
+
+func (c *featureFlagClient) do(req *http.Request) (*resource.FeatureFlag, error) {
+	resp, err := c.cf.ExecuteAuthRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	f := &resource.FeatureFlag{}
+	if err := json.NewDecoder(resp.Body).Decode(f); err != nil {
+		return nil, errors.Wrap(err, errDecodeFeatureFlag)
+	}
+	return f, nil
+}
+
+// defaults holds the value of each feature flag known to the CF client on a
+// new Cloud Foundry foundation.
+var defaults = map[string]bool{
+	resource.FeatureFlagAppBitsUpload.String():                           true,
+	resource.FeatureFlagAppScaling.String():                              true,
+	resource.FeatureFlagDiegoDocker.String():                             false,
+	resource.FeatureFlagEnvVarVisibility.String():                        true,
+	resource.FeatureFlagHideMarketPlaceFromUnauthenticatedUsers.String(): false,
+	resource.FeatureFlagPrivateDomainCreation.String():                   true,
+	resource.FeatureFlagResourceMatching.String():                        true,
+	resource.FeatureFlagRouteCreation.String():                           true,
+	resource.FeatureFlagRouteSharing.String():                            false,
+	resource.FeatureFlagServiceInstanceCreation.String():                 true,
+	resource.FeatureFlagServiceInstanceSharing.String():                  false,
+	resource.FeatureFlagSetRolesByUserName.String():                      true,
+	resource.FeatureFlagSpaceDeveloperEnvVarVisibility.String():          true,
+	resource.FeatureFlagSpaceScopedPrivateBrokerCreation.String():        true,
+	resource.FeatureFlagTaskCreation.String():                            true,
+	resource.FeatureFlagUnsetRolesByUsername.String():                    true,
+	resource.FeatureFlagUserOrgCreation.String():                         false,
+}
+
+// GenerateUpdate generates the FeatureFlagUpdate from an *FeatureFlagParameters.
+func GenerateUpdate(spec v1alpha1.FeatureFlagParameters) *resource.FeatureFlagUpdate {
+	return &resource.FeatureFlagUpdate{
+		Enabled:            spec.Enabled,
+		CustomErrorMessage: spec.CustomErrorMessage,
+	}
+}
+
+// GenerateReset generates the FeatureFlagUpdate that restores the default of
+// a feature flag and clears its custom error message. It returns false for a
+// feature flag without a known default, which is not reset.
+func GenerateReset(name string) (*resource.FeatureFlagUpdate, bool) {
+	enabled, ok := defaults[name]
+	if !ok {
+		return nil, false
+	}
+	return resource.NewFeatureFlagUpdate().WithEnabled(enabled).WithCustomErrorMessage(""), true
+}
+
+// IsDefault checks whether a feature flag has its default value and no custom
+// error message. A feature flag without a known default is not reset, so it
+// is considered to have its default.
+func IsDefault(name string, observed *resource.FeatureFlag) bool {
+	enabled, ok := defaults[name]
+	if !ok {
+		return true
+	}
+	return observed.Enabled == enabled && observed.CustomErrorMessage == ""
+}
+
+// GenerateObservation takes a FeatureFlag resource and returns
+// *FeatureFlagObservation.
+func GenerateObservation(o *resource.FeatureFlag) v1alpha1.FeatureFlagObservation {
+	return v1alpha1.FeatureFlagObservation{
+		Name:               ptr.To(o.Name),
+		Enabled:            ptr.To(o.Enabled),
+		CustomErrorMessage: ptr.To(o.CustomErrorMessage),
+		UpdatedAt:          ptr.To(o.UpdatedAt.Format(time.RFC3339)),
+	}
+}
+
+// IsUpToDate checks whether the observed feature flag matches the given set
+// of parameters. Unset parameters are not compared.
+func IsUpToDate(spec v1alpha1.FeatureFlagParameters, observed *resource.FeatureFlag) bool {
+	if observed == nil {
+		return false
+	}
+	if spec.Enabled != nil && *spec.Enabled != observed.Enabled {
+		return false
+	}
+	if spec.CustomErrorMessage != nil && *spec.CustomErrorMessage != observed.CustomErrorMessage {
+		return false
+	}
+	return true
+}
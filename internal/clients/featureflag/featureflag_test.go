@@ -0,0 +1,131 @@
+package featureflag
+
+import (
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+func TestGenerateReset(t *testing.T) {
+	type want struct {
+		update *resource.FeatureFlagUpdate
+		ok     bool
+	}
+
+	cases := map[string]struct {
+		name string
+		want want
+	}{
+		"DefaultDisabled": {
+			name: "diego_docker",
+			want: want{update: &resource.FeatureFlagUpdate{Enabled: ptr.To(false), CustomErrorMessage: ptr.To("")}, ok: true},
+		},
+		"DefaultEnabled": {
+			name: "task_creation",
+			want: want{update: &resource.FeatureFlagUpdate{Enabled: ptr.To(true), CustomErrorMessage: ptr.To("")}, ok: true},
+		},
+		"Unknown": {
+			name: "unknown_flag",
+			want: want{},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			update, ok := GenerateReset(tc.name)
+			if diff := cmp.Diff(tc.want.update, update); diff != "" {
+				t.Errorf("GenerateReset(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ok, ok); diff != "" {
+				t.Errorf("GenerateReset(...): -want ok, +got ok:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec     v1alpha1.FeatureFlagParameters
+		observed *resource.FeatureFlag
+		want     bool
+	}{
+		"UpToDate": {
+			spec:     v1alpha1.FeatureFlagParameters{Name: "diego_docker", Enabled: ptr.To(true), CustomErrorMessage: ptr.To("no docker")},
+			observed: &resource.FeatureFlag{Name: "diego_docker", Enabled: true, CustomErrorMessage: "no docker"},
+			want:     true,
+		},
+		"EnabledDrift": {
+			spec:     v1alpha1.FeatureFlagParameters{Name: "diego_docker", Enabled: ptr.To(true)},
+			observed: &resource.FeatureFlag{Name: "diego_docker", Enabled: false},
+			want:     false,
+		},
+		"MessageDrift": {
+			spec:     v1alpha1.FeatureFlagParameters{Name: "diego_docker", Enabled: ptr.To(false), CustomErrorMessage: ptr.To("no docker")},
+			observed: &resource.FeatureFlag{Name: "diego_docker", Enabled: false},
+			want:     false,
+		},
+		"MessageNotManaged": {
+			spec:     v1alpha1.FeatureFlagParameters{Name: "diego_docker", Enabled: ptr.To(false)},
+			observed: &resource.FeatureFlag{Name: "diego_docker", Enabled: false, CustomErrorMessage: "no docker"},
+			want:     true,
+		},
+		"NotObserved": {
+			spec: v1alpha1.FeatureFlagParameters{Name: "diego_docker", Enabled: ptr.To(false)},
+			want: false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.spec, tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDefault(t *testing.T) {
+	cases := map[string]struct {
+		name     string
+		observed *resource.FeatureFlag
+		want     bool
+	}{
+		"DefaultDisabled": {
+			name:     "diego_docker",
+			observed: &resource.FeatureFlag{Enabled: false},
+			want:     true,
+		},
+		"DefaultEnabled": {
+			name:     "task_creation",
+			observed: &resource.FeatureFlag{Enabled: true},
+			want:     true,
+		},
+		"NotDefault": {
+			name:     "diego_docker",
+			observed: &resource.FeatureFlag{Enabled: true},
+			want:     false,
+		},
+		"CustomErrorMessage": {
+			name:     "diego_docker",
+			observed: &resource.FeatureFlag{Enabled: false, CustomErrorMessage: "no docker"},
+			want:     false,
+		},
+		"Unknown": {
+			name:     "unknown_flag",
+			observed: &resource.FeatureFlag{Enabled: true, CustomErrorMessage: "disabled"},
+			want:     true,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsDefault(tc.name, tc.observed)); diff != "" {
+				t.Errorf("IsDefault(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+package clients
+
+import (
+	"context"
+	"strings"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errAPINotReachable = "cannot authenticate, the CF API is not reachable"
+	errReadInfo        = "cannot read /v3/info"
+	errReadRoot        = "cannot read the API root"
+	errAuthenticate    = "cannot authenticate"
+)
+
+// ProviderConfigHealth is the observed connectivity of a ProviderConfig.
+type ProviderConfigHealth struct {
+	// APIVersion of the CF API v3 reported by the API root.
+	APIVersion string
+	// UAAEndpoint reported by the API root.
+	UAAEndpoint string
+	// UnreachableErr is set if the CF API cannot be reached.
+	UnreachableErr error
+	// UnauthenticatedErr is set if the credentials do not authenticate.
+	UnauthenticatedErr error
+}
+
+// CheckHealth checks whether the CF API of the referenced ProviderConfig is
+// reachable and whether its credentials authenticate. Authentication uses the
+// cached client, so a client whose credentials have become invalid is removed
+// from the cache.
+func (c *ClientCache) CheckHealth(ctx context.Context, kube client.Client, ref ProviderConfigRef) *ProviderConfigHealth {
+	h := &ProviderConfigHealth{}
+
+	conn, err := getConnectionDetails(ctx, kube, ref)
+	if err != nil {
+		h.UnauthenticatedErr = err
+		return h
+	}
+	httpClient, err := newHTTPClient(conn.pc, conn.caBundle)
+	if err != nil {
+		h.UnreachableErr = errors.Wrap(err, errConfigureHTTPClient)
+		h.UnauthenticatedErr = errors.New(errAPINotReachable)
+		return h
+	}
+
+	root, err := getAPIRoot(ctx, httpClient, conn.url)
+	if err != nil {
+		h.UnreachableErr = errors.Wrap(err, errReadRoot)
+		h.UnauthenticatedErr = errors.New(errAPINotReachable)
+		return h
+	}
+	h.APIVersion = root.Links.CloudControllerV3.Meta.Version
+	h.UAAEndpoint = root.Links.Uaa.Href
+	if err := getJSON(ctx, httpClient, strings.TrimRight(conn.url, "/")+"/v3/info", &map[string]any{}); err != nil {
+		h.UnreachableErr = errors.Wrap(err, errReadInfo)
+		h.UnauthenticatedErr = errors.New(errAPINotReachable)
+		return h
+	}
+
+	cf, err := c.GetByProviderConfig(ctx, kube, ref)
+	if err == nil {
+		// any authenticated request proves the credentials
+		opts := cfv3.NewOrganizationListOptions()
+		opts.PerPage = 1
+		_, _, err = cf.Organizations.List(ctx, opts)
+	}
+	if err != nil {
+		c.Invalidate(ref)
+		h.UnauthenticatedErr = errors.Wrap(err, errAuthenticate)
+	}
+	return h
+}
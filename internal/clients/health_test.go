@@ -0,0 +1,87 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+func TestCheckHealth(t *testing.T) {
+	type args struct {
+		credentials string
+		apiEndpoint func(f *fakeUAA) string
+	}
+	type want struct {
+		apiVersion      string
+		uaa             bool
+		unreachable     bool
+		unauthenticated bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Healthy": {
+			args: args{
+				credentials: `{"email": "user@example.com", "password": "secret"}`,
+			},
+			want: want{
+				apiVersion: "3.180.0",
+				uaa:        true,
+			},
+		},
+		"WrongPassword": {
+			args: args{
+				credentials: `{"email": "user@example.com", "password": "wrong"}`,
+			},
+			want: want{
+				apiVersion:      "3.180.0",
+				uaa:             true,
+				unauthenticated: true,
+			},
+		},
+		"Unreachable": {
+			args: args{
+				credentials: `{"email": "user@example.com", "password": "secret"}`,
+				apiEndpoint: func(*fakeUAA) string { return "http://127.0.0.1:1" },
+			},
+			want: want{
+				unreachable:     true,
+				unauthenticated: true,
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			f := newFakeUAA(t, false)
+			endpoint := f.URL
+			if tc.args.apiEndpoint != nil {
+				endpoint = tc.args.apiEndpoint(f)
+			}
+			kube := &fakeKube{
+				pc:      fakeProviderConfig(endpoint, "", nil),
+				secrets: map[string]map[string][]byte{credentialsSecret: {"credentials": []byte(tc.args.credentials)}},
+			}
+
+			h := NewClientCache().CheckHealth(context.Background(), kube.client(), ProviderConfigRef{Kind: v1beta1.ProviderConfigKind, Name: "default"})
+
+			if diff := cmp.Diff(tc.want.apiVersion, h.APIVersion); diff != "" {
+				t.Errorf("APIVersion: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.uaa, h.UAAEndpoint == f.URL); diff != "" {
+				t.Errorf("UAAEndpoint: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.unreachable, h.UnreachableErr != nil); diff != "" {
+				t.Errorf("UnreachableErr: -want, +got:\n%s\n%v", diff, h.UnreachableErr)
+			}
+			if diff := cmp.Diff(tc.want.unauthenticated, h.UnauthenticatedErr != nil); diff != "" {
+				t.Errorf("UnauthenticatedErr: -want, +got:\n%s\n%v", diff, h.UnauthenticatedErr)
+			}
+		})
+	}
+}
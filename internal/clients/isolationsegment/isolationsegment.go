@@ -0,0 +1,149 @@
+package isolationsegment
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpresource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+// Client is the interface that defines the methods that an IsolationSegment
+// client should implement.
+type Client interface {
+	Get(ctx context.Context, guid string) (*resource.IsolationSegment, error)
+	Single(ctx context.Context, opts *client.IsolationSegmentListOptions) (*resource.IsolationSegment, error)
+	Create(ctx context.Context, r *resource.IsolationSegmentCreate) (*resource.IsolationSegment, error)
+	Update(ctx context.Context, guid string, r *resource.IsolationSegmentUpdate) (*resource.IsolationSegment, error)
+	Delete(ctx context.Context, guid string) error
+	ListOrganizationRelationships(ctx context.Context, guid string) ([]string, error)
+	EntitleOrganizations(ctx context.Context, guid string, organizationGUIDs []string) (*resource.IsolationSegmentRelationship, error)
+	RevokeOrganizations(ctx context.Context, guid string, organizationGUIDs []string) error
+}
+
+// NewClient creates a new IsolationSegment client
+func NewClient(cf *client.Client) Client {
+	return cf.IsolationSegments
+}
+
+// FindBySpec looks up an isolation segment by name when external-name is empty.
+func FindBySpec(ctx context.Context, c Client, spec v1alpha1.IsolationSegmentParameters) (*resource.IsolationSegment, error) {
+	opts := client.NewIsolationSegmentOptions()
+	opts.Names.EqualTo(spec.Name)
+	return c.Single(ctx, opts)
+}
+
+// GetGUID returns the GUID of an isolation segment by name.
+func GetGUID(ctx context.Context, c Client, name string) (*string, error) {
+	opts := client.NewIsolationSegmentOptions()
+	opts.Names.EqualTo(name)
+	s, err := c.Single(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &s.GUID, nil
+}
+
+// GenerateCreate generates the IsolationSegmentCreate from an *IsolationSegmentParameters.
+func GenerateCreate(mg xpresource.Managed, spec v1alpha1.IsolationSegmentParameters) *resource.IsolationSegmentCreate {
+	create := resource.NewIsolationSegmentCreate(spec.Name)
+	create.Metadata = metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	return create
+}
+
+// GenerateUpdate generates the IsolationSegmentUpdate from an *IsolationSegmentParameters.
+func GenerateUpdate(mg xpresource.Managed, spec v1alpha1.IsolationSegmentParameters) *resource.IsolationSegmentUpdate {
+	return &resource.IsolationSegmentUpdate{
+		Name:     ptr.To(spec.Name),
+		Metadata: metadata.BuildMetadata(mg, spec.Labels, spec.Annotations),
+	}
+}
+
+// GenerateObservation takes an IsolationSegment resource and the GUIDs of its
+// entitled organizations and returns *IsolationSegmentObservation.
+func GenerateObservation(o *resource.IsolationSegment, orgs []string) v1alpha1.IsolationSegmentObservation {
+	obs := v1alpha1.IsolationSegmentObservation{
+		ID:        ptr.To(o.GUID),
+		Name:      ptr.To(o.Name),
+		CreatedAt: ptr.To(o.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt: ptr.To(o.UpdatedAt.Format(time.RFC3339)),
+	}
+	for _, org := range orgs {
+		obs.Orgs = append(obs.Orgs, ptr.To(org))
+	}
+	if o.Metadata != nil {
+		obs.Labels = o.Metadata.Labels
+		obs.Annotations = o.Metadata.Annotations
+	}
+	return obs
+}
+
+// IsUpToDate checks whether the observed isolation segment and its entitled
+// organizations match the given set of parameters.
+func IsUpToDate(mg xpresource.Managed, spec v1alpha1.IsolationSegmentParameters, observed *resource.IsolationSegment, orgs []string) bool {
+	if observed == nil {
+		return false
+	}
+	if spec.Name != observed.Name {
+		return false
+	}
+	if entitle, revoke := Entitlements(spec.Orgs, orgs); len(entitle) > 0 || len(revoke) > 0 {
+		return false
+	}
+	desired := metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	var observedLabels, observedAnnotations map[string]*string
+	if observed.Metadata != nil {
+		observedLabels = observed.Metadata.Labels
+		observedAnnotations = observed.Metadata.Annotations
+	}
+	return metadata.IsMetadataUpToDate(desired.Labels, desired.Annotations, observedLabels, observedAnnotations)
+}
+
+// Entitlements compares the desired organizations with the entitled ones and
+// returns the organizations to entitle and the organizations to revoke.
+func Entitlements(desired []*string, entitled []string) (entitle, revoke []string) {
+	want := map[string]bool{}
+	for _, org := range desired {
+		if org != nil && *org != "" {
+			want[*org] = true
+		}
+	}
+	have := map[string]bool{}
+	for _, org := range entitled {
+		have[org] = true
+		if !want[org] {
+			revoke = append(revoke, org)
+		}
+	}
+	for _, org := range desired {
+		if org != nil && want[*org] && !have[*org] {
+			entitle = append(entitle, *org)
+			have[*org] = true
+		}
+	}
+	return entitle, revoke
+}
+
+// UpdateEntitlements entitles the desired organizations to the isolation
+// segment and revokes the entitlements of all other organizations.
+func UpdateEntitlements(ctx context.Context, c Client, guid string, desired []*string) error {
+	entitled, err := c.ListOrganizationRelationships(ctx, guid)
+	if err != nil {
+		return err
+	}
+	entitle, revoke := Entitlements(desired, entitled)
+	if len(entitle) > 0 {
+		if _, err := c.EntitleOrganizations(ctx, guid, entitle); err != nil {
+			return err
+		}
+	}
+	if len(revoke) > 0 {
+		return c.RevokeOrganizations(ctx, guid, revoke)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package isolationsegment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+)
+
+func TestEntitlements(t *testing.T) {
+	type want struct {
+		entitle []string
+		revoke  []string
+	}
+
+	cases := map[string]struct {
+		desired  []*string
+		entitled []string
+		want     want
+	}{
+		"None": {},
+		"Entitle": {
+			desired: []*string{ptr.To("org-1"), ptr.To("org-2")},
+			want:    want{entitle: []string{"org-1", "org-2"}},
+		},
+		"Revoke": {
+			entitled: []string{"org-1"},
+			want:     want{revoke: []string{"org-1"}},
+		},
+		"UpToDate": {
+			desired:  []*string{ptr.To("org-2"), ptr.To("org-1")},
+			entitled: []string{"org-1", "org-2"},
+		},
+		"EntitleAndRevoke": {
+			desired:  []*string{ptr.To("org-1"), ptr.To("org-3")},
+			entitled: []string{"org-1", "org-2"},
+			want:     want{entitle: []string{"org-3"}, revoke: []string{"org-2"}},
+		},
+		"IgnoreNilAndDuplicates": {
+			desired: []*string{nil, ptr.To("org-1"), ptr.To(""), ptr.To("org-1")},
+			want:    want{entitle: []string{"org-1"}},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			entitle, revoke := Entitlements(tc.desired, tc.entitled)
+			if diff := cmp.Diff(tc.want, want{entitle: entitle, revoke: revoke}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("Entitlements(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
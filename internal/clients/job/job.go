@@ -3,10 +3,12 @@ package job
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/go-cfclient/v3/config"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
 )
 
 // Job defines interfaces to async operations/jobs.
@@ -14,6 +16,13 @@ type Job interface {
 	PollComplete(ctx context.Context, jobGUID string, opt *client.PollingOptions) error
 }
 
+// Tracker defines interfaces to look up the state of async operations/jobs
+// without waiting for their completion.
+type Tracker interface {
+	Job
+	Get(ctx context.Context, jobGUID string) (*resource.Job, error)
+}
+
 // NewClient returns a new CF Job client
 func NewClient(config *config.Config) (Job, error) {
 	cf, err := client.New(config)
@@ -35,6 +44,26 @@ func newPollingOptions() *client.PollingOptions {
 	return p
 }
 
+// failedPrefix starts the message of the error go-cfclient returns for a failed
+// job, which is not typed.
+const failedPrefix = "received state " + string(resource.JobStateFailed)
+
+// A FailedError is returned by PollJobComplete for a job that failed. Its
+// message lists the CF errors of the job, which lost their type.
+type FailedError struct {
+	Err error
+}
+
+// Error returns the message of the failed job.
+func (e *FailedError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error go-cfclient returned for the failed job.
+func (e *FailedError) Unwrap() error {
+	return e.Err
+}
+
 // PollJobComplete polls for completion with extended timeout
 func PollJobComplete(ctx context.Context, job Job, jobGUID string) error {
 	ctx, cancel := context.WithTimeout(ctx, pollTimeout)
@@ -45,6 +74,14 @@ func PollJobComplete(ctx context.Context, job Job, jobGUID string) error {
 	if err != nil && errors.Is(err, client.AsyncProcessTimeoutError) { // because we have logic to observe job state, we can safely ignore timeout error
 		return nil
 	}
+	if err != nil && strings.HasPrefix(err.Error(), failedPrefix) {
+		return &FailedError{Err: err}
+	}
 
 	return err
 }
+
+// IsInProgress returns true if the job has not reached a final state yet.
+func IsInProgress(state resource.JobState) bool {
+	return state == resource.JobStateProcessing || state == resource.JobStatePolling
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+// DefaultTokenFilePath is the service account token of the provider pod, which
+// is exchanged for credentials of type JWTBearer and the source
+// InjectedIdentity if no tokenFilePath is configured.
+const DefaultTokenFilePath = "/var/run/secrets/kubernetes.io/serviceaccount/token" //nolint:gosec // a path, not a token
+
+// GrantTypeJWTBearer is the grant type of RFC 7523.
+const GrantTypeJWTBearer = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+
+const (
+	errInjectedIdentityType = "credentials source InjectedIdentity requires credentials of type JWTBearer"
+	errReadTokenFile        = "cannot read token file"
+	errEmptyAssertion       = "JWT for the jwt-bearer grant is empty"
+	errJWTBearerLogin       = "cannot log in with JWT"
+)
+
+// getAssertion returns the JWT of credentials of type JWTBearer. For the
+// source InjectedIdentity the JWT is read from the token file, for all other
+// sources it is the extracted credentials as is. The token file is read on
+// every call, so that a rotated token changes the connection details.
+func getAssertion(ctx context.Context, kube client.Client, pc v1beta1.ProviderConfigObject) (*CfCredentials, error) {
+	cred := pc.GetSpec().Credentials
+	if cred.Type != v1beta1.CredentialsTypeJWTBearer {
+		return nil, errors.New(errInjectedIdentityType)
+	}
+
+	var buf []byte
+	var err error
+	if cred.Source == xpv1.CredentialsSourceInjectedIdentity {
+		buf, err = os.ReadFile(ptr.Deref(cred.TokenFilePath, DefaultTokenFilePath))
+		err = errors.Wrap(err, errReadTokenFile)
+	} else {
+		buf, err = resource.CommonCredentialExtractor(ctx, cred.Source, kube, cred.CommonCredentialSelectors)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	assertion := strings.TrimSpace(string(buf))
+	if assertion == "" {
+		return nil, errors.New(errEmptyAssertion)
+	}
+	return &CfCredentials{Assertion: assertion}, nil
+}
+
+// jwtBearerToken exchanges the JWT of the credentials for a UAA token with the
+// jwt-bearer grant, like `cf auth --assertion` does.
+func jwtBearerToken(ctx context.Context, pc v1beta1.ProviderConfigObject, cred *CfCredentials, apiURL string, httpClient *http.Client) (*uaaToken, error) {
+	uaaURL, err := getUAAURL(ctx, pc, httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	token, err := requestToken(ctx, httpClient, uaaURL, url.Values{
+		"grant_type": {GrantTypeJWTBearer},
+		"assertion":  {cred.Assertion},
+	})
+	return token, errors.Wrap(err, errRequestToken)
+}
+
+// jwtBearerTokenSource is the oauth2.TokenSource of credentials of type
+// JWTBearer. An expired token is not refreshed, the JWT is read again and
+// exchanged for a new token, so that a rotated token file is used.
+type jwtBearerTokenSource struct {
+	kube       client.Client
+	pc         v1beta1.ProviderConfigObject
+	apiURL     string
+	httpClient *http.Client
+}
+
+// Token reads the JWT and exchanges it for a new UAA token.
+func (s *jwtBearerTokenSource) Token() (*oauth2.Token, error) {
+	ctx := context.Background()
+	cred, err := getAssertion(ctx, s.kube, s.pc)
+	if err != nil {
+		return nil, err
+	}
+	token, err := jwtBearerToken(ctx, s.pc, cred, s.apiURL, s.httpClient)
+	if err != nil {
+		return nil, errors.Wrap(err, errJWTBearerLogin)
+	}
+	return token.oauth2Token(), nil
+}
@@ -0,0 +1,169 @@
+package clients
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+func TestGetCredentialConfigJWTBearer(t *testing.T) {
+	type args struct {
+		source    xpv1.CredentialsSource
+		credType  v1beta1.CredentialsType
+		tokenFile string
+		secret    string
+	}
+	type want struct {
+		err    bool
+		grants []tokenRequest
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"InjectedIdentity": {
+			args: args{
+				source:    xpv1.CredentialsSourceInjectedIdentity,
+				credType:  v1beta1.CredentialsTypeJWTBearer,
+				tokenFile: "sa-token-1\n",
+			},
+			want: want{
+				grants: []tokenRequest{{grantType: GrantTypeJWTBearer, clientID: "cf", assertion: "sa-token-1"}},
+			},
+		},
+		"TokenFromSecret": {
+			args: args{
+				source:   xpv1.CredentialsSourceSecret,
+				credType: v1beta1.CredentialsTypeJWTBearer,
+				secret:   "oidc-token",
+			},
+			want: want{
+				grants: []tokenRequest{{grantType: GrantTypeJWTBearer, clientID: "cf", assertion: "oidc-token"}},
+			},
+		},
+		"InjectedIdentityWithoutJWTBearer": {
+			args: args{
+				source:    xpv1.CredentialsSourceInjectedIdentity,
+				credType:  v1beta1.CredentialsTypeUserPassword,
+				tokenFile: "sa-token-1",
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"NoTokenFile": {
+			args: args{
+				source:   xpv1.CredentialsSourceInjectedIdentity,
+				credType: v1beta1.CredentialsTypeJWTBearer,
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"EmptyToken": {
+			args: args{
+				source:    xpv1.CredentialsSourceInjectedIdentity,
+				credType:  v1beta1.CredentialsTypeJWTBearer,
+				tokenFile: "\n",
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			f := newFakeUAA(t, false)
+			pc := fakeProviderConfig(f.URL, tc.args.credType, nil)
+			pc.Spec.Credentials.Source = tc.args.source
+			path := filepath.Join(t.TempDir(), "token")
+			pc.Spec.Credentials.TokenFilePath = ptr.To(path)
+			if tc.args.tokenFile != "" {
+				if err := os.WriteFile(path, []byte(tc.args.tokenFile), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			kube := &fakeKube{
+				pc:      pc,
+				secrets: map[string]map[string][]byte{credentialsSecret: {"credentials": []byte(tc.args.secret)}},
+			}
+
+			_, err := GetCredentialConfig(context.Background(), kube.client(), fakeManaged())
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("GetCredentialConfig(...): -want error, +got error:\n%s\n%v", diff, err)
+			}
+			if diff := cmp.Diff(tc.want.grants, f.grants, cmp.AllowUnexported(tokenRequest{})); diff != "" {
+				t.Errorf("token requests: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClientCacheRotatedToken(t *testing.T) {
+	f := newFakeUAA(t, false)
+	pc := fakeProviderConfig(f.URL, v1beta1.CredentialsTypeJWTBearer, nil)
+	pc.Spec.Credentials.Source = xpv1.CredentialsSourceInjectedIdentity
+	path := filepath.Join(t.TempDir(), "token")
+	pc.Spec.Credentials.TokenFilePath = ptr.To(path)
+	kube := (&fakeKube{pc: pc}).client()
+	cache := NewClientCache()
+
+	for _, token := range []string{"sa-token-1", "sa-token-1", "sa-token-2"} {
+		if err := os.WriteFile(path, []byte(token), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cache.Get(context.Background(), kube, fakeManaged()); err != nil {
+			t.Fatalf("Get(...): unexpected error: %v", err)
+		}
+	}
+
+	want := []tokenRequest{
+		{grantType: GrantTypeJWTBearer, clientID: "cf", assertion: "sa-token-1"},
+		{grantType: GrantTypeJWTBearer, clientID: "cf", assertion: "sa-token-2"},
+	}
+	if diff := cmp.Diff(want, f.grants, cmp.AllowUnexported(tokenRequest{})); diff != "" {
+		t.Errorf("token requests: -want, +got:\n%s", diff)
+	}
+}
+
+func TestClientJWTBearerTokenExpired(t *testing.T) {
+	f := newFakeUAA(t, false)
+	// expires within the expiry delta of oauth2, so every request needs a new token
+	f.expiresIn = 1
+	pc := fakeProviderConfig(f.URL, v1beta1.CredentialsTypeJWTBearer, nil)
+	pc.Spec.Credentials.Source = xpv1.CredentialsSourceInjectedIdentity
+	path := filepath.Join(t.TempDir(), "token")
+	pc.Spec.Credentials.TokenFilePath = ptr.To(path)
+	kube := (&fakeKube{pc: pc}).client()
+
+	if err := os.WriteFile(path, []byte("sa-token-1"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cf, err := NewClientCache().Get(context.Background(), kube, fakeManaged())
+	if err != nil {
+		t.Fatalf("Get(...): unexpected error: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("sa-token-2"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cf.Organizations.ListAll(context.Background(), nil); err != nil {
+		t.Fatalf("ListAll(...): unexpected error: %v", err)
+	}
+
+	want := []tokenRequest{
+		{grantType: GrantTypeJWTBearer, clientID: "cf", assertion: "sa-token-1"},
+		{grantType: GrantTypeJWTBearer, clientID: "cf", assertion: "sa-token-2"},
+	}
+	if diff := cmp.Diff(want, f.grants, cmp.AllowUnexported(tokenRequest{})); diff != "" {
+		t.Errorf("token requests: -want, +got:\n%s", diff)
+	}
+}
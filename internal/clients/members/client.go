@@ -2,7 +2,6 @@ package members
 
 import (
 	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
-	"github.com/cloudfoundry/go-cfclient/v3/config"
 )
 
 // Client promotes the cfv3 client
@@ -10,15 +9,9 @@ type Client struct {
 	*cfv3.Client
 }
 
-// NewClient returns a new CF client
-func NewClient(config *config.Config) (*Client, error) {
-
-	cf, err := cfv3.New(config)
-	if err != nil {
-		return nil, err
-	}
-
-	return &Client{Client: cf}, nil
+// NewClient returns a new members client wrapping the CF client
+func NewClient(cf *cfv3.Client) *Client {
+	return &Client{Client: cf}
 }
 
 // V3Client returns the underlying cfv3 client
@@ -12,7 +12,7 @@ const (
 )
 
 // AssignOrgMembers assigns org role to a set of users in an all-or-none fashion, and return a map of assigned roles.
-func (c *Client) AssignOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) (*v1alpha1.RoleAssignments, error) {
+func (c *Client) AssignOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) (*v1alpha1.RoleAssignments, error) {
 	// get all users with the role
 	observed, err := c.ListUsersWithRole(ctx, newOrgRoleListOptions(orgGUID, roleType))
 	if err != nil {
@@ -21,7 +21,7 @@ func (c *Client) AssignOrgMembers(ctx context.Context, orgGUID, roleType string,
 
 	members := make(map[string]string)
 	// make sure all defined users has the role
-	for _, u := range cr.Spec.ForProvider.Members {
+	for _, u := range cr.GetForProvider().Members {
 		user := u.Key()
 		role, ok := observed[user]
 		if !ok {
@@ -35,7 +35,7 @@ func (c *Client) AssignOrgMembers(ctx context.Context, orgGUID, roleType string,
 	}
 
 	// in case of "Strict" delete any roles remained in the observed list.
-	if cr.Spec.ForProvider.EnforcementPolicy == enforcementPolicyStrict {
+	if cr.GetForProvider().EnforcementPolicy == enforcementPolicyStrict {
 		for user, role := range observed {
 			if _, ok := members[user]; !ok {
 				err := c.DeleteRole(ctx, role)
@@ -53,7 +53,7 @@ func (c *Client) AssignOrgMembers(ctx context.Context, orgGUID, roleType string,
 }
 
 // UpdateOrgMembers observes external state and update it according the CR specification
-func (c *Client) UpdateOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) (*v1alpha1.RoleAssignments, error) {
+func (c *Client) UpdateOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) (*v1alpha1.RoleAssignments, error) {
 	// get all users with the role
 	members, err := c.AssignOrgMembers(ctx, orgGUID, roleType, cr)
 	if err != nil {
@@ -62,8 +62,8 @@ func (c *Client) UpdateOrgMembers(ctx context.Context, orgGUID, roleType string,
 
 	// remove any orphans in the (previously) assigned roles. Changing CR's Org, RoleType, or Members
 	// can results in all or some assigned roles become unmanaged and need to be deleted.
-	if cr.Status.AtProvider.AssignedRoles != nil {
-		for user, role := range cr.Status.AtProvider.AssignedRoles {
+	if cr.GetAtProvider().AssignedRoles != nil {
+		for user, role := range cr.GetAtProvider().AssignedRoles {
 			if role != members.AssignedRoles[user] {
 				if err := c.DeleteRole(ctx, role); err != nil {
 					return nil, err
@@ -80,12 +80,12 @@ func (c *Client) UpdateOrgMembers(ctx context.Context, orgGUID, roleType string,
 // If the observed state is not consistent with CR, return a nil observation together with an error.
 // The bool return value indicates whether any roles exist in Cloud Foundry for the given org GUID and role type,
 // regardless of whether they match the desired state. It is used by the controller to determine ResourceExists.
-func (c *Client) ObserveOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) (*v1alpha1.RoleAssignments, bool, error) {
+func (c *Client) ObserveOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) (*v1alpha1.RoleAssignments, bool, error) {
 	// sync every currently assigned role and remove it from members list if it no longer exists
-	for user, role := range cr.Status.AtProvider.AssignedRoles {
+	for user, role := range cr.GetAtProvider().AssignedRoles {
 		_, err := c.Roles.Get(ctx, role)
 		if err != nil && clients.ErrorIsNotFound(err) {
-			delete(cr.Status.AtProvider.AssignedRoles, user)
+			delete(cr.GetAtProvider().AssignedRoles, user)
 		}
 	}
 	// get all users with the role
@@ -98,10 +98,10 @@ func (c *Client) ObserveOrgMembers(ctx context.Context, orgGUID, roleType string
 }
 
 // isOrgMemberUpToDate checks if observation is consistent with CR
-func generateOrgMemberObservation(observed map[string]string, cr *v1alpha1.OrgMembers) *v1alpha1.RoleAssignments {
+func generateOrgMemberObservation(observed map[string]string, cr v1alpha1.OrgMembersManaged) *v1alpha1.RoleAssignments {
 	members := make(map[string]string)
 	// check if all defined users has the role
-	for _, u := range cr.Spec.ForProvider.Members {
+	for _, u := range cr.GetForProvider().Members {
 		user := u.Key()
 		r, ok := observed[user]
 		if !ok {
@@ -112,14 +112,14 @@ func generateOrgMemberObservation(observed map[string]string, cr *v1alpha1.OrgMe
 
 	// check orphans in the (previously) assigned roles. This can happen if a user is removed from
 	// the defined list, or org and/or role_type changes.
-	for user, role := range cr.Status.AtProvider.AssignedRoles {
+	for user, role := range cr.GetAtProvider().AssignedRoles {
 		if role != members[user] {
 			return nil
 		}
 	}
 
 	// in case of "Strict", check orphans in the observed roles, due to external modification.
-	if cr.Spec.ForProvider.EnforcementPolicy == enforcementPolicyStrict {
+	if cr.GetForProvider().EnforcementPolicy == enforcementPolicyStrict {
 		if len(observed) != len(members) {
 			return nil
 		}
@@ -129,8 +129,8 @@ func generateOrgMemberObservation(observed map[string]string, cr *v1alpha1.OrgMe
 }
 
 // DeleteOrgMembers remove external org role resources managed by this CR
-func (c *Client) DeleteOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) error {
-	fp := cr.Spec.ForProvider
+func (c *Client) DeleteOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) error {
+	fp := cr.GetForProvider()
 	// if strict, remove all users from the role
 	if fp.EnforcementPolicy == enforcementPolicyStrict {
 		allUsersWithRole, err := c.ListUsersWithRole(ctx, newOrgRoleListOptions(orgGUID, roleType))
@@ -140,11 +140,11 @@ func (c *Client) DeleteOrgMembers(ctx context.Context, orgGUID, roleType string,
 		return c.RemoveUsersFromRole(ctx, allUsersWithRole)
 	}
 	// otherwise, remove just the members
-	return c.RemoveUsersFromRole(ctx, cr.Status.AtProvider.AssignedRoles)
+	return c.RemoveUsersFromRole(ctx, cr.GetAtProvider().AssignedRoles)
 }
 
 // AssignSpaceMembers assigns Space Role for the given list of users
-func (c *Client) AssignSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) (*v1alpha1.RoleAssignments, error) {
+func (c *Client) AssignSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) (*v1alpha1.RoleAssignments, error) {
 	// get all users with the role
 	observed, err := c.ListUsersWithRole(ctx, newSpaceRoleListOptions(spaceGUID, roleType))
 	if err != nil {
@@ -153,7 +153,7 @@ func (c *Client) AssignSpaceMembers(ctx context.Context, spaceGUID, roleType str
 
 	members := make(map[string]string)
 	// make sure all defined users has the role
-	for _, u := range cr.Spec.ForProvider.Members {
+	for _, u := range cr.GetForProvider().Members {
 		user := u.Key()
 		role, ok := observed[user]
 		if !ok {
@@ -167,7 +167,7 @@ func (c *Client) AssignSpaceMembers(ctx context.Context, spaceGUID, roleType str
 	}
 
 	// in case of "Strict", remove any remaining user in the observed list from the role
-	if cr.Spec.ForProvider.EnforcementPolicy == enforcementPolicyStrict {
+	if cr.GetForProvider().EnforcementPolicy == enforcementPolicyStrict {
 		for user, role := range observed {
 			if _, ok := members[user]; !ok {
 				err := c.DeleteRole(ctx, role)
@@ -185,7 +185,7 @@ func (c *Client) AssignSpaceMembers(ctx context.Context, spaceGUID, roleType str
 }
 
 // UpdateSpaceMembers observes external state and update it according the CR specification
-func (c *Client) UpdateSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) (*v1alpha1.RoleAssignments, error) {
+func (c *Client) UpdateSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) (*v1alpha1.RoleAssignments, error) {
 	members, err := c.AssignSpaceMembers(ctx, spaceGUID, roleType, cr)
 	if err != nil {
 		return nil, err
@@ -193,8 +193,8 @@ func (c *Client) UpdateSpaceMembers(ctx context.Context, spaceGUID, roleType str
 
 	// remove any orphans in the (previously) assigned roles. This can happen if a user is removed from
 	// the defined list, or org and/or role_type changes.
-	if cr.Status.AtProvider.AssignedRoles != nil {
-		for user, role := range cr.Status.AtProvider.AssignedRoles {
+	if cr.GetAtProvider().AssignedRoles != nil {
+		for user, role := range cr.GetAtProvider().AssignedRoles {
 			if role != members.AssignedRoles[user] {
 				if err := c.DeleteRole(ctx, role); err != nil {
 					return nil, err
@@ -210,12 +210,12 @@ func (c *Client) UpdateSpaceMembers(ctx context.Context, spaceGUID, roleType str
 // If the observed state is not consistent with CR, return a nil observation together with an error.
 // The bool return value indicates whether any roles exist in Cloud Foundry for the given space GUID and role type,
 // regardless of whether they match the desired state. It is used by the controller to determine ResourceExists.
-func (c *Client) ObserveSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) (*v1alpha1.RoleAssignments, bool, error) {
+func (c *Client) ObserveSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) (*v1alpha1.RoleAssignments, bool, error) {
 	// sync every currently assigned role and remove it from members list if it no longer exists
-	for user, role := range cr.Status.AtProvider.AssignedRoles {
+	for user, role := range cr.GetAtProvider().AssignedRoles {
 		_, err := c.Roles.Get(ctx, role)
 		if err != nil && clients.ErrorIsNotFound(err) {
-			delete(cr.Status.AtProvider.AssignedRoles, user)
+			delete(cr.GetAtProvider().AssignedRoles, user)
 		}
 	}
 
@@ -228,10 +228,10 @@ func (c *Client) ObserveSpaceMembers(ctx context.Context, spaceGUID, roleType st
 	return generateSpaceMemberObservation(observed, cr), len(observed) > 0, nil
 }
 
-func generateSpaceMemberObservation(observed map[string]string, cr *v1alpha1.SpaceMembers) *v1alpha1.RoleAssignments {
+func generateSpaceMemberObservation(observed map[string]string, cr v1alpha1.SpaceMembersManaged) *v1alpha1.RoleAssignments {
 	members := make(map[string]string)
 	// check if all defined users has the role
-	for _, u := range cr.Spec.ForProvider.Members {
+	for _, u := range cr.GetForProvider().Members {
 		user := u.Key()
 		r, ok := observed[user]
 		if !ok {
@@ -242,14 +242,14 @@ func generateSpaceMemberObservation(observed map[string]string, cr *v1alpha1.Spa
 
 	// check orphans in the (previously) assigned roles. This can happen if a user is removed from
 	// the defined list, or space and/or role_type changes.
-	for user, role := range cr.Status.AtProvider.AssignedRoles {
+	for user, role := range cr.GetAtProvider().AssignedRoles {
 		if role != members[user] {
 			return nil
 		}
 	}
 
 	// in case of "Strict", check orphans in the observed roles, due to external modification.
-	if cr.Spec.ForProvider.EnforcementPolicy == enforcementPolicyStrict {
+	if cr.GetForProvider().EnforcementPolicy == enforcementPolicyStrict {
 		if len(observed) != len(members) {
 			return nil
 		}
@@ -258,8 +258,8 @@ func generateSpaceMemberObservation(observed map[string]string, cr *v1alpha1.Spa
 }
 
 // DeleteSpaceMembers removes space Role managed by the given CR.
-func (c *Client) DeleteSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) error {
-	fp := cr.Spec.ForProvider
+func (c *Client) DeleteSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) error {
+	fp := cr.GetForProvider()
 	// if strict, remove all users from the role
 	if fp.EnforcementPolicy == enforcementPolicyStrict {
 		allUsersWithRole, err := c.ListUsersWithRole(ctx, newSpaceRoleListOptions(spaceGUID, roleType))
@@ -269,5 +269,5 @@ func (c *Client) DeleteSpaceMembers(ctx context.Context, spaceGUID, roleType str
 		return c.RemoveUsersFromRole(ctx, allUsersWithRole)
 	}
 	// otherwise, remove just the members
-	return c.RemoveUsersFromRole(ctx, cr.Status.AtProvider.AssignedRoles)
+	return c.RemoveUsersFromRole(ctx, cr.GetAtProvider().AssignedRoles)
 }
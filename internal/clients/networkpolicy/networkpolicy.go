@@ -0,0 +1,210 @@
+package networkpolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+const (
+	// policiesPath is the path of the external API of the policy server.
+	policiesPath = "/networking/v1/external/policies"
+
+	errEncodePolicies   = "cannot encode network policies"
+	errDecodePolicies   = "cannot decode network policies"
+	errMissingApps      = "the source and the destination apps of the network policy are not resolved"
+	errMissingPorts     = "the ports of the network policy are not set"
+	errInvalidExtName   = "external-name '%s' is not of the format <source-guid>/<destination-guid>/<protocol>/<start>-<end>"
+	externalNameDivider = "/"
+)
+
+// Policy allows the traffic from a source app to the ports of a
+// destination app.
+type Policy struct {
+	Source      Source      `json:"source"`
+	Destination Destination `json:"destination"`
+}
+
+// Source is the app that sends the traffic.
+type Source struct {
+	ID string `json:"id"`
+}
+
+// Destination is the app and the ports that receive the traffic.
+type Destination struct {
+	ID       string `json:"id"`
+	Protocol string `json:"protocol"`
+	Ports    Ports  `json:"ports"`
+}
+
+// Ports is a range of ports.
+type Ports struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type policyList struct {
+	TotalPolicies int      `json:"total_policies,omitempty"`
+	Policies      []Policy `json:"policies"`
+}
+
+// Client is the interface that defines the methods that a NetworkPolicy
+// client should implement.
+type Client interface {
+	// List returns the policies the app is the source or the destination of.
+	List(ctx context.Context, appGUID string) ([]Policy, error)
+	Create(ctx context.Context, policies ...Policy) error
+	Delete(ctx context.Context, policies ...Policy) error
+}
+
+// policyClient sends its requests to the external API of the policy server,
+// which is served next to the Cloud Controller API and is not covered by the
+// CF client.
+type policyClient struct {
+	cf *cfv3.Client
+}
+
+// NewClient creates a new NetworkPolicy client
+func NewClient(cf *cfv3.Client) Client {
+	return &policyClient{cf: cf}
+}
+
+// List returns the policies the app is the source or the destination of.
+func (c *policyClient) List(ctx context.Context, appGUID string) ([]Policy, error) {
+	q := url.Values{}
+	q.Set("id", appGUID)
+	l := &policyList{}
+	if err := c.do(ctx, http.MethodGet, policiesPath+"?"+q.Encode(), nil, l); err != nil {
+		return nil, err
+	}
+	return l.Policies, nil
+}
+
+// Create creates the policies. Creating an existing policy has no effect.
+func (c *policyClient) Create(ctx context.Context, policies ...Policy) error {
+	return c.do(ctx, http.MethodPost, policiesPath, &policyList{Policies: policies}, nil)
+}
+
+// Delete deletes the policies. Deleting a missing policy has no effect.
+func (c *policyClient) Delete(ctx context.Context, policies ...Policy) error {
+	return c.do(ctx, http.MethodPost, policiesPath+"/delete", &policyList{Policies: policies}, nil)
+}
+
+func (c *policyClient) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		buf, err := json.Marshal(in)
+		if err != nil {
+			return errors.Wrap(err, errEncodePolicies)
+		}
+		body = bytes.NewReader(buf)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.cf.ApiURL(path), body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.cf.ExecuteAuthRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if out == nil {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(out), errDecodePolicies)
+}
+
+// GeneratePolicy generates the Policy from an *NetworkPolicyParameters.
+func GeneratePolicy(spec v1alpha1.NetworkPolicyParameters) (Policy, error) {
+	if spec.Source == nil || spec.Destination == nil {
+		return Policy{}, errors.New(errMissingApps)
+	}
+	if spec.Ports == nil {
+		return Policy{}, errors.New(errMissingPorts)
+	}
+	return Policy{
+		Source: Source{ID: *spec.Source},
+		Destination: Destination{
+			ID:       *spec.Destination,
+			Protocol: spec.Protocol,
+			Ports:    Ports{Start: spec.Ports.Start, End: spec.Ports.End},
+		},
+	}, nil
+}
+
+// GenerateObservation takes a Policy and returns *NetworkPolicyObservation.
+func GenerateObservation(p Policy) v1alpha1.NetworkPolicyObservation {
+	return v1alpha1.NetworkPolicyObservation{
+		Source:      ptr.To(p.Source.ID),
+		Destination: ptr.To(p.Destination.ID),
+		Protocol:    ptr.To(p.Destination.Protocol),
+		Ports:       &v1alpha1.NetworkPolicyPorts{Start: p.Destination.Ports.Start, End: p.Destination.Ports.End},
+	}
+}
+
+// ExternalName returns the external name of a policy, which is the compound
+// key <source-guid>/<destination-guid>/<protocol>/<start>-<end>, as a policy
+// has no GUID.
+func ExternalName(p Policy) string {
+	return strings.Join([]string{
+		p.Source.ID,
+		p.Destination.ID,
+		p.Destination.Protocol,
+		fmt.Sprintf("%d-%d", p.Destination.Ports.Start, p.Destination.Ports.End),
+	}, externalNameDivider)
+}
+
+// ParseExternalName returns the policy of an external name.
+func ParseExternalName(name string) (Policy, error) {
+	parts := strings.Split(name, externalNameDivider)
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return Policy{}, errors.Errorf(errInvalidExtName, name)
+	}
+	start, end, ok := strings.Cut(parts[3], "-")
+	if !ok {
+		return Policy{}, errors.Errorf(errInvalidExtName, name)
+	}
+	s, err := strconv.Atoi(start)
+	if err != nil {
+		return Policy{}, errors.Errorf(errInvalidExtName, name)
+	}
+	e, err := strconv.Atoi(end)
+	if err != nil {
+		return Policy{}, errors.Errorf(errInvalidExtName, name)
+	}
+	return Policy{
+		Source: Source{ID: parts[0]},
+		Destination: Destination{
+			ID:       parts[1],
+			Protocol: parts[2],
+			Ports:    Ports{Start: s, End: e},
+		},
+	}, nil
+}
+
+// Find returns the policy of the list that equals the given policy, or nil
+// if the list does not contain it.
+func Find(policies []Policy, p Policy) *Policy {
+	for i := range policies {
+		if policies[i] == p {
+			return &policies[i]
+		}
+	}
+	return nil
+}
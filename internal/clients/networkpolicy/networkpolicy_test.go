@@ -0,0 +1,125 @@
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+func TestExternalName(t *testing.T) {
+	p := Policy{
+		Source: Source{ID: "source-guid"},
+		Destination: Destination{
+			ID:       "destination-guid",
+			Protocol: "udp",
+			Ports:    Ports{Start: 53, End: 54},
+		},
+	}
+
+	name := ExternalName(p)
+	if name != "source-guid/destination-guid/udp/53-54" {
+		t.Errorf("ExternalName() = %q", name)
+	}
+
+	got, err := ParseExternalName(name)
+	if err != nil {
+		t.Fatalf("ParseExternalName() error = %v", err)
+	}
+	if diff := cmp.Diff(p, got); diff != "" {
+		t.Errorf("ParseExternalName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestParseExternalName(t *testing.T) {
+	cases := map[string]string{
+		"ObjectName":   "my-policy",
+		"MissingPorts": "source-guid/destination-guid/tcp",
+		"SinglePort":   "source-guid/destination-guid/tcp/8080",
+		"InvalidPort":  "source-guid/destination-guid/tcp/8080-http",
+		"EmptySource":  "/destination-guid/tcp/8080-8080",
+	}
+
+	for n, name := range cases {
+		t.Run(n, func(t *testing.T) {
+			if _, err := ParseExternalName(name); err == nil {
+				t.Errorf("ParseExternalName(%q) error = nil, want error", name)
+			}
+		})
+	}
+}
+
+func TestGeneratePolicy(t *testing.T) {
+	type want struct {
+		policy Policy
+		err    bool
+	}
+
+	cases := map[string]struct {
+		spec v1alpha1.NetworkPolicyParameters
+		want want
+	}{
+		"Success": {
+			spec: v1alpha1.NetworkPolicyParameters{
+				Source:      ptr.To("source-guid"),
+				Destination: ptr.To("destination-guid"),
+				Protocol:    "tcp",
+				Ports:       &v1alpha1.NetworkPolicyPorts{Start: 8080, End: 8080},
+			},
+			want: want{
+				policy: Policy{
+					Source: Source{ID: "source-guid"},
+					Destination: Destination{
+						ID:       "destination-guid",
+						Protocol: "tcp",
+						Ports:    Ports{Start: 8080, End: 8080},
+					},
+				},
+			},
+		},
+		"MissingDestination": {
+			spec: v1alpha1.NetworkPolicyParameters{
+				Source: ptr.To("source-guid"),
+				Ports:  &v1alpha1.NetworkPolicyPorts{Start: 8080, End: 8080},
+			},
+			want: want{err: true},
+		},
+		"MissingPorts": {
+			spec: v1alpha1.NetworkPolicyParameters{
+				Source:      ptr.To("source-guid"),
+				Destination: ptr.To("destination-guid"),
+			},
+			want: want{err: true},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got, err := GeneratePolicy(tc.spec)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("GeneratePolicy() error = %v, want error %v", err, tc.want.err)
+			}
+			if diff := cmp.Diff(tc.want.policy, got); diff != "" {
+				t.Errorf("GeneratePolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	p := Policy{
+		Source:      Source{ID: "source-guid"},
+		Destination: Destination{ID: "destination-guid", Protocol: "tcp", Ports: Ports{Start: 8080, End: 8080}},
+	}
+	other := p
+	other.Destination.Protocol = "udp"
+
+	if Find([]Policy{other}, p) != nil {
+		t.Error("Find() found a policy with another protocol")
+	}
+	if got := Find([]Policy{other, p}, p); got == nil || *got != p {
+		t.Errorf("Find() = %v, want %v", got, p)
+	}
+}
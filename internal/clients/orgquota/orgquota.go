@@ -160,20 +160,20 @@ func orgsEqual(orgs1, orgs2 []*string) bool {
 // Status.AtProvider.
 //
 //nolint:gocyclo
-func NeedsReconciliation(orgQuota *v1alpha1.OrgQuota) bool {
-	if ptr.Deref(orgQuota.Spec.ForProvider.Name, "") != ptr.Deref(orgQuota.Status.AtProvider.Name, "") ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.AllowPaidServicePlans, orgQuota.Status.AtProvider.AllowPaidServicePlans) ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.InstanceMemory, orgQuota.Status.AtProvider.InstanceMemory) ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.TotalAppInstances, orgQuota.Status.AtProvider.TotalAppInstances) ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.TotalAppLogRateLimit, orgQuota.Status.AtProvider.TotalAppLogRateLimit) ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.TotalAppTasks, orgQuota.Status.AtProvider.TotalAppTasks) ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.TotalMemory, orgQuota.Status.AtProvider.TotalMemory) ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.TotalPrivateDomains, orgQuota.Status.AtProvider.TotalPrivateDomains) ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.TotalRoutePorts, orgQuota.Status.AtProvider.TotalRoutePorts) ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.TotalRoutes, orgQuota.Status.AtProvider.TotalRoutes) ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.TotalServiceKeys, orgQuota.Status.AtProvider.TotalServiceKeys) ||
-		!ptr.Equal(orgQuota.Spec.ForProvider.TotalServices, orgQuota.Status.AtProvider.TotalServices) ||
-		!orgsEqual(orgQuota.Spec.ForProvider.Orgs, orgQuota.Status.AtProvider.Orgs) {
+func NeedsReconciliation(orgQuota v1alpha1.OrgQuotaManaged) bool {
+	if ptr.Deref(orgQuota.GetForProvider().Name, "") != ptr.Deref(orgQuota.GetAtProvider().Name, "") ||
+		!ptr.Equal(orgQuota.GetForProvider().AllowPaidServicePlans, orgQuota.GetAtProvider().AllowPaidServicePlans) ||
+		!ptr.Equal(orgQuota.GetForProvider().InstanceMemory, orgQuota.GetAtProvider().InstanceMemory) ||
+		!ptr.Equal(orgQuota.GetForProvider().TotalAppInstances, orgQuota.GetAtProvider().TotalAppInstances) ||
+		!ptr.Equal(orgQuota.GetForProvider().TotalAppLogRateLimit, orgQuota.GetAtProvider().TotalAppLogRateLimit) ||
+		!ptr.Equal(orgQuota.GetForProvider().TotalAppTasks, orgQuota.GetAtProvider().TotalAppTasks) ||
+		!ptr.Equal(orgQuota.GetForProvider().TotalMemory, orgQuota.GetAtProvider().TotalMemory) ||
+		!ptr.Equal(orgQuota.GetForProvider().TotalPrivateDomains, orgQuota.GetAtProvider().TotalPrivateDomains) ||
+		!ptr.Equal(orgQuota.GetForProvider().TotalRoutePorts, orgQuota.GetAtProvider().TotalRoutePorts) ||
+		!ptr.Equal(orgQuota.GetForProvider().TotalRoutes, orgQuota.GetAtProvider().TotalRoutes) ||
+		!ptr.Equal(orgQuota.GetForProvider().TotalServiceKeys, orgQuota.GetAtProvider().TotalServiceKeys) ||
+		!ptr.Equal(orgQuota.GetForProvider().TotalServices, orgQuota.GetAtProvider().TotalServices) ||
+		!orgsEqual(orgQuota.GetForProvider().Orgs, orgQuota.GetAtProvider().Orgs) {
 		return true
 	}
 	return false
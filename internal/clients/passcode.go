@@ -0,0 +1,219 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/config"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+// RefreshTokenKey is the key of the refresh token in the Secret referenced by
+// refreshTokenSecretRef.
+const RefreshTokenKey = "refresh_token"
+
+const (
+	errNoRefreshTokenSecretRef = "refreshTokenSecretRef is required for credentials of type Passcode"
+	errNoPasscode              = "no refresh token is stored and no passcode is provided"
+	errGetRefreshToken         = "cannot get stored refresh token"
+	errStoreRefreshToken       = "cannot store refresh token"
+	errDiscoverUAA             = "cannot discover UAA endpoint"
+	errRequestToken            = "cannot request token from UAA"
+)
+
+// uaaToken is the token response of the UAA.
+type uaaToken struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+// oauth2Token returns the token as an oauth2.Token that expires as reported
+// by the UAA, or never if the UAA reported no expiry.
+func (t *uaaToken) oauth2Token() *oauth2.Token {
+	token := &oauth2.Token{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		TokenType:    "bearer",
+	}
+	if t.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(t.ExpiresIn) * time.Second)
+	}
+	return token
+}
+
+// passcodeToken returns a token for credentials of type Passcode. It renews the
+// stored refresh token, if there is one, and otherwise logs in with the
+// one-time passcode. The resulting refresh token is stored for later use.
+func passcodeToken(ctx context.Context, kube client.Client, pc v1beta1.ProviderConfigObject, cred *CfCredentials, apiURL string, httpClient *http.Client) (*uaaToken, error) {
+	ref := pc.GetSpec().Credentials.RefreshTokenSecretRef
+	if ref == nil {
+		return nil, errors.New(errNoRefreshTokenSecretRef)
+	}
+
+	stored, err := getRefreshToken(ctx, kube, ref)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetRefreshToken)
+	}
+
+	uaaURL, err := getUAAURL(ctx, pc, httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var token *uaaToken
+	switch {
+	case stored != "":
+		token, err = requestToken(ctx, httpClient, uaaURL, url.Values{
+			"grant_type":    {config.GrantTypeRefreshToken},
+			"refresh_token": {stored},
+		})
+	case cred.Passcode != "":
+		token, err = requestToken(ctx, httpClient, uaaURL, url.Values{
+			"grant_type": {config.GrantTypePassword},
+			"passcode":   {cred.Passcode},
+		})
+	default:
+		return nil, errors.New(errNoPasscode)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errRequestToken)
+	}
+
+	if token.RefreshToken != "" && token.RefreshToken != stored {
+		if err := storeRefreshToken(ctx, kube, ref, token.RefreshToken); err != nil {
+			return nil, errors.Wrap(err, errStoreRefreshToken)
+		}
+	}
+	return token, nil
+}
+
+// getRefreshToken returns the refresh token stored in the referenced Secret, or
+// an empty string if the Secret does not exist yet.
+func getRefreshToken(ctx context.Context, kube client.Client, ref *xpv1.SecretReference) (string, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	return string(s.Data[RefreshTokenKey]), nil
+}
+
+// storeRefreshToken writes the refresh token into the referenced Secret and
+// creates the Secret if it does not exist.
+func storeRefreshToken(ctx context.Context, kube client.Client, ref *xpv1.SecretReference, token string) error {
+	s := &corev1.Secret{}
+	err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s)
+	if kerrors.IsNotFound(err) {
+		s = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ref.Namespace, Name: ref.Name},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{RefreshTokenKey: []byte(token)},
+		}
+		return kube.Create(ctx, s)
+	}
+	if err != nil {
+		return err
+	}
+	if s.Data == nil {
+		s.Data = map[string][]byte{}
+	}
+	s.Data[RefreshTokenKey] = []byte(token)
+	return kube.Update(ctx, s)
+}
+
+// getUAAURL returns the UAA endpoint configured in the ProviderConfig or, if
+// none is configured, the one advertised by the CF API root.
+func getUAAURL(ctx context.Context, pc v1beta1.ProviderConfigObject, httpClient *http.Client, apiURL string) (string, error) {
+	if uaaURL := getTokenEndpoint(pc); uaaURL != "" {
+		return uaaURL, nil
+	}
+	uaaURL, err := discoverUAA(ctx, httpClient, apiURL)
+	return uaaURL, errors.Wrap(err, errDiscoverUAA)
+}
+
+// discoverUAA returns the UAA endpoint advertised by the CF API root.
+func discoverUAA(ctx context.Context, httpClient *http.Client, apiURL string) (string, error) {
+	root, err := getAPIRoot(ctx, httpClient, apiURL)
+	if err != nil {
+		return "", err
+	}
+	if root.Links.Uaa.Href == "" {
+		return "", errors.New("API root does not advertise a UAA endpoint")
+	}
+	return strings.TrimRight(root.Links.Uaa.Href, "/"), nil
+}
+
+// getAPIRoot reads the unauthenticated CF API root.
+func getAPIRoot(ctx context.Context, httpClient *http.Client, apiURL string) (*resource.Root, error) {
+	root := &resource.Root{}
+	if err := getJSON(ctx, httpClient, strings.TrimRight(apiURL, "/")+"/", root); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// getJSON reads an unauthenticated JSON resource.
+func getJSON(ctx context.Context, httpClient *http.Client, url string, into any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(into)
+}
+
+// requestToken requests a token from the UAA for the default cf client, like
+// the CF CLI does.
+func requestToken(ctx context.Context, httpClient *http.Client, uaaURL string, form url.Values) (*uaaToken, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uaaURL+"/oauth/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(config.DefaultClientID, "")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %s", resp.Status)
+	}
+
+	token := &uaaToken{}
+	if err := json.NewDecoder(resp.Body).Decode(token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("UAA returned no access token")
+	}
+	return token, nil
+}
@@ -0,0 +1,128 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+func TestGetCredentialConfigPasscode(t *testing.T) {
+	const tokenSecret = "cf-refresh-token"
+
+	type args struct {
+		credentials  string
+		stored       map[string][]byte
+		refreshToken string
+		noSecretRef  bool
+	}
+	type want struct {
+		err    bool
+		grants []tokenRequest
+		stored map[string][]byte
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"LoginWithPasscode": {
+			args: args{
+				credentials:  `{"passcode": "123456"}`,
+				refreshToken: "refresh-1",
+			},
+			want: want{
+				grants: []tokenRequest{{grantType: "password", clientID: "cf", passcode: "123456"}},
+				stored: map[string][]byte{RefreshTokenKey: []byte("refresh-1")},
+			},
+		},
+		"RenewStoredRefreshToken": {
+			args: args{
+				credentials:  `{"passcode": "123456"}`,
+				stored:       map[string][]byte{RefreshTokenKey: []byte("refresh-1")},
+				refreshToken: "refresh-1",
+			},
+			want: want{
+				grants: []tokenRequest{{grantType: "refresh_token", clientID: "cf", refreshToken: "refresh-1"}},
+				stored: map[string][]byte{RefreshTokenKey: []byte("refresh-1")},
+			},
+		},
+		"StoreRotatedRefreshToken": {
+			args: args{
+				credentials:  `{}`,
+				stored:       map[string][]byte{RefreshTokenKey: []byte("refresh-1")},
+				refreshToken: "refresh-2",
+			},
+			want: want{
+				grants: []tokenRequest{{grantType: "refresh_token", clientID: "cf", refreshToken: "refresh-1"}},
+				stored: map[string][]byte{RefreshTokenKey: []byte("refresh-2")},
+			},
+		},
+		"NoPasscodeAndNoRefreshToken": {
+			args: args{
+				credentials: `{}`,
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"NoRefreshTokenSecretRef": {
+			args: args{
+				credentials: `{"passcode": "123456"}`,
+				noSecretRef: true,
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			f := newFakeUAA(t, false)
+			f.refreshToken = tc.args.refreshToken
+
+			pc := fakeProviderConfig(f.URL, v1beta1.CredentialsTypePasscode, nil)
+			if !tc.args.noSecretRef {
+				pc.Spec.Credentials.RefreshTokenSecretRef = &xpv1.SecretReference{Name: tokenSecret, Namespace: "default"}
+			}
+			kube := &fakeKube{
+				pc:      pc,
+				secrets: map[string]map[string][]byte{credentialsSecret: {"credentials": []byte(tc.args.credentials)}},
+			}
+			if tc.args.stored != nil {
+				kube.secrets[tokenSecret] = tc.args.stored
+			}
+
+			cfg, err := GetCredentialConfig(context.Background(), kube.client(), fakeManaged())
+			if tc.want.err {
+				if err == nil {
+					t.Fatalf("GetCredentialConfig(...): expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetCredentialConfig(...): unexpected error: %v", err)
+			}
+
+			resp, err := cfg.HTTPAuthClient().Get(f.URL + "/v3/organizations")
+			if err != nil {
+				t.Fatalf("GET /v3/organizations: unexpected error: %v", err)
+			}
+			_ = resp.Body.Close()
+			if diff := cmp.Diff(http.StatusOK, resp.StatusCode); diff != "" {
+				t.Errorf("GET /v3/organizations: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.grants, f.grants, cmp.AllowUnexported(tokenRequest{})); diff != "" {
+				t.Errorf("token requests: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.stored, kube.secrets[tokenSecret]); diff != "" {
+				t.Errorf("stored refresh token: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -6,45 +6,92 @@ package clients
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"net/http"
+	"strings"
 
 	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/go-cfclient/v3/config"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	nsv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 )
 
 // CfCredentials used to authenticate with the provider
 // FIXME: not consistent with other providers.
 type CfCredentials struct {
-	Email    string `json:"email"`
-	Username string `json:"username"`
-	Password string `json:"password"` //nolint:gosec
-	Passcode string `json:"passcode"`
+	Email        string `json:"email"`
+	Username     string `json:"username"`
+	Password     string `json:"password"` //nolint:gosec
+	Passcode     string `json:"passcode"`
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"` //nolint:gosec
+	// Assertion is the JWT of credentials of type JWTBearer. It is read as is,
+	// not from JSON, and part of the JSON only to identify the credentials.
+	Assertion string `json:"assertion,omitempty"` //nolint:gosec
 }
 
 const (
 	// error messages
-	errNoProviderConfig     = "no providerConfigRef provided"
-	errGetProviderConfig    = "cannot get referenced ProviderConfig"
-	errTrackUsage           = "cannot track ProviderConfig usage"
-	errExtractCredentials   = "cannot extract credentials"
-	errExtractEndpoint      = "cannot extract endpoint"
-	errUnmarshalCredentials = "cannot unmarshal cloudfoundry credentials as JSON"
-	errUnmarshalEndpoint    = "cannot unmarshal cloudfoundry endpoint as JSON"
-	errNoEndpoint           = "no API endpoint is configured in ProviderConfig"
+	errNoProviderConfig      = "no providerConfigRef provided"
+	errGetProviderConfig     = "cannot get referenced ProviderConfig"
+	errTrackUsage            = "cannot track ProviderConfig usage"
+	errExtractCredentials    = "cannot extract credentials"
+	errExtractEndpoint       = "cannot extract endpoint"
+	errUnmarshalCredentials  = "cannot unmarshal cloudfoundry credentials as JSON"
+	errUnmarshalEndpoint     = "cannot unmarshal cloudfoundry endpoint as JSON"
+	errNoEndpoint            = "no API endpoint is configured in ProviderConfig"
+	errNoClientCredentials   = "clientId and clientSecret are required for credentials of type ClientCredentials"
+	errUnknownCredentials    = "unknown credentials type %q"
+	errConfigureHTTPClient   = "cannot configure HTTP client"
+	errPasscodeLogin         = "cannot log in with passcode or refresh token"
+	errUnknownProviderConfig = "unknown ProviderConfig kind %q"
+	errForeignNamespace      = "%s %q must be in the namespace %q of the ProviderConfig"
+	errNamespacedSource      = "%s source %s is not allowed in a namespaced ProviderConfig, use Secret"
 )
 
 // GetCredentialConfig returns a config.Config for the given managed resource
 func GetCredentialConfig(ctx context.Context, client client.Client, mg resource.Managed) (*config.Config, error) {
-	pc, err := getProviderConfig(ctx, client, mg)
+	ref, err := ProviderConfigRefOf(mg)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := getConnectionDetails(ctx, client, ref)
+	if err != nil {
+		return nil, err
+	}
+	return conn.newConfig(ctx, client)
+}
+
+// connectionDetails are read from a ProviderConfig and the Secrets and
+// ConfigMaps it references. They are everything needed to connect to Cloud
+// Foundry.
+type connectionDetails struct {
+	pc       v1beta1.ProviderConfigObject
+	cred     *CfCredentials
+	url      string
+	caBundle []byte
+}
+
+// getConnectionDetails returns the connection details of the referenced
+// ProviderConfig.
+func getConnectionDetails(ctx context.Context, client client.Client, ref ProviderConfigRef) (*connectionDetails, error) {
+	pc, err := getProviderConfig(ctx, client, ref)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetProviderConfig)
 	}
+	if err := checkNamespaces(pc); err != nil {
+		return nil, err
+	}
 	cred, err := getCredentials(ctx, client, pc)
 	if err != nil {
 		return nil, errors.Wrap(err, errExtractCredentials)
@@ -55,19 +102,212 @@ func GetCredentialConfig(ctx context.Context, client client.Client, mg resource.
 		return nil, errors.Wrap(err, errExtractEndpoint)
 	}
 
-	return config.New(*url, config.UserPassword(cred.Email, cred.Password), config.SkipTLSValidation())
+	bundle, err := getCABundle(ctx, client, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCABundle)
+	}
+
+	return &connectionDetails{pc: pc, cred: cred, url: *url, caBundle: bundle}, nil
+}
+
+// hash identifies the connection details. It changes whenever the
+// ProviderConfig spec, the credentials or the CA bundle change.
+func (c *connectionDetails) hash() (string, error) {
+	buf, err := json.Marshal(struct {
+		Spec     v1beta1.ProviderConfigSpec `json:"spec"`
+		Cred     *CfCredentials             `json:"cred"`
+		URL      string                     `json:"url"`
+		CABundle []byte                     `json:"caBundle"`
+	}{*c.pc.GetSpec(), c.cred, c.url, c.caBundle})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// newConfig returns an authenticated config.Config and records on the
+// ProviderConfig whether the CF API certificate could be verified.
+func (c *connectionDetails) newConfig(ctx context.Context, kube client.Client) (*config.Config, error) {
+	httpClient, err := newHTTPClient(c.pc, c.caBundle)
+	if err != nil {
+		return nil, errors.Wrap(err, errConfigureHTTPClient)
+	}
+
+	cfg, err := newConfig(ctx, kube, c.pc, c.cred, c.url, httpClient)
+	setCertificateCondition(ctx, kube, c.pc, err)
+	return cfg, err
 }
 
-func getProviderConfig(ctx context.Context, client client.Client, mg resource.Managed) (*v1beta1.ProviderConfig, error) {
-	pc := &v1beta1.ProviderConfig{}
-	if err := client.Get(ctx, types.NamespacedName{Name: mg.(resource.LegacyManaged).GetProviderConfigReference().Name}, pc); err != nil {
+// newConfig authenticates with the grant type selected by the ProviderConfig
+// and returns the resulting config.Config.
+func newConfig(ctx context.Context, kube client.Client, pc v1beta1.ProviderConfigObject, cred *CfCredentials, apiURL string, httpClient *http.Client) (*config.Config, error) {
+	spec := pc.GetSpec()
+	opts := []config.Option{config.HttpClient(httpClient)}
+	var source oauth2.TokenSource
+	switch spec.Credentials.Type {
+	case v1beta1.CredentialsTypeUserPassword, "":
+		opts = append(opts, config.UserPassword(cred.Email, cred.Password))
+	case v1beta1.CredentialsTypeClientCredentials:
+		if cred.ClientID == "" || cred.ClientSecret == "" {
+			return nil, errors.Wrap(errors.New(errNoClientCredentials), errExtractCredentials)
+		}
+		opts = append(opts, config.ClientCredentials(cred.ClientID, cred.ClientSecret))
+	case v1beta1.CredentialsTypePasscode:
+		token, err := passcodeToken(ctx, kube, pc, cred, apiURL, httpClient)
+		if err != nil {
+			return nil, errors.Wrap(err, errPasscodeLogin)
+		}
+		opts = append(opts, config.Token(token.AccessToken, token.RefreshToken))
+	case v1beta1.CredentialsTypeJWTBearer:
+		token, err := jwtBearerToken(ctx, pc, cred, apiURL, httpClient)
+		if err != nil {
+			return nil, errors.Wrap(err, errJWTBearerLogin)
+		}
+		opts = append(opts, config.Token(token.AccessToken, token.RefreshToken))
+		source = oauth2.ReuseTokenSource(token.oauth2Token(), &jwtBearerTokenSource{kube: kube, pc: pc, apiURL: apiURL, httpClient: httpClient})
+	default:
+		return nil, errors.Errorf(errUnknownCredentials, spec.Credentials.Type)
+	}
+
+	if endpoint := getTokenEndpoint(pc); endpoint != "" {
+		opts = append(opts, config.AuthTokenURL(endpoint, endpoint))
+	}
+	if spec.InsecureSkipTLSVerify {
+		opts = append(opts, config.SkipTLSValidation())
+	}
+	// config.New overrides the timeout of the http.Client
+	if timeout := requestTimeout(spec); timeout > 0 {
+		opts = append(opts, config.RequestTimeout(timeout))
+	}
+	cfg, err := config.New(apiURL, opts...)
+	if err != nil || source == nil {
+		return cfg, err
+	}
+	// go-cfclient can only refresh a token with a refresh token, so the
+	// authenticated client uses the token source instead
+	cfg.HTTPAuthClient().Transport = &oauth2.Transport{Base: httpClient.Transport, Source: source}
+	return cfg, nil
+}
+
+// getTokenEndpoint returns the UAA endpoint configured in the ProviderConfig,
+// or an empty string if it is discovered from the API root. go-cfclient
+// appends /oauth/token to the UAA endpoint itself.
+func getTokenEndpoint(pc v1beta1.ProviderConfigObject) string {
+	endpoint := ptr.Deref(pc.GetSpec().Credentials.TokenEndpoint, "")
+	return strings.TrimSuffix(strings.TrimRight(endpoint, "/"), "/oauth/token")
+}
+
+// A ProviderConfigRef identifies a ProviderConfig of any kind. A namespaced
+// ProviderConfig has a namespace, a legacy cluster scoped ProviderConfig and a
+// ClusterProviderConfig have none.
+type ProviderConfigRef struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// String returns the kind, the namespace and the name of the ProviderConfig.
+func (r ProviderConfigRef) String() string {
+	if r.Namespace != "" {
+		return r.Kind + "/" + r.Namespace + "/" + r.Name
+	}
+	return r.Kind + "/" + r.Name
+}
+
+// ProviderConfigRefOf returns the ProviderConfig referenced by the managed
+// resource. Namespaced managed resources reference either a ProviderConfig in
+// their own namespace or a ClusterProviderConfig.
+func ProviderConfigRefOf(mg resource.Managed) (ProviderConfigRef, error) {
+	switch m := mg.(type) {
+	case resource.LegacyManaged:
+		ref := m.GetProviderConfigReference()
+		if ref == nil {
+			return ProviderConfigRef{}, errors.New(errNoProviderConfig)
+		}
+		return ProviderConfigRef{Kind: v1beta1.ProviderConfigKind, Name: ref.Name}, nil
+	case resource.ModernManaged:
+		ref := m.GetProviderConfigReference()
+		if ref == nil {
+			return ProviderConfigRef{}, errors.New(errNoProviderConfig)
+		}
+		switch ref.Kind {
+		case nsv1beta1.ProviderConfigKind:
+			return ProviderConfigRef{Kind: ref.Kind, Namespace: mg.GetNamespace(), Name: ref.Name}, nil
+		case nsv1beta1.ClusterProviderConfigKind:
+			return ProviderConfigRef{Kind: ref.Kind, Name: ref.Name}, nil
+		}
+		return ProviderConfigRef{}, errors.Errorf(errUnknownProviderConfig, ref.Kind)
+	}
+	return ProviderConfigRef{}, errors.New(errNoProviderConfig)
+}
+
+func getProviderConfig(ctx context.Context, client client.Client, ref ProviderConfigRef) (v1beta1.ProviderConfigObject, error) {
+	var pc v1beta1.ProviderConfigObject
+	switch {
+	case ref.Kind == nsv1beta1.ClusterProviderConfigKind:
+		pc = &nsv1beta1.ClusterProviderConfig{}
+	case ref.Kind == nsv1beta1.ProviderConfigKind && ref.Namespace != "":
+		pc = &nsv1beta1.ProviderConfig{}
+	case ref.Kind == v1beta1.ProviderConfigKind:
+		pc = &v1beta1.ProviderConfig{}
+	default:
+		return nil, errors.Errorf(errUnknownProviderConfig, ref.Kind)
+	}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, pc); err != nil {
 		return nil, err
 	}
 	return pc, nil
 }
 
-func getCredentials(ctx context.Context, client client.Client, pc *v1beta1.ProviderConfig) (*CfCredentials, error) {
-	buf, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, client, pc.Spec.Credentials.CommonCredentialSelectors)
+// checkNamespaces returns an error if a namespaced ProviderConfig reads from
+// anything but the Secrets and ConfigMaps of its own namespace.
+func checkNamespaces(pc v1beta1.ProviderConfigObject) error {
+	ns := pc.GetNamespace()
+	if ns == "" {
+		return nil
+	}
+	spec := pc.GetSpec()
+	// The identity, the environment and the files of the provider pod must
+	// not be lent to a namespace.
+	if spec.Credentials.Source != xpv1.CredentialsSourceSecret {
+		return errors.Errorf(errNamespacedSource, "credentials", spec.Credentials.Source)
+	}
+	if spec.Endpoint != nil && spec.Endpoint.Source != xpv1.CredentialsSourceSecret && spec.Endpoint.Source != xpv1.CredentialsSourceNone {
+		return errors.Errorf(errNamespacedSource, "endpoint", spec.Endpoint.Source)
+	}
+	refs := map[string]*xpv1.SecretReference{
+		"credentials secret":   secretReference(spec.Credentials.SecretRef),
+		"refresh token secret": spec.Credentials.RefreshTokenSecretRef,
+		"CA bundle secret":     secretReference(spec.CABundleSecretRef),
+	}
+	if spec.Endpoint != nil {
+		refs["endpoint secret"] = secretReference(spec.Endpoint.SecretRef)
+	}
+	for what, ref := range refs {
+		if ref != nil && ref.Namespace != ns {
+			return errors.Errorf(errForeignNamespace, what, ref.Namespace, ns)
+		}
+	}
+	if ref := spec.CABundleConfigMapRef; ref != nil && ref.Namespace != ns {
+		return errors.Errorf(errForeignNamespace, "CA bundle config map", ref.Namespace, ns)
+	}
+	return nil
+}
+
+func secretReference(sel *xpv1.SecretKeySelector) *xpv1.SecretReference {
+	if sel == nil {
+		return nil
+	}
+	return &sel.SecretReference
+}
+
+func getCredentials(ctx context.Context, client client.Client, pc v1beta1.ProviderConfigObject) (*CfCredentials, error) {
+	spec := pc.GetSpec()
+	if spec.Credentials.Type == v1beta1.CredentialsTypeJWTBearer || spec.Credentials.Source == xpv1.CredentialsSourceInjectedIdentity {
+		return getAssertion(ctx, client, pc)
+	}
+	buf, err := resource.CommonCredentialExtractor(ctx, spec.Credentials.Source, client, spec.Credentials.CommonCredentialSelectors)
 	if err != nil {
 		return nil, err
 	}
@@ -78,14 +318,14 @@ func getCredentials(ctx context.Context, client client.Client, pc *v1beta1.Provi
 	return &s, nil
 }
 
-func getEndpoint(ctx context.Context, client client.Client, pc *v1beta1.ProviderConfig) (*string, error) {
-
-	if pc.Spec.APIEndpoint != nil {
-		return pc.Spec.APIEndpoint, nil
+func getEndpoint(ctx context.Context, client client.Client, pc v1beta1.ProviderConfigObject) (*string, error) {
+	spec := pc.GetSpec()
+	if spec.APIEndpoint != nil {
+		return spec.APIEndpoint, nil
 	}
 
-	if pc.Spec.Endpoint != nil {
-		buf, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Endpoint.Source, client, pc.Spec.Endpoint.CommonCredentialSelectors)
+	if spec.Endpoint != nil {
+		buf, err := resource.CommonCredentialExtractor(ctx, spec.Endpoint.Source, client, spec.Endpoint.CommonCredentialSelectors)
 		if err != nil {
 			return nil, err
 		}
@@ -97,13 +337,14 @@ func getEndpoint(ctx context.Context, client client.Client, pc *v1beta1.Provider
 
 type ClientFn func(resource.Managed) (*cfv3.Client, error)
 
+// ClientFnBuilder returns a ClientFn that returns the authenticated client of
+// the ProviderConfig referenced by a managed resource from the shared cache.
 func ClientFnBuilder(ctx context.Context, client client.Client) func(resource.Managed) (*cfv3.Client, error) {
 	return func(mg resource.Managed) (*cfv3.Client, error) {
-		cfg, err := GetCredentialConfig(ctx, client, mg)
+		cf, err := SharedClientCache.Get(ctx, client, mg)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot config cloudfoundry client")
 		}
-
-		return cfv3.New(cfg)
+		return cf, nil
 	}
 }
@@ -0,0 +1,448 @@
+package clients
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	nsv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/v1beta1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+// fakeUAA serves the CF API root and the UAA token endpoint and records the
+// token requests it receives.
+type fakeUAA struct {
+	*httptest.Server
+	uaaURL       string
+	refreshToken string
+	expiresIn    int
+	grants       []tokenRequest
+}
+
+type tokenRequest struct {
+	grantType    string
+	clientID     string
+	username     string
+	passcode     string
+	refreshToken string
+	assertion    string
+}
+
+// fakeAccessToken is a JWT, go-cfclient reads the expiry of tokens passed to
+// config.Token.
+var fakeAccessToken = "header." + base64.RawURLEncoding.EncodeToString([]byte(`{"exp": 4102444800}`)) + ".signature"
+
+func newFakeUAA(t *testing.T, secure bool) *fakeUAA {
+	t.Helper()
+	f := &fakeUAA{expiresIn: 3600}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"links": map[string]any{
+				"login":               map[string]string{"href": f.uaaURL},
+				"uaa":                 map[string]string{"href": f.uaaURL},
+				"cloud_controller_v3": map[string]any{"href": f.URL + "/v3", "meta": map[string]string{"version": "3.180.0"}},
+			},
+		})
+	})
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("password") == "wrong" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		clientID, _, _ := r.BasicAuth()
+		f.grants = append(f.grants, tokenRequest{
+			grantType:    r.PostForm.Get("grant_type"),
+			clientID:     clientID,
+			username:     r.PostForm.Get("username"),
+			passcode:     r.PostForm.Get("passcode"),
+			refreshToken: r.PostForm.Get("refresh_token"),
+			assertion:    r.PostForm.Get("assertion"),
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  fakeAccessToken,
+			"refresh_token": f.refreshToken,
+			"token_type":    "bearer",
+			"expires_in":    f.expiresIn,
+		})
+	})
+	mux.HandleFunc("/v3/info", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"name": "fake"})
+	})
+	mux.HandleFunc("/v3/organizations", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+fakeAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"pagination": map[string]any{"total_results": 0}, "resources": []any{}})
+	})
+	if secure {
+		f.Server = httptest.NewTLSServer(mux)
+	} else {
+		f.Server = httptest.NewServer(mux)
+	}
+	f.uaaURL = f.URL
+	t.Cleanup(f.Close)
+	return f
+}
+
+// fakeKube serves the ProviderConfig and the Secrets and ConfigMaps by name.
+// The spec of the ProviderConfig is served for all kinds of ProviderConfigs.
+type fakeKube struct {
+	pc         *v1beta1.ProviderConfig
+	secrets    map[string]map[string][]byte
+	configMaps map[string]map[string]string
+}
+
+func (f *fakeKube) client() k8s.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key k8s.ObjectKey, obj k8s.Object) error {
+			switch o := obj.(type) {
+			case *v1beta1.ProviderConfig:
+				f.pc.DeepCopyInto(o)
+			case *nsv1beta1.ProviderConfig:
+				o.ObjectMeta = metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}
+				f.pc.Spec.DeepCopyInto(&o.Spec)
+			case *nsv1beta1.ClusterProviderConfig:
+				o.ObjectMeta = metav1.ObjectMeta{Name: key.Name}
+				f.pc.Spec.DeepCopyInto(&o.Spec)
+			case *corev1.Secret:
+				data, ok := f.secrets[key.Name]
+				if !ok {
+					return kerrors.NewNotFound(corev1.Resource("secrets"), key.Name)
+				}
+				o.Data = data
+			case *corev1.ConfigMap:
+				o.Data = f.configMaps[key.Name]
+			}
+			return nil
+		},
+		MockCreate: func(_ context.Context, obj k8s.Object, _ ...k8s.CreateOption) error {
+			f.secrets[obj.GetName()] = obj.(*corev1.Secret).Data
+			return nil
+		},
+		MockUpdate: func(_ context.Context, obj k8s.Object, _ ...k8s.UpdateOption) error {
+			f.secrets[obj.GetName()] = obj.(*corev1.Secret).Data
+			return nil
+		},
+		MockStatusUpdate: func(_ context.Context, obj k8s.Object, _ ...k8s.SubResourceUpdateOption) error {
+			obj.(v1beta1.ProviderConfigObject).GetStatus().DeepCopyInto(&f.pc.Status)
+			return nil
+		},
+	}
+}
+
+const credentialsSecret = "cf-credentials"
+
+func fakeProviderConfig(endpoint string, credType v1beta1.CredentialsType, tokenEndpoint *string) *v1beta1.ProviderConfig {
+	return &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1beta1.ProviderConfigSpec{
+			APIEndpoint: ptr.To(endpoint),
+			Credentials: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: credentialsSecret, Namespace: "default"},
+						Key:             "credentials",
+					},
+				},
+				Type:          credType,
+				TokenEndpoint: tokenEndpoint,
+			},
+		},
+	}
+}
+
+func fakeManaged() *v1alpha1.Space {
+	s := &v1alpha1.Space{}
+	s.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	return s
+}
+
+func TestGetCredentialConfig(t *testing.T) {
+	type args struct {
+		credType      v1beta1.CredentialsType
+		credentials   string
+		tokenEndpoint func(f *fakeUAA) *string
+		discoveredUAA func(f *fakeUAA) string
+	}
+	type want struct {
+		err    bool
+		grants []tokenRequest
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"DefaultUserPassword": {
+			args: args{
+				credentials: `{"email": "user@example.com", "password": "secret"}`,
+			},
+			want: want{
+				grants: []tokenRequest{{grantType: "password", clientID: "cf", username: "user@example.com"}},
+			},
+		},
+		"ClientCredentials": {
+			args: args{
+				credType:    v1beta1.CredentialsTypeClientCredentials,
+				credentials: `{"clientId": "my-client", "clientSecret": "secret"}`,
+			},
+			want: want{
+				grants: []tokenRequest{{grantType: "client_credentials", clientID: "my-client"}},
+			},
+		},
+		"ClientCredentialsMissingSecret": {
+			args: args{
+				credType:    v1beta1.CredentialsTypeClientCredentials,
+				credentials: `{"clientId": "my-client"}`,
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"UnknownCredentialsType": {
+			args: args{
+				credType:    v1beta1.CredentialsType("Kerberos"),
+				credentials: `{"clientId": "my-client", "clientSecret": "secret"}`,
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"CustomTokenEndpoint": {
+			args: args{
+				credType:      v1beta1.CredentialsTypeClientCredentials,
+				credentials:   `{"clientId": "my-client", "clientSecret": "secret"}`,
+				tokenEndpoint: func(f *fakeUAA) *string { return ptr.To(f.URL + "/oauth/token") },
+				discoveredUAA: func(*fakeUAA) string { return "http://127.0.0.1:1" },
+			},
+			want: want{
+				grants: []tokenRequest{{grantType: "client_credentials", clientID: "my-client"}},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			f := newFakeUAA(t, false)
+			if tc.args.discoveredUAA != nil {
+				f.uaaURL = tc.args.discoveredUAA(f)
+			}
+			var tokenEndpoint *string
+			if tc.args.tokenEndpoint != nil {
+				tokenEndpoint = tc.args.tokenEndpoint(f)
+			}
+			kube := &fakeKube{
+				pc:      fakeProviderConfig(f.URL, tc.args.credType, tokenEndpoint),
+				secrets: map[string]map[string][]byte{credentialsSecret: {"credentials": []byte(tc.args.credentials)}},
+			}
+
+			cfg, err := GetCredentialConfig(context.Background(), kube.client(), fakeManaged())
+			if tc.want.err {
+				if err == nil {
+					t.Fatalf("GetCredentialConfig(...): expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetCredentialConfig(...): unexpected error: %v", err)
+			}
+
+			resp, err := cfg.HTTPAuthClient().Get(f.URL + "/v3/organizations")
+			if err != nil {
+				t.Fatalf("GET /v3/organizations: unexpected error: %v", err)
+			}
+			_ = resp.Body.Close()
+			if diff := cmp.Diff(http.StatusOK, resp.StatusCode); diff != "" {
+				t.Errorf("GET /v3/organizations: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.grants, f.grants, cmp.AllowUnexported(tokenRequest{})); diff != "" {
+				t.Errorf("token requests: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetCredentialConfigScopes(t *testing.T) {
+	type args struct {
+		mg              func() resource.Managed
+		secretNamespace string
+		source          xpv1.CredentialsSource
+	}
+	type want struct {
+		err bool
+	}
+
+	namespaced := func(kind string) func() resource.Managed {
+		return func() resource.Managed {
+			s := &nsv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "space"}}
+			s.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: kind, Name: "default"})
+			return s
+		}
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"LegacyProviderConfig": {
+			args: args{
+				mg:              func() resource.Managed { return fakeManaged() },
+				secretNamespace: "default",
+			},
+		},
+		"NamespacedProviderConfig": {
+			args: args{
+				mg:              namespaced(nsv1beta1.ProviderConfigKind),
+				secretNamespace: "team-a",
+			},
+		},
+		"NamespacedProviderConfigForeignSecret": {
+			args: args{
+				mg:              namespaced(nsv1beta1.ProviderConfigKind),
+				secretNamespace: "default",
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"NamespacedProviderConfigInjectedIdentity": {
+			args: args{
+				mg:              namespaced(nsv1beta1.ProviderConfigKind),
+				secretNamespace: "team-a",
+				source:          xpv1.CredentialsSourceInjectedIdentity,
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"NamespacedProviderConfigFilesystem": {
+			args: args{
+				mg:              namespaced(nsv1beta1.ProviderConfigKind),
+				secretNamespace: "team-a",
+				source:          xpv1.CredentialsSourceFilesystem,
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"NamespacedProviderConfigEnvironment": {
+			args: args{
+				mg:              namespaced(nsv1beta1.ProviderConfigKind),
+				secretNamespace: "team-a",
+				source:          xpv1.CredentialsSourceEnvironment,
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"ClusterProviderConfig": {
+			args: args{
+				mg:              namespaced(nsv1beta1.ClusterProviderConfigKind),
+				secretNamespace: "default",
+			},
+		},
+		"UnknownKind": {
+			args: args{
+				mg:              namespaced("OtherProviderConfig"),
+				secretNamespace: "team-a",
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			f := newFakeUAA(t, false)
+			pc := fakeProviderConfig(f.URL, "", nil)
+			pc.Spec.Credentials.SecretRef.Namespace = tc.args.secretNamespace
+			if tc.args.source != "" {
+				pc.Spec.Credentials.Source = tc.args.source
+			}
+			kube := &fakeKube{
+				pc:      pc,
+				secrets: map[string]map[string][]byte{credentialsSecret: {"credentials": []byte(`{"email": "user@example.com", "password": "secret"}`)}},
+			}
+
+			_, err := GetCredentialConfig(context.Background(), kube.client(), tc.args.mg())
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("GetCredentialConfig(...): -want error, +got error:\n%s\n%v", diff, err)
+			}
+		})
+	}
+}
+
+func TestCheckNamespaces(t *testing.T) {
+	cases := map[string]struct {
+		source         xpv1.CredentialsSource
+		endpointSource xpv1.CredentialsSource
+		want           error
+	}{
+		"Secret": {
+			source: xpv1.CredentialsSourceSecret,
+		},
+		"EndpointSecret": {
+			source:         xpv1.CredentialsSourceSecret,
+			endpointSource: xpv1.CredentialsSourceSecret,
+		},
+		"InjectedIdentity": {
+			source: xpv1.CredentialsSourceInjectedIdentity,
+			want:   errors.Errorf(errNamespacedSource, "credentials", xpv1.CredentialsSourceInjectedIdentity),
+		},
+		"Filesystem": {
+			source: xpv1.CredentialsSourceFilesystem,
+			want:   errors.Errorf(errNamespacedSource, "credentials", xpv1.CredentialsSourceFilesystem),
+		},
+		"Environment": {
+			source: xpv1.CredentialsSourceEnvironment,
+			want:   errors.Errorf(errNamespacedSource, "credentials", xpv1.CredentialsSourceEnvironment),
+		},
+		"EndpointFilesystem": {
+			source:         xpv1.CredentialsSourceSecret,
+			endpointSource: xpv1.CredentialsSourceFilesystem,
+			want:           errors.Errorf(errNamespacedSource, "endpoint", xpv1.CredentialsSourceFilesystem),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			pc := fakeProviderConfig("https://api.example.com", v1beta1.CredentialsTypeJWTBearer, nil)
+			pc.Namespace = "team-a"
+			pc.Spec.Credentials.Source = tc.source
+			pc.Spec.Credentials.SecretRef.Namespace = "team-a"
+			if tc.endpointSource != "" {
+				pc.Spec.Endpoint = &v1beta1.EndpointConfig{Source: tc.endpointSource}
+			}
+
+			err := checkNamespaces(pc)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("checkNamespaces(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+package clients
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+const (
+	errInvalidProxyURL = "invalid proxy URL %q"
+)
+
+// proxyFunc returns the proxy function of the transport to the CF API and UAA.
+// The proxy of the ProviderConfig overrides the proxy of the environment, the
+// hosts in the no-proxy list are reached directly in both cases.
+func proxyFunc(spec *v1beta1.ProviderConfigSpec) (func(*http.Request) (*url.URL, error), error) {
+	proxy := http.ProxyFromEnvironment
+	if spec.ProxyURL != nil {
+		u, err := url.Parse(*spec.ProxyURL)
+		if err != nil || u.Host == "" {
+			return nil, errors.Errorf(errInvalidProxyURL, *spec.ProxyURL)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, errors.Errorf(errInvalidProxyURL, *spec.ProxyURL)
+		}
+		proxy = http.ProxyURL(u)
+	}
+	if len(spec.NoProxy) == 0 {
+		return proxy, nil
+	}
+
+	noProxy := spec.NoProxy
+	return func(req *http.Request) (*url.URL, error) {
+		if bypassProxy(noProxy, req.URL.Hostname()) {
+			return nil, nil
+		}
+		return proxy(req)
+	}, nil
+}
+
+// bypassProxy returns true if the host matches an entry of the no-proxy list.
+func bypassProxy(noProxy []string, host string) bool {
+	host = strings.ToLower(host)
+	ip := net.ParseIP(host)
+	for _, entry := range noProxy {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		switch {
+		case entry == "":
+			continue
+		case entry == "*":
+			return true
+		case ip != nil:
+			if _, cidr, err := net.ParseCIDR(entry); err == nil && cidr.Contains(ip) {
+				return true
+			}
+			if other := net.ParseIP(entry); other != nil && other.Equal(ip) {
+				return true
+			}
+		case strings.HasPrefix(entry, "."):
+			if strings.HasSuffix(host, entry) || host == entry[1:] {
+				return true
+			}
+		case host == entry || strings.HasSuffix(host, "."+entry):
+			return true
+		}
+	}
+	return false
+}
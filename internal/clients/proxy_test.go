@@ -0,0 +1,222 @@
+package clients
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// fakeProxy is a forward proxy for plain HTTP requests. It records the paths of
+// the requests it forwards.
+type fakeProxy struct {
+	*httptest.Server
+	delay time.Duration
+
+	mu    sync.Mutex
+	paths []string
+}
+
+func newFakeProxy(t *testing.T, delay time.Duration) *fakeProxy {
+	t.Helper()
+	p := &fakeProxy{delay: delay}
+	transport := &http.Transport{}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		p.paths = append(p.paths, r.URL.Path)
+		p.mu.Unlock()
+		time.Sleep(p.delay)
+
+		req, err := http.NewRequestWithContext(r.Context(), r.Method, r.URL.String(), r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		req.Header = r.Header.Clone()
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close() //nolint:errcheck
+		for k, v := range resp.Header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(resp.StatusCode)
+		_, _ = io.Copy(w, resp.Body)
+	}))
+	t.Cleanup(p.Close)
+	return p
+}
+
+func (p *fakeProxy) proxied() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.paths
+}
+
+func TestGetCredentialConfigProxy(t *testing.T) {
+	credentials := []byte(`{"email": "user@example.com", "password": "secret"}`)
+
+	type args struct {
+		proxyURL       func(p *fakeProxy) string
+		noProxy        []string
+		requestTimeout time.Duration
+		delay          time.Duration
+	}
+	type want struct {
+		err     bool
+		proxied []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoProxy": {
+			args: args{},
+			want: want{},
+		},
+		"Proxy": {
+			args: args{
+				proxyURL: func(p *fakeProxy) string { return p.URL },
+			},
+			want: want{
+				proxied: []string{"/", "/oauth/token"},
+			},
+		},
+		"NoProxyHost": {
+			args: args{
+				proxyURL: func(p *fakeProxy) string { return p.URL },
+				noProxy:  []string{"cf.example.com", "127.0.0.1"},
+			},
+			want: want{},
+		},
+		"NoProxyCIDR": {
+			args: args{
+				proxyURL: func(p *fakeProxy) string { return p.URL },
+				noProxy:  []string{"127.0.0.0/8"},
+			},
+			want: want{},
+		},
+		"ProxyUnreachable": {
+			args: args{
+				proxyURL: func(*fakeProxy) string { return "http://127.0.0.1:1" },
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"InvalidProxyURL": {
+			args: args{
+				proxyURL: func(*fakeProxy) string { return "proxy.example.com" },
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"RequestTimeout": {
+			args: args{
+				proxyURL:       func(p *fakeProxy) string { return p.URL },
+				requestTimeout: 50 * time.Millisecond,
+				delay:          500 * time.Millisecond,
+			},
+			want: want{
+				err:     true,
+				proxied: []string{"/"},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			f := newFakeUAA(t, false)
+			p := newFakeProxy(t, tc.args.delay)
+			pc := fakeProviderConfig(f.URL, "", nil)
+			if tc.args.proxyURL != nil {
+				pc.Spec.ProxyURL = ptr.To(tc.args.proxyURL(p))
+			}
+			pc.Spec.NoProxy = tc.args.noProxy
+			if tc.args.requestTimeout > 0 {
+				pc.Spec.RequestTimeout = &metav1.Duration{Duration: tc.args.requestTimeout}
+			}
+			kube := &fakeKube{
+				pc:      pc,
+				secrets: map[string]map[string][]byte{credentialsSecret: {"credentials": credentials}},
+			}
+
+			_, err := GetCredentialConfig(context.Background(), kube.client(), fakeManaged())
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("GetCredentialConfig(...): -want error, +got error:\n%s\n%v", diff, err)
+			}
+			if diff := cmp.Diff(tc.want.proxied, p.proxied()); diff != "" {
+				t.Errorf("proxied requests: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBypassProxy(t *testing.T) {
+	cases := map[string]struct {
+		noProxy []string
+		host    string
+		want    bool
+	}{
+		"Empty": {
+			host: "api.cf.example.com",
+		},
+		"Wildcard": {
+			noProxy: []string{"*"},
+			host:    "api.cf.example.com",
+			want:    true,
+		},
+		"Host": {
+			noProxy: []string{"api.cf.example.com"},
+			host:    "API.cf.example.com",
+			want:    true,
+		},
+		"Subdomain": {
+			noProxy: []string{"example.com"},
+			host:    "api.cf.example.com",
+			want:    true,
+		},
+		"LeadingDot": {
+			noProxy: []string{".example.com"},
+			host:    "example.com",
+			want:    true,
+		},
+		"OtherDomain": {
+			noProxy: []string{"example.com"},
+			host:    "api.cf.notexample.com",
+		},
+		"IP": {
+			noProxy: []string{"10.0.0.1"},
+			host:    "10.0.0.1",
+			want:    true,
+		},
+		"CIDR": {
+			noProxy: []string{" 10.0.0.0/8 "},
+			host:    "10.1.2.3",
+			want:    true,
+		},
+		"OutsideCIDR": {
+			noProxy: []string{"10.0.0.0/8"},
+			host:    "192.168.0.1",
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, bypassProxy(tc.noProxy, tc.host)); diff != "" {
+				t.Errorf("bypassProxy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+package clients
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+const (
+	// DefaultMaxRetries of a request rejected by the CF API.
+	DefaultMaxRetries = 5
+
+	// headerRateLimitReset is the Unix time at which the CF API rate limit of
+	// the user resets.
+	headerRateLimitReset = "X-RateLimit-Reset"
+
+	// minBackoff and maxBackoff bound the backoff between retries of requests
+	// that failed with a transient error.
+	minBackoff = 500 * time.Millisecond
+	maxBackoff = 30 * time.Second
+
+	// maxRetryDelay is the longest delay a request is held back for. A request
+	// whose rate limit resets later fails, so that the reconcile is requeued
+	// instead of blocking a worker.
+	maxRetryDelay = time.Minute
+)
+
+var clientRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "cloudfoundry_client_retries_total",
+	Help: "Number of requests to the CF API or UAA that were retried, by the HTTP status code of the failed attempt.",
+}, []string{"code"})
+
+func init() {
+	metrics.Registry.MustRegister(clientRetries)
+}
+
+// A retryTransport limits the rate of requests and retries requests that were
+// rejected by the rate limit of the CF API or failed with a transient error.
+type retryTransport struct {
+	base       http.RoundTripper
+	limiter    *rate.Limiter // nil if unlimited
+	maxRetries int
+
+	// now and backoff are replaced in tests
+	now     func() time.Time
+	backoff func(attempt int) time.Duration
+}
+
+// newRetryTransport returns a retryTransport around the base transport with
+// the rate limit of the ProviderConfig.
+func newRetryTransport(base http.RoundTripper, rl *v1beta1.RateLimit) *retryTransport {
+	t := &retryTransport{
+		base:       base,
+		maxRetries: DefaultMaxRetries,
+		now:        time.Now,
+		backoff:    backoff,
+	}
+	if rl == nil {
+		return t
+	}
+	if rl.QPS != nil {
+		t.limiter = rate.NewLimiter(rate.Limit(*rl.QPS), ptr.Deref(rl.Burst, *rl.QPS))
+	}
+	if rl.MaxRetries != nil {
+		t.maxRetries = *rl.MaxRetries
+	}
+	return t
+}
+
+// RoundTrip sends the request once the rate limit allows it and retries it as
+// long as it is retryable.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		r, err := rewind(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := t.base.RoundTrip(r)
+		if err != nil || attempt >= t.maxRetries || !retryable(req, resp) {
+			return resp, err
+		}
+		delay, ok := t.retryDelay(resp, attempt)
+		if !ok {
+			return resp, nil
+		}
+
+		// the response is discarded, drain it to reuse the connection
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		clientRetries.WithLabelValues(strconv.Itoa(resp.StatusCode)).Inc()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rewind returns the request to send in the given attempt. The body of a
+// request is consumed by an attempt, later attempts send a fresh copy.
+func rewind(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
+
+// retryable returns true if the request may be sent again. A request rejected
+// with 429 has not been processed and is always retryable, other requests only
+// if they are idempotent and failed with a transient error. Requests whose
+// body cannot be sent again are never retryable.
+func retryable(req *http.Request, resp *http.Response) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(req.Method)
+	}
+	return false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns the jittered delay before the next attempt. It honors the
+// Retry-After and X-RateLimit-Reset headers and falls back to an exponential
+// backoff. It returns false if the delay exceeds maxRetryDelay.
+func (t *retryTransport) retryDelay(resp *http.Response, attempt int) (time.Duration, bool) {
+	delay, ok := t.headerDelay(resp.Header)
+	if ok {
+		// spread the requests waiting for the same reset
+		delay = wait.Jitter(delay, 0.1)
+	} else {
+		delay = t.backoff(attempt)
+	}
+	if delay > maxRetryDelay {
+		return 0, false
+	}
+	return delay, true
+}
+
+// headerDelay returns the delay requested by the Retry-After header, in
+// seconds or as HTTP date, or by the X-RateLimit-Reset header.
+func (t *retryTransport) headerDelay(h http.Header) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if s, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return max(time.Duration(s)*time.Second, 0), true
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return max(at.Sub(t.now()), 0), true
+		}
+	}
+	if v := h.Get(headerRateLimitReset); v != "" {
+		if s, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return max(time.Unix(s, 0).Sub(t.now()), 0), true
+		}
+	}
+	return 0, false
+}
+
+// backoff returns the jittered exponential backoff of the given attempt.
+func backoff(attempt int) time.Duration {
+	d := math.Min(float64(minBackoff)*math.Pow(2, float64(attempt)), float64(maxBackoff))
+	return wait.Jitter(time.Duration(d), 0.5)
+}
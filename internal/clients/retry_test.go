@@ -0,0 +1,288 @@
+package clients
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+// fakeResponse is a response of the fake CF API.
+type fakeResponse struct {
+	status int
+	header http.Header
+}
+
+func TestRetryTransport(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	retryAfter := func(v string) http.Header { return http.Header{"Retry-After": {v}} }
+
+	type args struct {
+		method     string
+		body       []byte
+		maxRetries int
+		responses  []fakeResponse
+	}
+	type want struct {
+		status   int
+		attempts int
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Success": {
+			args: args{
+				method:     http.MethodGet,
+				maxRetries: 5,
+				responses:  []fakeResponse{{status: http.StatusOK}},
+			},
+			want: want{status: http.StatusOK, attempts: 1},
+		},
+		"RateLimitedRetryAfter": {
+			args: args{
+				method:     http.MethodGet,
+				maxRetries: 5,
+				responses: []fakeResponse{
+					{status: http.StatusTooManyRequests, header: retryAfter("0")},
+					{status: http.StatusTooManyRequests, header: retryAfter("0")},
+					{status: http.StatusOK},
+				},
+			},
+			want: want{status: http.StatusOK, attempts: 3},
+		},
+		"RateLimitedPostWithBody": {
+			args: args{
+				method:     http.MethodPost,
+				body:       []byte(`{"name": "my-space"}`),
+				maxRetries: 5,
+				responses: []fakeResponse{
+					{status: http.StatusTooManyRequests, header: http.Header{headerRateLimitReset: {strconv.FormatInt(now.Unix(), 10)}}},
+					{status: http.StatusCreated},
+				},
+			},
+			want: want{status: http.StatusCreated, attempts: 2},
+		},
+		"RateLimitResetsTooLate": {
+			args: args{
+				method:     http.MethodGet,
+				maxRetries: 5,
+				responses: []fakeResponse{
+					{status: http.StatusTooManyRequests, header: http.Header{headerRateLimitReset: {strconv.FormatInt(now.Add(time.Hour).Unix(), 10)}}},
+				},
+			},
+			want: want{status: http.StatusTooManyRequests, attempts: 1},
+		},
+		"TransientIdempotent": {
+			args: args{
+				method:     http.MethodDelete,
+				maxRetries: 5,
+				responses: []fakeResponse{
+					{status: http.StatusBadGateway},
+					{status: http.StatusServiceUnavailable},
+					{status: http.StatusAccepted},
+				},
+			},
+			want: want{status: http.StatusAccepted, attempts: 3},
+		},
+		"TransientNotIdempotent": {
+			args: args{
+				method:     http.MethodPost,
+				body:       []byte(`{}`),
+				maxRetries: 5,
+				responses: []fakeResponse{
+					{status: http.StatusServiceUnavailable},
+					{status: http.StatusCreated},
+				},
+			},
+			want: want{status: http.StatusServiceUnavailable, attempts: 1},
+		},
+		"MaxRetriesExceeded": {
+			args: args{
+				method:     http.MethodGet,
+				maxRetries: 2,
+				responses: []fakeResponse{
+					{status: http.StatusServiceUnavailable},
+					{status: http.StatusServiceUnavailable},
+					{status: http.StatusServiceUnavailable},
+					{status: http.StatusOK},
+				},
+			},
+			want: want{status: http.StatusServiceUnavailable, attempts: 3},
+		},
+		"NotRetryable": {
+			args: args{
+				method:     http.MethodGet,
+				maxRetries: 5,
+				responses: []fakeResponse{
+					{status: http.StatusNotFound},
+					{status: http.StatusOK},
+				},
+			},
+			want: want{status: http.StatusNotFound, attempts: 1},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if diff := cmp.Diff(string(tc.args.body), string(body)); diff != "" {
+					t.Errorf("attempt %d: -want body, +got body:\n%s", attempts, diff)
+				}
+				resp := tc.args.responses[attempts]
+				attempts++
+				for k, v := range resp.header {
+					w.Header()[k] = v
+				}
+				w.WriteHeader(resp.status)
+			}))
+			defer srv.Close()
+
+			rt := &retryTransport{
+				base:       http.DefaultTransport,
+				maxRetries: tc.args.maxRetries,
+				now:        func() time.Time { return now },
+				backoff:    func(int) time.Duration { return 0 },
+			}
+			var body io.Reader
+			if tc.args.body != nil {
+				body = bytes.NewReader(tc.args.body)
+			}
+			req, err := http.NewRequest(tc.args.method, srv.URL, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("RoundTrip(...): unexpected error: %v", err)
+			}
+			defer resp.Body.Close() //nolint:errcheck
+			if diff := cmp.Diff(tc.want, want{status: resp.StatusCode, attempts: attempts}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("RoundTrip(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	reset := func(at time.Time) http.Header {
+		h := http.Header{}
+		h.Set(headerRateLimitReset, strconv.FormatInt(at.Unix(), 10))
+		return h
+	}
+
+	cases := map[string]struct {
+		header http.Header
+		want   time.Duration
+		ok     bool
+	}{
+		"RetryAfterSeconds": {
+			header: http.Header{"Retry-After": {"10"}},
+			want:   10 * time.Second,
+			ok:     true,
+		},
+		"RetryAfterDate": {
+			header: http.Header{"Retry-After": {now.Add(20 * time.Second).UTC().Format(http.TimeFormat)}},
+			want:   20 * time.Second,
+			ok:     true,
+		},
+		"RateLimitReset": {
+			header: reset(now.Add(30 * time.Second)),
+			want:   30 * time.Second,
+			ok:     true,
+		},
+		"RateLimitResetInThePast": {
+			header: reset(now.Add(-time.Minute)),
+			want:   0,
+			ok:     true,
+		},
+		"RateLimitResetTooLate": {
+			header: reset(now.Add(time.Hour)),
+		},
+		"Backoff": {
+			header: http.Header{},
+			want:   time.Second,
+			ok:     true,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			rt := &retryTransport{
+				now:     func() time.Time { return now },
+				backoff: func(int) time.Duration { return time.Second },
+			}
+			got, ok := rt.retryDelay(&http.Response{Header: tc.header}, 0)
+			if diff := cmp.Diff(tc.ok, ok); diff != "" {
+				t.Fatalf("retryDelay(...): -want ok, +got ok:\n%s", diff)
+			}
+			// header delays are jittered by up to 10%
+			if got < tc.want || got > tc.want+tc.want/10 {
+				t.Errorf("retryDelay(...): want %s up to 10%% more, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt, want := range []time.Duration{minBackoff, 2 * minBackoff, 4 * minBackoff} {
+		if got := backoff(attempt); got < want || got > want+want/2 {
+			t.Errorf("backoff(%d): want %s up to 50%% more, got %s", attempt, want, got)
+		}
+	}
+	if got := backoff(100); got < maxBackoff || got > maxBackoff+maxBackoff/2 {
+		t.Errorf("backoff(100): want %s up to 50%% more, got %s", maxBackoff, got)
+	}
+}
+
+func TestNewRetryTransport(t *testing.T) {
+	type want struct {
+		limit      rate.Limit
+		burst      int
+		maxRetries int
+	}
+
+	cases := map[string]struct {
+		rl   *v1beta1.RateLimit
+		want want
+	}{
+		"Default": {
+			want: want{limit: rate.Inf, maxRetries: DefaultMaxRetries},
+		},
+		"QPS": {
+			rl:   &v1beta1.RateLimit{QPS: ptr.To(10)},
+			want: want{limit: 10, burst: 10, maxRetries: DefaultMaxRetries},
+		},
+		"QPSAndBurst": {
+			rl:   &v1beta1.RateLimit{QPS: ptr.To(10), Burst: ptr.To(50), MaxRetries: ptr.To(0)},
+			want: want{limit: 10, burst: 50},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			rt := newRetryTransport(http.DefaultTransport, tc.rl)
+			got := want{limit: rate.Inf, maxRetries: rt.maxRetries}
+			if rt.limiter != nil {
+				got.limit, got.burst = rt.limiter.Limit(), rt.limiter.Burst()
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("newRetryTransport(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/go-cfclient/v3/resource"
 	xpresource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
 
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
@@ -16,6 +17,8 @@ import (
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
 )
 
+const errUnresolvedSpace = "shared space %d is not resolved, set space, spaceRef, spaceSelector, or spaceName and orgName"
+
 // Route is the interface that defines the methods that a Route client should implement.
 type Route interface {
 	Get(ctx context.Context, guid string) (*resource.Route, error)
@@ -23,6 +26,10 @@ type Route interface {
 	Create(ctx context.Context, r *resource.RouteCreate) (*resource.Route, error)
 	Update(ctx context.Context, guid string, r *resource.RouteUpdate) (*resource.Route, error)
 	Delete(ctx context.Context, guid string) (string, error)
+	GetSharedSpacesRelationships(ctx context.Context, guid string) (*resource.RouteSharedSpaceRelationships, error)
+	ShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) (*resource.RouteSharedSpaceRelationships, error)
+	UnShareWithSpaces(ctx context.Context, guid string, spaceGUIDs []string) error
+	TransferOwnership(ctx context.Context, guid string, spaceGUID string) error
 }
 
 type Client struct {
@@ -110,6 +117,110 @@ func (c *Client) Delete(ctx context.Context, guid string) (string, error) {
 	return jobGUID, nil
 }
 
+// AreSharedSpacesUpToDate checks if the shared spaces of a route are in sync with the CR
+func (c *Client) AreSharedSpacesUpToDate(ctx context.Context, guid string, desired []v1alpha1.SpaceReference) (bool, error) {
+	desiredGUIDs, err := getDesiredSharedSpaces(desired)
+	if err != nil {
+		return false, err
+	}
+	currentGUIDs, err := c.getCurrentSharedSpaces(ctx, guid)
+	if err != nil {
+		return false, err
+	}
+
+	toAdd, toRemove := diffSharedSpaces(currentGUIDs, desiredGUIDs)
+	return len(toAdd) == 0 && len(toRemove) == 0, nil
+}
+
+// UpdateSharedSpaces shares the route with the desired spaces and unshares it
+// from the spaces not desired. Unsharing a route unmaps the apps of the
+// unshared space from it.
+func (c *Client) UpdateSharedSpaces(ctx context.Context, guid string, desired []v1alpha1.SpaceReference) error {
+	desiredGUIDs, err := getDesiredSharedSpaces(desired)
+	if err != nil {
+		return err
+	}
+	currentGUIDs, err := c.getCurrentSharedSpaces(ctx, guid)
+	if err != nil {
+		return err
+	}
+
+	toAdd, toRemove := diffSharedSpaces(currentGUIDs, desiredGUIDs)
+	if len(toAdd) > 0 {
+		if _, err := c.ShareWithSpaces(ctx, guid, toAdd); err != nil {
+			return errors.Wrap(err, "cannot share route with spaces")
+		}
+	}
+	if len(toRemove) > 0 {
+		if err := c.UnShareWithSpaces(ctx, guid, toRemove); err != nil {
+			return errors.Wrap(err, "cannot unshare route from spaces")
+		}
+	}
+
+	return nil
+}
+
+// getCurrentSharedSpaces retrieves the GUIDs of spaces a route is shared with
+func (c *Client) getCurrentSharedSpaces(ctx context.Context, guid string) ([]string, error) {
+	relationships, err := c.GetSharedSpacesRelationships(ctx, guid)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get shared space relationships")
+	}
+	if relationships == nil {
+		return []string{}, nil
+	}
+
+	spaceGUIDs := make([]string, 0, len(relationships.Data))
+	for _, rel := range relationships.Data {
+		spaceGUIDs = append(spaceGUIDs, rel.GUID)
+	}
+	return spaceGUIDs, nil
+}
+
+// getDesiredSharedSpaces extracts space GUIDs from a list of SpaceReference.
+// An unresolved reference is an error, as dropping it would unshare the route
+// from that space.
+func getDesiredSharedSpaces(refs []v1alpha1.SpaceReference) ([]string, error) {
+	guids := make([]string, 0, len(refs))
+	for i, ref := range refs {
+		if ref.Space == nil || *ref.Space == "" {
+			return nil, errors.Errorf(errUnresolvedSpace, i)
+		}
+		guids = append(guids, *ref.Space)
+	}
+	return guids, nil
+}
+
+// diffSharedSpaces returns the spaces to share the route with and to unshare
+// it from to get from the current to the desired shared spaces.
+func diffSharedSpaces(current, desired []string) (toAdd, toRemove []string) {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, guid := range current {
+		currentSet[guid] = struct{}{}
+	}
+
+	for _, guid := range desired {
+		if _, exists := currentSet[guid]; !exists {
+			toAdd = append(toAdd, guid)
+		}
+		delete(currentSet, guid)
+	}
+
+	for guid := range currentSet {
+		toRemove = append(toRemove, guid)
+	}
+	return toAdd, toRemove
+}
+
+// IsOwnedBySpace checks whether the route is owned by the space of the
+// parameters. A route of another space is transferred to it on update.
+func IsOwnedBySpace(forProvider v1alpha1.RouteParameters, atProvider v1alpha1.RouteObservation) bool {
+	if forProvider.Space == nil || atProvider.Space == nil {
+		return true
+	}
+	return *forProvider.Space == *atProvider.Space
+}
+
 // FormatListOption generates the list options for the client.
 func FormatListOption(forProvider v1alpha1.RouteParameters) (*client.RouteListOptions, error) {
 
@@ -181,6 +292,10 @@ func GenerateObservation(o *resource.Route) v1alpha1.RouteObservation {
 	obs.Path = strToPtr(o.Path)
 	obs.Protocol = strToPtr(o.Protocol)
 
+	if o.Relationships.Space.Data != nil {
+		obs.Space = strToPtr(o.Relationships.Space.Data.GUID)
+	}
+
 	if o.Destinations != nil {
 		obs.Destinations = make([]v1alpha1.RouteDestination, 0, len(o.Destinations))
 		for _, d := range o.Destinations {
@@ -218,7 +333,10 @@ func GenerateObservation(o *resource.Route) v1alpha1.RouteObservation {
 // IsUpToDate checks whether current state is up-to-date compared to the given
 // set of parameters.
 func IsUpToDate(mg xpresource.Managed, forProvider v1alpha1.RouteParameters, atProvider v1alpha1.RouteObservation) bool {
-	// Routes are mostly immutable, except for metadata
+	// Routes are mostly immutable, except for metadata and the owning space
+	if !IsOwnedBySpace(forProvider, atProvider) {
+		return false
+	}
 	desired := metadata.BuildMetadata(mg, forProvider.Labels, forProvider.Annotations)
 	return metadata.IsMetadataUpToDate(desired.Labels, desired.Annotations, atProvider.Labels, atProvider.Annotations)
 }
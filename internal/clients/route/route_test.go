@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	pkgerrors "github.com/pkg/errors"
 	"k8s.io/utils/ptr"
 
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
@@ -21,6 +24,8 @@ var (
 	domainGUID = "22fd5b0b-4f3b-4b1b-8b3d-3b5f7b4b3b4b"
 	timezero   = "0001-01-01T00:00:00Z"
 
+	otherSpaceGUID = "44fd5b0b-4f3b-4b1b-8b3d-3b5f7b4b3b4b"
+
 	fakeForProvider = v1alpha1.RouteParameters{
 		SpaceReference:  v1alpha1.SpaceReference{Space: &spaceGUID},
 		DomainReference: v1alpha1.DomainReference{Domain: &domainGUID},
@@ -505,6 +510,16 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"Owned by the space": {
+			forProvider: fakeForProvider,
+			atProvider:  v1alpha1.RouteObservation{Space: ptr.To(spaceGUID)},
+			want:        true,
+		},
+		"Owned by another space": {
+			forProvider: fakeForProvider,
+			atProvider:  v1alpha1.RouteObservation{Space: ptr.To(otherSpaceGUID)},
+			want:        false,
+		},
 	}
 
 	for n, tc := range cases {
@@ -516,3 +531,134 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestAreSharedSpacesUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired []v1alpha1.SpaceReference
+		current *resource.RouteSharedSpaceRelationships
+		err     error
+		want    bool
+		wantErr error
+	}{
+		"UpToDate": {
+			desired: []v1alpha1.SpaceReference{{Space: ptr.To(spaceGUID)}},
+			current: &resource.RouteSharedSpaceRelationships{Data: []resource.Relationship{{GUID: spaceGUID}}},
+			want:    true,
+		},
+		"Add": {
+			desired: []v1alpha1.SpaceReference{{Space: ptr.To(spaceGUID)}, {Space: ptr.To(otherSpaceGUID)}},
+			current: &resource.RouteSharedSpaceRelationships{Data: []resource.Relationship{{GUID: spaceGUID}}},
+			want:    false,
+		},
+		"Remove": {
+			desired: []v1alpha1.SpaceReference{},
+			current: &resource.RouteSharedSpaceRelationships{Data: []resource.Relationship{{GUID: spaceGUID}}},
+			want:    false,
+		},
+		"Unresolved": {
+			desired: []v1alpha1.SpaceReference{{Space: ptr.To(spaceGUID)}, {SpaceName: ptr.To("my-space")}},
+			want:    false,
+			wantErr: pkgerrors.Errorf(errUnresolvedSpace, 1),
+		},
+		"Error": {
+			desired: []v1alpha1.SpaceReference{},
+			err:     errBoom,
+			want:    false,
+			wantErr: pkgerrors.Wrap(errBoom, "cannot get shared space relationships"),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := &fake.MockRoute{}
+			if tc.current != nil {
+				m.On("GetSharedSpacesRelationships", guid).Return(tc.current, tc.err)
+			} else if tc.err != nil {
+				m.On("GetSharedSpacesRelationships", guid).Return(nil, tc.err)
+			}
+			c := &Client{Route: m}
+
+			got, err := c.AreSharedSpacesUpToDate(context.Background(), guid, tc.desired)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("AreSharedSpacesUpToDate(...): -want error, +got error:\n%s", diff)
+			}
+			if got != tc.want {
+				t.Errorf("AreSharedSpacesUpToDate(...): want %v, got %v", tc.want, got)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateSharedSpaces(t *testing.T) {
+	cases := map[string]struct {
+		desired   []v1alpha1.SpaceReference
+		mockSetup func(*fake.MockRoute)
+		wantErr   error
+	}{
+		"InSync": {
+			desired: []v1alpha1.SpaceReference{{Space: ptr.To(spaceGUID)}},
+			mockSetup: func(m *fake.MockRoute) {
+				m.On("GetSharedSpacesRelationships", guid).Return(
+					&resource.RouteSharedSpaceRelationships{Data: []resource.Relationship{{GUID: spaceGUID}}}, nil,
+				)
+			},
+		},
+		"ShareAndUnshare": {
+			desired: []v1alpha1.SpaceReference{{Space: ptr.To(otherSpaceGUID)}},
+			mockSetup: func(m *fake.MockRoute) {
+				m.On("GetSharedSpacesRelationships", guid).Return(
+					&resource.RouteSharedSpaceRelationships{Data: []resource.Relationship{{GUID: spaceGUID}}}, nil,
+				)
+				m.On("ShareWithSpaces", guid, []string{otherSpaceGUID}).Return(&resource.RouteSharedSpaceRelationships{}, nil)
+				m.On("UnShareWithSpaces", guid, []string{spaceGUID}).Return(nil)
+			},
+		},
+		"UnshareAll": {
+			desired: []v1alpha1.SpaceReference{},
+			mockSetup: func(m *fake.MockRoute) {
+				m.On("GetSharedSpacesRelationships", guid).Return(
+					&resource.RouteSharedSpaceRelationships{Data: []resource.Relationship{{GUID: spaceGUID}}}, nil,
+				)
+				m.On("UnShareWithSpaces", guid, []string{spaceGUID}).Return(nil)
+			},
+		},
+		"Unresolved": {
+			desired:   []v1alpha1.SpaceReference{{SpaceName: ptr.To("my-space"), OrgName: ptr.To("my-org")}},
+			mockSetup: func(m *fake.MockRoute) {},
+			wantErr:   pkgerrors.Errorf(errUnresolvedSpace, 0),
+		},
+		"ShareError": {
+			desired: []v1alpha1.SpaceReference{{Space: ptr.To(otherSpaceGUID)}},
+			mockSetup: func(m *fake.MockRoute) {
+				m.On("GetSharedSpacesRelationships", guid).Return(&resource.RouteSharedSpaceRelationships{}, nil)
+				m.On("ShareWithSpaces", guid, []string{otherSpaceGUID}).Return(nil, errBoom)
+			},
+			wantErr: pkgerrors.Wrap(errBoom, "cannot share route with spaces"),
+		},
+		"UnshareError": {
+			desired: []v1alpha1.SpaceReference{},
+			mockSetup: func(m *fake.MockRoute) {
+				m.On("GetSharedSpacesRelationships", guid).Return(
+					&resource.RouteSharedSpaceRelationships{Data: []resource.Relationship{{GUID: spaceGUID}}}, nil,
+				)
+				m.On("UnShareWithSpaces", guid, []string{spaceGUID}).Return(errBoom)
+			},
+			wantErr: pkgerrors.Wrap(errBoom, "cannot unshare route from spaces"),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := &fake.MockRoute{}
+			tc.mockSetup(m)
+			c := &Client{Route: m}
+
+			err := c.UpdateSharedSpaces(context.Background(), guid, tc.desired)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("UpdateSharedSpaces(...): -want error, +got error:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
@@ -15,6 +17,31 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 )
 
+const errForeignSecret = "secret %q must be in the namespace %q of the managed resource"
+
+// ExtractManagedSecret extracts parameters/credentials from a secret reference
+// of the managed resource. A namespaced managed resource can only reference
+// Secrets in its own namespace.
+func ExtractManagedSecret(ctx context.Context, kube k8s.Client, mg resource.Managed, sr *xpv1.SecretReference, key string) ([]byte, error) {
+	if ns := mg.GetNamespace(); sr != nil && ns != "" && sr.Namespace != ns {
+		return nil, errors.Errorf(errForeignSecret, sr.Namespace+"/"+sr.Name, ns)
+	}
+	return ExtractSecret(ctx, kube, sr, key)
+}
+
+// GetManagedSecret returns the Secret referenced by the managed resource. A
+// namespaced managed resource can only reference Secrets in its own namespace.
+func GetManagedSecret(ctx context.Context, kube k8s.Client, mg resource.Managed, sr xpv1.SecretReference) (*v1.Secret, error) {
+	if ns := mg.GetNamespace(); ns != "" && sr.Namespace != ns {
+		return nil, errors.Errorf(errForeignSecret, sr.Namespace+"/"+sr.Name, ns)
+	}
+	secret := &v1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: sr.Namespace, Name: sr.Name}, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
 // ExtractSecret extracts parameters/credentials from a secret reference.
 // If a key is specified, returns the raw value for that key.
 // If no key is specified, returns all secret data as nested JSON/YAML.
@@ -0,0 +1,277 @@
+package securitygroup
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
+)
+
+const (
+	relationshipRunningSpaces = "running_spaces"
+	relationshipStagingSpaces = "staging_spaces"
+)
+
+// Client is the interface that defines the methods that a SecurityGroup
+// client should implement.
+type Client interface {
+	Get(ctx context.Context, guid string) (*resource.SecurityGroup, error)
+	Single(ctx context.Context, opts *client.SecurityGroupListOptions) (*resource.SecurityGroup, error)
+	Create(ctx context.Context, r *resource.SecurityGroupCreate) (*resource.SecurityGroup, error)
+	Update(ctx context.Context, guid string, r *resource.SecurityGroupUpdate) (*resource.SecurityGroup, error)
+	Delete(ctx context.Context, guid string) (string, error)
+	BindRunningSecurityGroup(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error)
+	BindStagingSecurityGroup(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error)
+	UnBindRunningSecurityGroup(ctx context.Context, guid string, spaceGUID string) error
+	UnBindStagingSecurityGroup(ctx context.Context, guid string, spaceGUID string) error
+	// ClearRules removes all rules of the security group.
+	ClearRules(ctx context.Context, guid string) error
+}
+
+// securityGroupClient adds ClearRules to the SecurityGroup client of the CF
+// client, which omits an empty rules list from an update.
+type securityGroupClient struct {
+	*client.SecurityGroupClient
+	cf *client.Client
+}
+
+// NewClient creates a new SecurityGroup client and a Job client to poll the
+// asynchronous deletion.
+func NewClient(cf *client.Client) (Client, job.Job) {
+	return &securityGroupClient{SecurityGroupClient: cf.SecurityGroups, cf: cf}, cf.Jobs
+}
+
+// ClearRules removes all rules of the security group by sending an explicitly
+// empty rules list.
+func (c *securityGroupClient) ClearRules(ctx context.Context, guid string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.cf.ApiURL("/v3/security_groups/"+guid), bytes.NewReader([]byte(`{"rules":[]}`)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.cf.ExecuteAuthRequest(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// FindBySpec looks up a security group by name when external-name is empty.
+func FindBySpec(ctx context.Context, c Client, spec v1alpha1.SecurityGroupParameters) (*resource.SecurityGroup, error) {
+	opts := client.NewSecurityGroupListOptions()
+	opts.Names.EqualTo(spec.Name)
+	return c.Single(ctx, opts)
+}
+
+// GenerateCreate generates the SecurityGroupCreate from an *SecurityGroupParameters.
+// The space bindings are passed as relationships of the new security group.
+func GenerateCreate(spec v1alpha1.SecurityGroupParameters) *resource.SecurityGroupCreate {
+	create := &resource.SecurityGroupCreate{
+		Name:            spec.Name,
+		GloballyEnabled: generateGloballyEnabled(spec.GloballyEnabled),
+		Rules:           generateRules(spec.Rules),
+	}
+	relationships := map[string]resource.ToManyRelationships{}
+	if spaces := SpaceGUIDs(spec.RunningSpaces); len(spaces) > 0 {
+		relationships[relationshipRunningSpaces] = *resource.NewToManyRelationships(spaces)
+	}
+	if spaces := SpaceGUIDs(spec.StagingSpaces); len(spaces) > 0 {
+		relationships[relationshipStagingSpaces] = *resource.NewToManyRelationships(spaces)
+	}
+	if len(relationships) > 0 {
+		create.Relationships = relationships
+	}
+	return create
+}
+
+// GenerateUpdate generates the SecurityGroupUpdate from an *SecurityGroupParameters.
+// Note that go-cfclient omits an empty rules list, so the last rule of a
+// security group is removed with ClearRules.
+func GenerateUpdate(spec v1alpha1.SecurityGroupParameters) *resource.SecurityGroupUpdate {
+	return &resource.SecurityGroupUpdate{
+		Name:            spec.Name,
+		GloballyEnabled: generateGloballyEnabled(spec.GloballyEnabled),
+		Rules:           generateRules(spec.Rules),
+	}
+}
+
+func generateGloballyEnabled(g v1alpha1.SecurityGroupGloballyEnabled) *resource.SecurityGroupGloballyEnabled {
+	return &resource.SecurityGroupGloballyEnabled{
+		Running: ptr.To(g.Running),
+		Staging: ptr.To(g.Staging),
+	}
+}
+
+func generateRules(rules []v1alpha1.SecurityGroupRule) []*resource.SecurityGroupRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	out := make([]*resource.SecurityGroupRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, &resource.SecurityGroupRule{
+			Protocol:    r.Protocol,
+			Destination: r.Destination,
+			Ports:       r.Ports,
+			Type:        r.Type,
+			Code:        r.Code,
+			Log:         r.Log,
+			Description: r.Description,
+		})
+	}
+	return out
+}
+
+// GenerateObservation takes a SecurityGroup resource and returns *SecurityGroupObservation.
+func GenerateObservation(o *resource.SecurityGroup) v1alpha1.SecurityGroupObservation {
+	obs := v1alpha1.SecurityGroupObservation{
+		ID:   ptr.To(o.GUID),
+		Name: ptr.To(o.Name),
+		GloballyEnabled: v1alpha1.SecurityGroupGloballyEnabled{
+			Running: ptr.Deref(o.GloballyEnabled.Running, false),
+			Staging: ptr.Deref(o.GloballyEnabled.Staging, false),
+		},
+		RunningSpaces: relationshipGUIDs(o.Relationships.RunningSpaces),
+		StagingSpaces: relationshipGUIDs(o.Relationships.StagingSpaces),
+		CreatedAt:     ptr.To(o.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:     ptr.To(o.UpdatedAt.Format(time.RFC3339)),
+	}
+	for _, r := range o.Rules {
+		obs.Rules = append(obs.Rules, v1alpha1.SecurityGroupRule{
+			Protocol:    r.Protocol,
+			Destination: r.Destination,
+			Ports:       r.Ports,
+			Type:        r.Type,
+			Code:        r.Code,
+			Log:         r.Log,
+			Description: r.Description,
+		})
+	}
+	return obs
+}
+
+func relationshipGUIDs(r resource.ToManyRelationships) []string {
+	var guids []string
+	for _, d := range r.Data {
+		guids = append(guids, d.GUID)
+	}
+	return guids
+}
+
+// IsUpToDate checks whether the observed security group, including its rules
+// and space bindings, matches the given set of parameters.
+func IsUpToDate(spec v1alpha1.SecurityGroupParameters, observed *resource.SecurityGroup) bool {
+	if observed == nil {
+		return false
+	}
+	obs := GenerateObservation(observed)
+	if spec.Name != observed.Name || spec.GloballyEnabled != obs.GloballyEnabled {
+		return false
+	}
+	if !AreRulesUpToDate(spec.Rules, obs.Rules) {
+		return false
+	}
+	if bind, unbind := Bindings(SpaceGUIDs(spec.RunningSpaces), obs.RunningSpaces); len(bind) > 0 || len(unbind) > 0 {
+		return false
+	}
+	bind, unbind := Bindings(SpaceGUIDs(spec.StagingSpaces), obs.StagingSpaces)
+	return len(bind) == 0 && len(unbind) == 0
+}
+
+// AreRulesUpToDate compares the desired and observed rules in order. Unset
+// optional fields are equal to their zero values, as CF may omit them.
+func AreRulesUpToDate(desired, observed []v1alpha1.SecurityGroupRule) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	for i := range desired {
+		d, o := desired[i], observed[i]
+		if d.Protocol != o.Protocol || d.Destination != o.Destination {
+			return false
+		}
+		if ptr.Deref(d.Ports, "") != ptr.Deref(o.Ports, "") ||
+			ptr.Deref(d.Description, "") != ptr.Deref(o.Description, "") ||
+			ptr.Deref(d.Log, false) != ptr.Deref(o.Log, false) {
+			return false
+		}
+		if !ptr.Equal(d.Type, o.Type) || !ptr.Equal(d.Code, o.Code) {
+			return false
+		}
+	}
+	return true
+}
+
+// SpaceGUIDs extracts the resolved space GUIDs from a list of SpaceReference.
+func SpaceGUIDs(refs []v1alpha1.SpaceReference) []string {
+	guids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Space != nil && *ref.Space != "" {
+			guids = append(guids, *ref.Space)
+		}
+	}
+	return guids
+}
+
+// Bindings compares the desired spaces with the bound ones and returns the
+// spaces to bind and the spaces to unbind.
+func Bindings(desired, bound []string) (bind, unbind []string) {
+	want := map[string]bool{}
+	for _, s := range desired {
+		want[s] = true
+	}
+	have := map[string]bool{}
+	for _, s := range bound {
+		have[s] = true
+		if !want[s] {
+			unbind = append(unbind, s)
+		}
+	}
+	for _, s := range desired {
+		if !have[s] {
+			bind = append(bind, s)
+			have[s] = true
+		}
+	}
+	return bind, unbind
+}
+
+// NeedsClearRules returns true if the rules of the security group must be
+// removed with ClearRules, as the update omits an empty rules list.
+func NeedsClearRules(spec v1alpha1.SecurityGroupParameters, observed v1alpha1.SecurityGroupObservation) bool {
+	return len(spec.Rules) == 0 && len(observed.Rules) > 0
+}
+
+// UpdateBindings binds the security group to the desired running and staging
+// spaces and unbinds it from all other spaces.
+func UpdateBindings(ctx context.Context, c Client, guid string, spec v1alpha1.SecurityGroupParameters, observed v1alpha1.SecurityGroupObservation) error {
+	bind, unbind := Bindings(SpaceGUIDs(spec.RunningSpaces), observed.RunningSpaces)
+	if len(bind) > 0 {
+		if _, err := c.BindRunningSecurityGroup(ctx, guid, bind); err != nil {
+			return err
+		}
+	}
+	for _, s := range unbind {
+		if err := c.UnBindRunningSecurityGroup(ctx, guid, s); err != nil {
+			return err
+		}
+	}
+
+	bind, unbind = Bindings(SpaceGUIDs(spec.StagingSpaces), observed.StagingSpaces)
+	if len(bind) > 0 {
+		if _, err := c.BindStagingSecurityGroup(ctx, guid, bind); err != nil {
+			return err
+		}
+	}
+	for _, s := range unbind {
+		if err := c.UnBindStagingSecurityGroup(ctx, guid, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package securitygroup
+
+import (
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+func TestGenerateCreate(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.SecurityGroupParameters
+		want *resource.SecurityGroupCreate
+	}{
+		"NameOnly": {
+			spec: v1alpha1.SecurityGroupParameters{Name: "my-asg"},
+			want: &resource.SecurityGroupCreate{
+				Name:            "my-asg",
+				GloballyEnabled: &resource.SecurityGroupGloballyEnabled{Running: ptr.To(false), Staging: ptr.To(false)},
+			},
+		},
+		"RulesAndSpaces": {
+			spec: v1alpha1.SecurityGroupParameters{
+				Name:            "my-asg",
+				Rules:           []v1alpha1.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: ptr.To("443")}},
+				GloballyEnabled: v1alpha1.SecurityGroupGloballyEnabled{Staging: true},
+				RunningSpaces:   []v1alpha1.SpaceReference{{Space: ptr.To("space-1")}, {SpaceName: ptr.To("unresolved")}},
+				StagingSpaces:   []v1alpha1.SpaceReference{{Space: ptr.To("space-2")}},
+			},
+			want: &resource.SecurityGroupCreate{
+				Name:            "my-asg",
+				GloballyEnabled: &resource.SecurityGroupGloballyEnabled{Running: ptr.To(false), Staging: ptr.To(true)},
+				Rules:           []*resource.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: ptr.To("443")}},
+				Relationships: map[string]resource.ToManyRelationships{
+					"running_spaces": *resource.NewToManyRelationships([]string{"space-1"}),
+					"staging_spaces": *resource.NewToManyRelationships([]string{"space-2"}),
+				},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateCreate(tc.spec)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateCreate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	rule := v1alpha1.SecurityGroupRule{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: ptr.To("443")}
+	observed := func(m ...func(*resource.SecurityGroup)) *resource.SecurityGroup {
+		sg := &resource.SecurityGroup{
+			Name:  "my-asg",
+			Rules: []resource.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: ptr.To("443"), Log: ptr.To(false)}},
+			Relationships: resource.SecurityGroupsRelationships{
+				RunningSpaces: *resource.NewToManyRelationships([]string{"space-1"}),
+			},
+		}
+		for _, f := range m {
+			f(sg)
+		}
+		return sg
+	}
+	spec := func(m ...func(*v1alpha1.SecurityGroupParameters)) v1alpha1.SecurityGroupParameters {
+		p := v1alpha1.SecurityGroupParameters{
+			Name:          "my-asg",
+			Rules:         []v1alpha1.SecurityGroupRule{rule},
+			RunningSpaces: []v1alpha1.SpaceReference{{Space: ptr.To("space-1")}},
+		}
+		for _, f := range m {
+			f(&p)
+		}
+		return p
+	}
+
+	cases := map[string]struct {
+		spec     v1alpha1.SecurityGroupParameters
+		observed *resource.SecurityGroup
+		want     bool
+	}{
+		"UpToDate": {
+			spec:     spec(),
+			observed: observed(),
+			want:     true,
+		},
+		"NilObserved": {
+			spec: spec(),
+			want: false,
+		},
+		"NameDrift": {
+			spec:     spec(func(p *v1alpha1.SecurityGroupParameters) { p.Name = "other" }),
+			observed: observed(),
+			want:     false,
+		},
+		"GloballyEnabledDrift": {
+			spec:     spec(func(p *v1alpha1.SecurityGroupParameters) { p.GloballyEnabled.Running = true }),
+			observed: observed(),
+			want:     false,
+		},
+		"RuleDrift": {
+			spec: spec(),
+			observed: observed(func(sg *resource.SecurityGroup) {
+				sg.Rules[0].Ports = ptr.To("80")
+			}),
+			want: false,
+		},
+		"RuleAdded": {
+			spec: spec(func(p *v1alpha1.SecurityGroupParameters) {
+				p.Rules = append(p.Rules, v1alpha1.SecurityGroupRule{Protocol: "icmp", Destination: "0.0.0.0/0", Type: ptr.To(0), Code: ptr.To(0)})
+			}),
+			observed: observed(),
+			want:     false,
+		},
+		"LastRuleRemoved": {
+			spec:     spec(func(p *v1alpha1.SecurityGroupParameters) { p.Rules = nil }),
+			observed: observed(),
+			want:     false,
+		},
+		"RunningSpaceDrift": {
+			spec: spec(),
+			observed: observed(func(sg *resource.SecurityGroup) {
+				sg.Relationships.RunningSpaces = *resource.NewToManyRelationships([]string{"space-2"})
+			}),
+			want: false,
+		},
+		"StagingSpaceDrift": {
+			spec: spec(),
+			observed: observed(func(sg *resource.SecurityGroup) {
+				sg.Relationships.StagingSpaces = *resource.NewToManyRelationships([]string{"space-2"})
+			}),
+			want: false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if got := IsUpToDate(tc.spec, tc.observed); got != tc.want {
+				t.Errorf("IsUpToDate(...): want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNeedsClearRules(t *testing.T) {
+	rule := v1alpha1.SecurityGroupRule{Protocol: "tcp", Destination: "10.0.0.0/24"}
+
+	cases := map[string]struct {
+		spec     []v1alpha1.SecurityGroupRule
+		observed []v1alpha1.SecurityGroupRule
+		want     bool
+	}{
+		"LastRuleRemoved": {
+			observed: []v1alpha1.SecurityGroupRule{rule},
+			want:     true,
+		},
+		"RulesChanged": {
+			spec:     []v1alpha1.SecurityGroupRule{rule},
+			observed: []v1alpha1.SecurityGroupRule{rule, rule},
+			want:     false,
+		},
+		"NoRules": {
+			want: false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := NeedsClearRules(v1alpha1.SecurityGroupParameters{Rules: tc.spec}, v1alpha1.SecurityGroupObservation{Rules: tc.observed})
+			if got != tc.want {
+				t.Errorf("NeedsClearRules(...): want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBindings(t *testing.T) {
+	type want struct {
+		bind   []string
+		unbind []string
+	}
+
+	cases := map[string]struct {
+		desired []string
+		bound   []string
+		want    want
+	}{
+		"None": {},
+		"Bind": {
+			desired: []string{"space-1", "space-2"},
+			want:    want{bind: []string{"space-1", "space-2"}},
+		},
+		"Unbind": {
+			bound: []string{"space-1"},
+			want:  want{unbind: []string{"space-1"}},
+		},
+		"BindAndUnbind": {
+			desired: []string{"space-1", "space-3", "space-3"},
+			bound:   []string{"space-1", "space-2"},
+			want:    want{bind: []string{"space-3"}, unbind: []string{"space-2"}},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			bind, unbind := Bindings(tc.desired, tc.bound)
+			if diff := cmp.Diff(tc.want, want{bind: bind, unbind: unbind}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("Bindings(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
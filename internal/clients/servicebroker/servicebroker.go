@@ -0,0 +1,168 @@
+package servicebroker
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpresource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+// Client is the interface that defines the methods that a ServiceBroker
+// client should implement.
+type Client interface {
+	Get(ctx context.Context, guid string) (*resource.ServiceBroker, error)
+	Single(ctx context.Context, opts *client.ServiceBrokerListOptions) (*resource.ServiceBroker, error)
+	Create(ctx context.Context, r *resource.ServiceBrokerCreate) (string, error)
+	Update(ctx context.Context, guid string, r *resource.ServiceBrokerUpdate) (string, *resource.ServiceBroker, error)
+	Delete(ctx context.Context, guid string) (string, error)
+}
+
+// Offerings is the interface to list the service offerings of a catalog.
+type Offerings interface {
+	List(ctx context.Context, opts *client.ServiceOfferingListOptions) ([]*resource.ServiceOffering, *client.Pager, error)
+}
+
+// Plans is the interface to list the service plans of a catalog.
+type Plans interface {
+	List(ctx context.Context, opts *client.ServicePlanListOptions) ([]*resource.ServicePlan, *client.Pager, error)
+}
+
+// Credentials are the basic authentication credentials of a service broker.
+type Credentials struct {
+	Username string
+	Password string
+
+	// Version identifies the revision of the Secret the credentials are read
+	// from.
+	Version string
+}
+
+// NewClient creates a new ServiceBroker client, the clients to count the
+// service offerings and plans of its catalog, and a Job client to track the
+// asynchronous catalog synchronization.
+func NewClient(cf *client.Client) (Client, Offerings, Plans, job.Tracker) {
+	return cf.ServiceBrokers, cf.ServiceOfferings, cf.ServicePlans, cf.Jobs
+}
+
+// FindBySpec looks up a service broker by name and space when external-name
+// is empty.
+func FindBySpec(ctx context.Context, c Client, spec v1alpha1.ServiceBrokerParameters) (*resource.ServiceBroker, error) {
+	opts := client.NewServiceBrokerListOptions()
+	opts.Names.EqualTo(spec.Name)
+	if spec.Space != nil && *spec.Space != "" {
+		opts.SpaceGUIDs.EqualTo(*spec.Space)
+	}
+	return c.Single(ctx, opts)
+}
+
+// GenerateCreate generates the ServiceBrokerCreate from an *ServiceBrokerParameters.
+func GenerateCreate(mg xpresource.Managed, spec v1alpha1.ServiceBrokerParameters, creds Credentials) *resource.ServiceBrokerCreate {
+	create := resource.NewServiceBrokerCreate(spec.Name, spec.URL, creds.Username, creds.Password)
+	if spec.Space != nil && *spec.Space != "" {
+		create.WithSpace(*spec.Space)
+	}
+	create.Metadata = metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	return create
+}
+
+// GenerateUpdate generates the ServiceBrokerUpdate from an *ServiceBrokerParameters.
+// The credentials are always sent, so that an update synchronizes the catalog
+// with the current credentials of the Secret.
+func GenerateUpdate(mg xpresource.Managed, spec v1alpha1.ServiceBrokerParameters, creds Credentials) *resource.ServiceBrokerUpdate {
+	update := resource.NewServiceBrokerUpdate().
+		WithName(spec.Name).
+		WithURL(spec.URL).
+		WithCredentials(creds.Username, creds.Password)
+	update.Metadata = metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	return update
+}
+
+// GenerateObservation takes a ServiceBroker resource and returns *ServiceBrokerObservation.
+func GenerateObservation(o *resource.ServiceBroker) v1alpha1.ServiceBrokerObservation {
+	obs := v1alpha1.ServiceBrokerObservation{
+		ID:        ptr.To(o.GUID),
+		Name:      ptr.To(o.Name),
+		URL:       ptr.To(o.URL),
+		CreatedAt: ptr.To(o.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt: ptr.To(o.UpdatedAt.Format(time.RFC3339)),
+	}
+	if o.Relationships.Space.Data != nil && o.Relationships.Space.Data.GUID != "" {
+		obs.Space = ptr.To(o.Relationships.Space.Data.GUID)
+	}
+	if o.Metadata != nil {
+		obs.Labels = o.Metadata.Labels
+		obs.Annotations = o.Metadata.Annotations
+	}
+	return obs
+}
+
+// GenerateJob takes a Job resource and returns *ServiceBrokerJob.
+func GenerateJob(j *resource.Job) *v1alpha1.ServiceBrokerJob {
+	errs := make([]string, 0, len(j.Errors))
+	for _, e := range j.Errors {
+		errs = append(errs, e.Detail)
+	}
+	return &v1alpha1.ServiceBrokerJob{
+		GUID:      j.GUID,
+		Operation: j.Operation,
+		State:     string(j.State),
+		Errors:    strings.Join(errs, "; "),
+	}
+}
+
+// IsUpToDate checks whether the observed service broker matches the given
+// set of parameters. The credentials cannot be observed and the space scope
+// cannot be changed, so neither is compared; compare the version of the
+// credentials with the one in the status to detect rotated credentials.
+func IsUpToDate(mg xpresource.Managed, spec v1alpha1.ServiceBrokerParameters, observed *resource.ServiceBroker) bool {
+	if observed == nil {
+		return false
+	}
+	if spec.Name != observed.Name || spec.URL != observed.URL {
+		return false
+	}
+	desired := metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	var observedLabels, observedAnnotations map[string]*string
+	if observed.Metadata != nil {
+		observedLabels = observed.Metadata.Labels
+		observedAnnotations = observed.Metadata.Annotations
+	}
+	return metadata.IsMetadataUpToDate(desired.Labels, desired.Annotations, observedLabels, observedAnnotations)
+}
+
+// CountCatalog returns the number of service offerings and service plans in
+// the synchronized catalog of the service broker.
+func CountCatalog(ctx context.Context, offerings Offerings, plans Plans, guid string) (int, int, error) {
+	oOpts := client.NewServiceOfferingListOptions()
+	oOpts.ServiceBrokerGUIDs.EqualTo(guid)
+	oOpts.PerPage = 1
+	_, oPager, err := offerings.List(ctx, oOpts)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pOpts := client.NewServicePlanListOptions()
+	pOpts.ServiceBrokerGUIDs.EqualTo(guid)
+	pOpts.PerPage = 1
+	_, pPager, err := plans.List(ctx, pOpts)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return totalResults(oPager), totalResults(pPager), nil
+}
+
+func totalResults(p *client.Pager) int {
+	if p == nil {
+		return 0
+	}
+	return p.TotalResults
+}
@@ -0,0 +1,128 @@
+package servicebroker
+
+import (
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+func TestGenerateCreate(t *testing.T) {
+	creds := Credentials{Username: "admin", Password: "secret"}
+	cases := map[string]struct {
+		spec v1alpha1.ServiceBrokerParameters
+		want *resource.ServiceBrokerCreate
+	}{
+		"Global": {
+			spec: v1alpha1.ServiceBrokerParameters{Name: "my-broker", URL: "https://broker.example.com"},
+			want: &resource.ServiceBrokerCreate{
+				Name:           "my-broker",
+				URL:            "https://broker.example.com",
+				Authentication: resource.ServiceBrokerCredentials{Type: "basic", Credentials: resource.ServiceBrokerBasicAuthCredentials{Username: "admin", Password: "secret"}},
+				Metadata:       resource.NewMetadata(),
+			},
+		},
+		"SpaceScoped": {
+			spec: v1alpha1.ServiceBrokerParameters{
+				Name:           "my-broker",
+				URL:            "https://broker.example.com",
+				SpaceReference: v1alpha1.SpaceReference{Space: ptr.To("space-guid")},
+			},
+			want: &resource.ServiceBrokerCreate{
+				Name:           "my-broker",
+				URL:            "https://broker.example.com",
+				Authentication: resource.ServiceBrokerCredentials{Type: "basic", Credentials: resource.ServiceBrokerBasicAuthCredentials{Username: "admin", Password: "secret"}},
+				Relationships:  &resource.SpaceRelationship{Space: resource.ToOneRelationship{Data: &resource.Relationship{GUID: "space-guid"}}},
+				Metadata:       resource.NewMetadata(),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateCreate(nil, tc.spec, creds)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateCreate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateJob(t *testing.T) {
+	cases := map[string]struct {
+		job  *resource.Job
+		want *v1alpha1.ServiceBrokerJob
+	}{
+		"Processing": {
+			job:  &resource.Job{Resource: resource.Resource{GUID: "job-guid"}, Operation: "service_broker.catalog.synchronize", State: resource.JobStateProcessing},
+			want: &v1alpha1.ServiceBrokerJob{GUID: "job-guid", Operation: "service_broker.catalog.synchronize", State: "PROCESSING"},
+		},
+		"Failed": {
+			job: &resource.Job{
+				Resource: resource.Resource{GUID: "job-guid"},
+				State:    resource.JobStateFailed,
+				Errors:   []resource.CloudFoundryError{{Detail: "catalog invalid"}, {Detail: "unreachable"}},
+			},
+			want: &v1alpha1.ServiceBrokerJob{GUID: "job-guid", State: "FAILED", Errors: "catalog invalid; unreachable"},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateJob(tc.job)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateJob(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	spec := v1alpha1.ServiceBrokerParameters{Name: "my-broker", URL: "https://broker.example.com"}
+	cases := map[string]struct {
+		spec     v1alpha1.ServiceBrokerParameters
+		observed *resource.ServiceBroker
+		want     bool
+	}{
+		"NotObserved": {
+			spec: spec,
+			want: false,
+		},
+		"UpToDate": {
+			spec:     spec,
+			observed: &resource.ServiceBroker{Name: "my-broker", URL: "https://broker.example.com"},
+			want:     true,
+		},
+		"NameChanged": {
+			spec:     spec,
+			observed: &resource.ServiceBroker{Name: "old-broker", URL: "https://broker.example.com"},
+			want:     false,
+		},
+		"URLChanged": {
+			spec:     spec,
+			observed: &resource.ServiceBroker{Name: "my-broker", URL: "https://old.example.com"},
+			want:     false,
+		},
+		"LabelMissing": {
+			spec: v1alpha1.ServiceBrokerParameters{
+				Name:             "my-broker",
+				URL:              "https://broker.example.com",
+				ResourceMetadata: v1alpha1.ResourceMetadata{Labels: map[string]*string{"team": ptr.To("a")}},
+			},
+			observed: &resource.ServiceBroker{Name: "my-broker", URL: "https://broker.example.com"},
+			want:     false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := IsUpToDate(nil, tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,42 +18,39 @@ const ForceRotationKey = "servicecredentialbinding.cloudfoundry.crossplane.io/fo
 type KeyRotator interface {
 	// RetireBinding checks if the binding should be retired based on the rotation frequency
 	// and the force rotation annotation. If it should be retired, it adds the retired key to the status.
-	RetireBinding(cr *v1alpha1.ServiceCredentialBinding, serviceBinding *cfresource.ServiceCredentialBinding) bool
+	RetireBinding(cr v1alpha1.ServiceCredentialBindingManaged, serviceBinding *cfresource.ServiceCredentialBinding) bool
 
 	// HasExpiredKeys checks if there are any retired keys that have expired based on the rotation TTL.
-	HasExpiredKeys(cr *v1alpha1.ServiceCredentialBinding) bool
+	HasExpiredKeys(cr v1alpha1.ServiceCredentialBindingManaged) bool
 
 	// DeleteExpiredKeys deletes the expired keys from the status and the external system.
 	// It returns the new list of retired keys and any error encountered during deletion.
-	DeleteExpiredKeys(ctx context.Context, cr *v1alpha1.ServiceCredentialBinding) ([]*v1alpha1.SCBResource, error)
+	DeleteExpiredKeys(ctx context.Context, cr v1alpha1.ServiceCredentialBindingManaged) ([]*v1alpha1.SCBResource, error)
 
 	// DeleteRetiredKeys deletes all retired keys from the external system.
-	DeleteRetiredKeys(ctx context.Context, cr *v1alpha1.ServiceCredentialBinding) error
+	DeleteRetiredKeys(ctx context.Context, cr v1alpha1.ServiceCredentialBindingManaged) error
 }
 
 type SCBKeyRotator struct {
 	SCBClient ServiceCredentialBinding
 }
 
-func (r *SCBKeyRotator) RetireBinding(cr *v1alpha1.ServiceCredentialBinding, serviceBinding *cfresource.ServiceCredentialBinding) bool {
-	forceRotation := false
-	if cr.Annotations != nil {
-		_, forceRotation = cr.Annotations[ForceRotationKey]
-	}
+func (r *SCBKeyRotator) RetireBinding(cr v1alpha1.ServiceCredentialBindingManaged, serviceBinding *cfresource.ServiceCredentialBinding) bool {
+	_, forceRotation := cr.GetAnnotations()[ForceRotationKey]
 
-	rotationDue := cr.Spec.ForProvider.Rotation != nil && cr.Spec.ForProvider.Rotation.Frequency != nil &&
-		(cr.Status.AtProvider.CreatedAt == nil ||
-			cr.Status.AtProvider.CreatedAt.Add(cr.Spec.ForProvider.Rotation.Frequency.Duration).Before(time.Now()))
+	rotationDue := cr.GetForProvider().Rotation != nil && cr.GetForProvider().Rotation.Frequency != nil &&
+		(cr.GetAtProvider().CreatedAt == nil ||
+			cr.GetAtProvider().CreatedAt.Add(cr.GetForProvider().Rotation.Frequency.Duration).Before(time.Now()))
 
 	if forceRotation || rotationDue {
 		// If the binding was created before the rotation frequency, retire it.
-		for _, retiredKey := range cr.Status.AtProvider.RetiredKeys {
+		for _, retiredKey := range cr.GetAtProvider().RetiredKeys {
 			if retiredKey.GUID == serviceBinding.GUID {
 				// If the binding is already retired, do not retire it again.
 				return true
 			}
 		}
-		cr.Status.AtProvider.RetiredKeys = append(cr.Status.AtProvider.RetiredKeys, &v1alpha1.SCBResource{
+		cr.GetAtProvider().RetiredKeys = append(cr.GetAtProvider().RetiredKeys, &v1alpha1.SCBResource{
 			GUID:      serviceBinding.GUID,
 			CreatedAt: &metav1.Time{Time: serviceBinding.CreatedAt},
 		})
@@ -63,14 +60,14 @@ func (r *SCBKeyRotator) RetireBinding(cr *v1alpha1.ServiceCredentialBinding, ser
 	return false
 }
 
-func (r *SCBKeyRotator) HasExpiredKeys(cr *v1alpha1.ServiceCredentialBinding) bool {
-	if cr.Status.AtProvider.RetiredKeys == nil || cr.Spec.ForProvider.Rotation == nil ||
-		cr.Spec.ForProvider.Rotation.TTL == nil {
+func (r *SCBKeyRotator) HasExpiredKeys(cr v1alpha1.ServiceCredentialBindingManaged) bool {
+	if cr.GetAtProvider().RetiredKeys == nil || cr.GetForProvider().Rotation == nil ||
+		cr.GetForProvider().Rotation.TTL == nil {
 		return false
 	}
 
-	for _, key := range cr.Status.AtProvider.RetiredKeys {
-		if key.CreatedAt.Add(cr.Spec.ForProvider.Rotation.TTL.Duration).Before(time.Now()) {
+	for _, key := range cr.GetAtProvider().RetiredKeys {
+		if key.CreatedAt.Add(cr.GetForProvider().Rotation.TTL.Duration).Before(time.Now()) {
 			return true
 		}
 	}
@@ -78,13 +75,13 @@ func (r *SCBKeyRotator) HasExpiredKeys(cr *v1alpha1.ServiceCredentialBinding) bo
 	return false
 }
 
-func (c *SCBKeyRotator) DeleteExpiredKeys(ctx context.Context, cr *v1alpha1.ServiceCredentialBinding) ([]*v1alpha1.SCBResource, error) {
+func (c *SCBKeyRotator) DeleteExpiredKeys(ctx context.Context, cr v1alpha1.ServiceCredentialBindingManaged) ([]*v1alpha1.SCBResource, error) {
 	var newRetiredKeys []*v1alpha1.SCBResource
 	var errs []error
 
-	for _, key := range cr.Status.AtProvider.RetiredKeys {
+	for _, key := range cr.GetAtProvider().RetiredKeys {
 
-		if key.CreatedAt.Add(cr.Spec.ForProvider.Rotation.TTL.Duration).After(time.Now()) ||
+		if key.CreatedAt.Add(cr.GetForProvider().Rotation.TTL.Duration).After(time.Now()) ||
 			key.GUID == meta.GetExternalName(cr) {
 			newRetiredKeys = append(newRetiredKeys, key)
 
@@ -101,8 +98,8 @@ func (c *SCBKeyRotator) DeleteExpiredKeys(ctx context.Context, cr *v1alpha1.Serv
 	return newRetiredKeys, errors.Join(errs...)
 }
 
-func (c *SCBKeyRotator) DeleteRetiredKeys(ctx context.Context, cr *v1alpha1.ServiceCredentialBinding) error {
-	for _, retiredKey := range cr.Status.AtProvider.RetiredKeys {
+func (c *SCBKeyRotator) DeleteRetiredKeys(ctx context.Context, cr v1alpha1.ServiceCredentialBindingManaged) error {
+	for _, retiredKey := range cr.GetAtProvider().RetiredKeys {
 		if err := Delete(ctx, c.SCBClient, retiredKey.GUID); err != nil &&
 			!cfresource.IsResourceNotFoundError(err) &&
 			!cfresource.IsServiceBindingNotFoundError(err) {
@@ -26,12 +26,12 @@ type ServicePlanResolver interface {
 
 // We either populate/update the service plan ID with the external resource GUID
 // based on the specified offering and plan or we use the provided ID directly.
-func (c *Client) ResolveServicePlan(ctx context.Context, kube k8s.Client, cr *v1alpha1.ServiceInstance) error {
-	if cr.Spec.ForProvider.ServicePlan.Offering != nil && cr.Spec.ForProvider.ServicePlan.Plan != nil {
+func (c *Client) ResolveServicePlan(ctx context.Context, kube k8s.Client, cr v1alpha1.ServiceInstanceManaged) error {
+	if cr.GetForProvider().ServicePlan.Offering != nil && cr.GetForProvider().ServicePlan.Plan != nil {
 		return c.resolvePlanID(ctx, kube, cr)
 	}
 
-	if cr.Spec.ForProvider.ServicePlan.ID != nil {
+	if cr.GetForProvider().ServicePlan.ID != nil {
 		return c.validatePlanID(ctx, cr)
 	}
 
@@ -39,25 +39,25 @@ func (c *Client) ResolveServicePlan(ctx context.Context, kube k8s.Client, cr *v1
 }
 
 // Populate/Update service plan ID based on offering and plan
-func (c *Client) resolvePlanID(ctx context.Context, kube k8s.Client, cr *v1alpha1.ServiceInstance) error {
+func (c *Client) resolvePlanID(ctx context.Context, kube k8s.Client, cr v1alpha1.ServiceInstanceManaged) error {
 	opt := client.NewServicePlanListOptions()
-	opt.ServiceOfferingNames.EqualTo(*cr.Spec.ForProvider.ServicePlan.Offering)
-	opt.Names.EqualTo(*cr.Spec.ForProvider.ServicePlan.Plan)
+	opt.ServiceOfferingNames.EqualTo(*cr.GetForProvider().ServicePlan.Offering)
+	opt.Names.EqualTo(*cr.GetForProvider().ServicePlan.Plan)
 
 	res, err := c.ServicePlanResolver.Single(ctx, opt)
 	if err != nil {
-		return errors.Wrapf(err, "cannot initialize service plan using serviceName/servicePlanName: %s:%s", *cr.Spec.ForProvider.ServicePlan.Offering, *cr.Spec.ForProvider.ServicePlan.Plan)
+		return errors.Wrapf(err, "cannot initialize service plan using serviceName/servicePlanName: %s:%s", *cr.GetForProvider().ServicePlan.Offering, *cr.GetForProvider().ServicePlan.Plan)
 	}
 
-	cr.Spec.ForProvider.ServicePlan.ID = &res.GUID
+	cr.GetForProvider().ServicePlan.ID = &res.GUID
 	return kube.Update(ctx, cr)
 }
 
 // Verify whether service plan ID is valid
-func (c *Client) validatePlanID(ctx context.Context, cr *v1alpha1.ServiceInstance) error {
-	_, err := c.ServicePlanResolver.Get(ctx, *cr.Spec.ForProvider.ServicePlan.ID)
+func (c *Client) validatePlanID(ctx context.Context, cr v1alpha1.ServiceInstanceManaged) error {
+	_, err := c.ServicePlanResolver.Get(ctx, *cr.GetForProvider().ServicePlan.ID)
 	if err != nil {
-		return errors.Wrapf(err, "cannot initialize service plan using ID: %s", *cr.Spec.ForProvider.ServicePlan.ID)
+		return errors.Wrapf(err, "cannot initialize service plan using ID: %s", *cr.GetForProvider().ServicePlan.ID)
 	}
 	return nil
 }
@@ -0,0 +1,60 @@
+package serviceoffering
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+// Client is the interface that defines the methods that a ServiceOffering
+// client should implement.
+type Client interface {
+	Get(ctx context.Context, guid string) (*resource.ServiceOffering, error)
+	Single(ctx context.Context, opts *client.ServiceOfferingListOptions) (*resource.ServiceOffering, error)
+}
+
+// NewClient creates a new ServiceOffering client
+func NewClient(cf *client.Client) Client {
+	return cf.ServiceOfferings
+}
+
+// FindBySpec looks up a service offering by name and, if given, the name of
+// its service broker when external-name is empty.
+func FindBySpec(ctx context.Context, c Client, spec v1alpha1.ServiceOfferingParameters) (*resource.ServiceOffering, error) {
+	opts := client.NewServiceOfferingListOptions()
+	opts.Names.EqualTo(spec.Name)
+	if spec.ServiceBrokerName != nil && *spec.ServiceBrokerName != "" {
+		opts.ServiceBrokerNames.EqualTo(*spec.ServiceBrokerName)
+	}
+	return c.Single(ctx, opts)
+}
+
+// GenerateObservation takes a ServiceOffering resource and returns *ServiceOfferingObservation.
+func GenerateObservation(o *resource.ServiceOffering) v1alpha1.ServiceOfferingObservation {
+	obs := v1alpha1.ServiceOfferingObservation{
+		ID:               ptr.To(o.GUID),
+		Name:             ptr.To(o.Name),
+		Description:      ptr.To(o.Description),
+		Available:        ptr.To(o.Available),
+		Tags:             o.Tags,
+		Requires:         o.Requires,
+		Shareable:        ptr.To(o.Shareable),
+		DocumentationURL: ptr.To(o.DocumentationURL),
+		BrokerCatalogID:  ptr.To(o.BrokerCatalog.ID),
+		CreatedAt:        ptr.To(o.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:        ptr.To(o.UpdatedAt.Format(time.RFC3339)),
+	}
+	if o.Relationships.ServiceBroker.Data != nil {
+		obs.ServiceBroker = ptr.To(o.Relationships.ServiceBroker.Data.GUID)
+	}
+	if o.Metadata != nil {
+		obs.Labels = o.Metadata.Labels
+		obs.Annotations = o.Metadata.Annotations
+	}
+	return obs
+}
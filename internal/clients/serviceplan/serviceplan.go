@@ -0,0 +1,96 @@
+package serviceplan
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+)
+
+const (
+	errMissingServicePlan = "a service plan ID or offering and plan name is required"
+)
+
+// Client is the interface that defines the methods that a ServicePlan client
+// should implement.
+type Client interface {
+	Get(ctx context.Context, guid string) (*resource.ServicePlan, error)
+	Single(ctx context.Context, opts *client.ServicePlanListOptions) (*resource.ServicePlan, error)
+}
+
+// NewClient creates a new ServicePlan client
+func NewClient(cf *client.Client) Client {
+	return cf.ServicePlans
+}
+
+// FindBySpec looks up a service plan by ID or by offering and plan name.
+func FindBySpec(ctx context.Context, c Client, spec v1alpha1.ServicePlanParameters) (*resource.ServicePlan, error) {
+	if spec.ID != nil && *spec.ID != "" {
+		return c.Get(ctx, *spec.ID)
+	}
+
+	if spec.Offering == nil || spec.Plan == nil {
+		return nil, errors.New(errMissingServicePlan)
+	}
+
+	opts := client.NewServicePlanListOptions()
+	opts.ServiceOfferingNames.EqualTo(*spec.Offering)
+	opts.Names.EqualTo(*spec.Plan)
+	return c.Single(ctx, opts)
+}
+
+// GenerateObservation takes a ServicePlan resource and returns *ServicePlanObservation.
+func GenerateObservation(o *resource.ServicePlan) v1alpha1.ServicePlanObservation {
+	obs := v1alpha1.ServicePlanObservation{
+		ID:             ptr.To(o.GUID),
+		Name:           ptr.To(o.Name),
+		Description:    ptr.To(o.Description),
+		Available:      ptr.To(o.Available),
+		Free:           ptr.To(o.Free),
+		VisibilityType: ptr.To(o.VisibilityType),
+		MaintenanceInfo: v1alpha1.MaintenanceInfo{
+			Version:     ptr.To(o.MaintenanceInfo.Version),
+			Description: ptr.To(o.MaintenanceInfo.Description),
+		},
+		Schemas: v1alpha1.ServicePlanSchemas{
+			ServiceInstanceCreate: rawSchema(o.Schemas.ServiceInstance.Create.Parameters),
+			ServiceInstanceUpdate: rawSchema(o.Schemas.ServiceInstance.Update.Parameters),
+			ServiceBindingCreate:  rawSchema(o.Schemas.ServiceBinding.Create.Parameters),
+		},
+		BrokerCatalogID: ptr.To(o.BrokerCatalog.ID),
+		CreatedAt:       ptr.To(o.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:       ptr.To(o.UpdatedAt.Format(time.RFC3339)),
+	}
+	for _, c := range o.Costs {
+		obs.Costs = append(obs.Costs, v1alpha1.ServicePlanCost{
+			Amount:   strconv.FormatFloat(c.Amount, 'f', -1, 64),
+			Currency: c.Currency,
+			Unit:     c.Unit,
+		})
+	}
+	if o.Relationships.ServiceOffering.Data != nil {
+		obs.ServiceOffering = ptr.To(o.Relationships.ServiceOffering.Data.GUID)
+	}
+	if o.Metadata != nil {
+		obs.Labels = o.Metadata.Labels
+		obs.Annotations = o.Metadata.Annotations
+	}
+	return obs
+}
+
+// rawSchema returns the schema as RawExtension, or nil if the service plan
+// has no schema.
+func rawSchema(schema *json.RawMessage) *runtime.RawExtension {
+	if schema == nil || len(*schema) == 0 || string(*schema) == "null" || string(*schema) == "{}" {
+		return nil
+	}
+	return &runtime.RawExtension{Raw: []byte(*schema)}
+}
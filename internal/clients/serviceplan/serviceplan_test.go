@@ -0,0 +1,105 @@
+package serviceplan
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+)
+
+func TestFindBySpec(t *testing.T) {
+	plan := &resource.ServicePlan{Resource: resource.Resource{GUID: "plan-guid"}}
+
+	cases := map[string]struct {
+		spec   v1alpha1.ServicePlanParameters
+		client func() *fake.MockServicePlan
+		want   *resource.ServicePlan
+		err    error
+	}{
+		"ByID": {
+			spec: v1alpha1.ServicePlanParameters{ID: ptr.To("plan-guid"), Offering: ptr.To("ignored"), Plan: ptr.To("ignored")},
+			client: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Get", "plan-guid").Return(plan, nil)
+				return m
+			},
+			want: plan,
+		},
+		"ByName": {
+			spec: v1alpha1.ServicePlanParameters{Offering: ptr.To("my-service"), Plan: ptr.To("small")},
+			client: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Single").Return(plan, nil)
+				return m
+			},
+			want: plan,
+		},
+		"MissingPlanName": {
+			spec:   v1alpha1.ServicePlanParameters{Offering: ptr.To("my-service")},
+			client: func() *fake.MockServicePlan { return &fake.MockServicePlan{} },
+			err:    errors.New(errMissingServicePlan),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.client()
+			got, err := FindBySpec(context.Background(), m, tc.spec)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("FindBySpec(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FindBySpec(...): -want, +got:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	schema := json.RawMessage(`{"$schema":"http://json-schema.org/draft-04/schema#","type":"object"}`)
+	empty := json.RawMessage(`{}`)
+
+	plan := &resource.ServicePlan{
+		Name:            "small",
+		Description:     "A small plan",
+		VisibilityType:  "public",
+		Available:       true,
+		Costs:           []resource.ServicePlanCosts{{Amount: 9.5, Currency: "EUR", Unit: "Monthly"}},
+		MaintenanceInfo: resource.ServicePlanMaintenanceInfo{Version: "1.2.0", Description: "Patch"},
+		BrokerCatalog:   resource.ServicePlanBrokerCatalog{ID: "catalog-id"},
+		Relationships:   resource.ServicePlanRelationship{ServiceOffering: resource.ToOneRelationship{Data: &resource.Relationship{GUID: "offering-guid"}}},
+		Resource:        resource.Resource{GUID: "plan-guid"},
+	}
+	plan.Schemas.ServiceInstance.Create.Parameters = &schema
+	plan.Schemas.ServiceInstance.Update.Parameters = &empty
+
+	want := v1alpha1.ServicePlanObservation{
+		ID:              ptr.To("plan-guid"),
+		Name:            ptr.To("small"),
+		Description:     ptr.To("A small plan"),
+		Available:       ptr.To(true),
+		Free:            ptr.To(false),
+		VisibilityType:  ptr.To("public"),
+		Costs:           []v1alpha1.ServicePlanCost{{Amount: "9.5", Currency: "EUR", Unit: "Monthly"}},
+		MaintenanceInfo: v1alpha1.MaintenanceInfo{Version: ptr.To("1.2.0"), Description: ptr.To("Patch")},
+		Schemas:         v1alpha1.ServicePlanSchemas{ServiceInstanceCreate: &runtime.RawExtension{Raw: schema}},
+		BrokerCatalogID: ptr.To("catalog-id"),
+		ServiceOffering: ptr.To("offering-guid"),
+	}
+
+	got := GenerateObservation(plan)
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(v1alpha1.ServicePlanObservation{}, "CreatedAt", "UpdatedAt")); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
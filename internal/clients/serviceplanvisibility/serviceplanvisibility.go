@@ -0,0 +1,155 @@
+package serviceplanvisibility
+
+import (
+	"context"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/serviceplan"
+)
+
+// Client is the interface that defines the methods that a
+// ServicePlanVisibility client should implement.
+type Client interface {
+	Get(ctx context.Context, servicePlanGUID string) (*resource.ServicePlanVisibility, error)
+	Apply(ctx context.Context, servicePlanGUID string, r *resource.ServicePlanVisibility) (*resource.ServicePlanVisibility, error)
+	Update(ctx context.Context, servicePlanGUID string, r *resource.ServicePlanVisibility) (*resource.ServicePlanVisibility, error)
+	Delete(ctx context.Context, servicePlanGUID, organizationGUID string) error
+}
+
+// NewClient creates a new ServicePlanVisibility client and a ServicePlan
+// client to look up the service plans.
+func NewClient(cf *client.Client) (Client, serviceplan.Client) {
+	return cf.ServicePlansVisibility, cf.ServicePlans
+}
+
+// FindServicePlan returns the GUID of the service plan given by ID or by
+// offering and plan name.
+func FindServicePlan(ctx context.Context, plans serviceplan.Client, spec v1alpha1.ServicePlanParameters) (string, error) {
+	p, err := serviceplan.FindBySpec(ctx, plans, spec)
+	if err != nil {
+		return "", err
+	}
+	return p.GUID, nil
+}
+
+// GenerateUpdate generates the ServicePlanVisibility that replaces the
+// visibility of a service plan from *ServicePlanVisibilityParameters.
+func GenerateUpdate(spec v1alpha1.ServicePlanVisibilityParameters) *resource.ServicePlanVisibility {
+	v := &resource.ServicePlanVisibility{Type: spec.Type}
+	if spec.Type == resource.ServicePlanVisibilityOrganization.String() {
+		v.Organizations = relations(desiredOrgs(spec.Orgs))
+	}
+	return v
+}
+
+// GenerateObservation takes a ServicePlanVisibility resource and returns
+// *ServicePlanVisibilityObservation.
+func GenerateObservation(servicePlanGUID string, o *resource.ServicePlanVisibility) v1alpha1.ServicePlanVisibilityObservation {
+	obs := v1alpha1.ServicePlanVisibilityObservation{
+		ServicePlan: ptr.To(servicePlanGUID),
+		Type:        ptr.To(o.Type),
+	}
+	for _, org := range o.Organizations {
+		obs.Orgs = append(obs.Orgs, ptr.To(org.GUID))
+	}
+	if o.Space != nil {
+		obs.Space = ptr.To(o.Space.GUID)
+	}
+	return obs
+}
+
+// IsUpToDate checks whether the observed visibility matches the given set of
+// parameters. The organizations are only compared for the type
+// `organization`.
+func IsUpToDate(spec v1alpha1.ServicePlanVisibilityParameters, observed *resource.ServicePlanVisibility) bool {
+	if observed == nil {
+		return false
+	}
+	if spec.Type != observed.Type {
+		return false
+	}
+	if spec.Type != resource.ServicePlanVisibilityOrganization.String() {
+		return true
+	}
+	add, remove := Organizations(spec.Orgs, observed)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// Organizations compares the desired organizations with the organizations of
+// the observed visibility and returns the organizations to add and the
+// organizations to remove.
+func Organizations(desired []*string, observed *resource.ServicePlanVisibility) (add, remove []string) {
+	want := map[string]bool{}
+	for _, org := range desiredOrgs(desired) {
+		want[org] = true
+	}
+	have := map[string]bool{}
+	if observed != nil {
+		for _, org := range observed.Organizations {
+			have[org.GUID] = true
+			if !want[org.GUID] {
+				remove = append(remove, org.GUID)
+			}
+		}
+	}
+	for _, org := range desiredOrgs(desired) {
+		if !have[org] {
+			add = append(add, org)
+		}
+	}
+	return add, remove
+}
+
+// UpdateVisibility changes the visibility of the service plan to match the
+// given set of parameters. A change of the type replaces the visibility, while
+// the organizations of an `organization` visibility are added and removed
+// individually.
+func UpdateVisibility(ctx context.Context, c Client, servicePlanGUID string, spec v1alpha1.ServicePlanVisibilityParameters, observed *resource.ServicePlanVisibility) error {
+	if observed == nil || spec.Type != observed.Type {
+		_, err := c.Update(ctx, servicePlanGUID, GenerateUpdate(spec))
+		return err
+	}
+	if spec.Type != resource.ServicePlanVisibilityOrganization.String() {
+		return nil
+	}
+
+	add, remove := Organizations(spec.Orgs, observed)
+	if len(add) > 0 {
+		r := &resource.ServicePlanVisibility{Type: spec.Type, Organizations: relations(add)}
+		if _, err := c.Apply(ctx, servicePlanGUID, r); err != nil {
+			return err
+		}
+	}
+	for _, org := range remove {
+		if err := c.Delete(ctx, servicePlanGUID, org); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// desiredOrgs returns the unique, non-empty organization GUIDs in order.
+func desiredOrgs(orgs []*string) []string {
+	seen := map[string]bool{}
+	guids := make([]string, 0, len(orgs))
+	for _, org := range orgs {
+		if org == nil || *org == "" || seen[*org] {
+			continue
+		}
+		seen[*org] = true
+		guids = append(guids, *org)
+	}
+	return guids
+}
+
+func relations(guids []string) []resource.ServicePlanVisibilityRelation {
+	r := make([]resource.ServicePlanVisibilityRelation, 0, len(guids))
+	for _, guid := range guids {
+		r = append(r, resource.ServicePlanVisibilityRelation{GUID: guid})
+	}
+	return r
+}
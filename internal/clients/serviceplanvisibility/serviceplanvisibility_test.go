@@ -0,0 +1,150 @@
+package serviceplanvisibility
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+)
+
+const planGUID = "plan-guid"
+
+func orgVisibility(guids ...string) *resource.ServicePlanVisibility {
+	return &resource.ServicePlanVisibility{Type: "organization", Organizations: relations(guids)}
+}
+
+func TestGenerateUpdate(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.ServicePlanVisibilityParameters
+		want *resource.ServicePlanVisibility
+	}{
+		"Public": {
+			spec: v1alpha1.ServicePlanVisibilityParameters{Type: "public", Orgs: []*string{ptr.To("org-1")}},
+			want: &resource.ServicePlanVisibility{Type: "public"},
+		},
+		"Organization": {
+			spec: v1alpha1.ServicePlanVisibilityParameters{Type: "organization", Orgs: []*string{ptr.To("org-1"), nil, ptr.To("org-1"), ptr.To("org-2")}},
+			want: orgVisibility("org-1", "org-2"),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateUpdate(tc.spec)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec     v1alpha1.ServicePlanVisibilityParameters
+		observed *resource.ServicePlanVisibility
+		want     bool
+	}{
+		"NotObserved": {
+			spec: v1alpha1.ServicePlanVisibilityParameters{Type: "public"},
+			want: false,
+		},
+		"TypeChanged": {
+			spec:     v1alpha1.ServicePlanVisibilityParameters{Type: "public"},
+			observed: &resource.ServicePlanVisibility{Type: "admin"},
+			want:     false,
+		},
+		"Public": {
+			spec:     v1alpha1.ServicePlanVisibilityParameters{Type: "public"},
+			observed: &resource.ServicePlanVisibility{Type: "public"},
+			want:     true,
+		},
+		"OrganizationsMatch": {
+			spec:     v1alpha1.ServicePlanVisibilityParameters{Type: "organization", Orgs: []*string{ptr.To("org-2"), ptr.To("org-1")}},
+			observed: orgVisibility("org-1", "org-2"),
+			want:     true,
+		},
+		"OrganizationMissing": {
+			spec:     v1alpha1.ServicePlanVisibilityParameters{Type: "organization", Orgs: []*string{ptr.To("org-1"), ptr.To("org-2")}},
+			observed: orgVisibility("org-1"),
+			want:     false,
+		},
+		"OrganizationExtra": {
+			spec:     v1alpha1.ServicePlanVisibilityParameters{Type: "organization", Orgs: []*string{ptr.To("org-1")}},
+			observed: orgVisibility("org-1", "org-2"),
+			want:     false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := IsUpToDate(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateVisibility(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		spec     v1alpha1.ServicePlanVisibilityParameters
+		observed *resource.ServicePlanVisibility
+		client   func() *fake.MockServicePlanVisibility
+		err      error
+	}{
+		"ReplaceOnTypeChange": {
+			spec:     v1alpha1.ServicePlanVisibilityParameters{Type: "organization", Orgs: []*string{ptr.To("org-1")}},
+			observed: &resource.ServicePlanVisibility{Type: "admin"},
+			client: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Update", planGUID, orgVisibility("org-1")).Return(orgVisibility("org-1"), nil)
+				return m
+			},
+		},
+		"AddAndRemoveOrganizations": {
+			spec:     v1alpha1.ServicePlanVisibilityParameters{Type: "organization", Orgs: []*string{ptr.To("org-1"), ptr.To("org-3")}},
+			observed: orgVisibility("org-1", "org-2"),
+			client: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Apply", planGUID, orgVisibility("org-3")).Return(orgVisibility("org-1", "org-2", "org-3"), nil)
+				m.On("Delete", planGUID, "org-2").Return(nil)
+				return m
+			},
+		},
+		"UpToDate": {
+			spec:     v1alpha1.ServicePlanVisibilityParameters{Type: "public"},
+			observed: &resource.ServicePlanVisibility{Type: "public"},
+			client:   func() *fake.MockServicePlanVisibility { return &fake.MockServicePlanVisibility{} },
+		},
+		"RemoveError": {
+			spec:     v1alpha1.ServicePlanVisibilityParameters{Type: "organization"},
+			observed: orgVisibility("org-1"),
+			client: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Delete", planGUID, "org-1").Return(errBoom)
+				return m
+			},
+			err: errBoom,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.client()
+			err := UpdateVisibility(context.Background(), m, planGUID, tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("UpdateVisibility(...): -want error, +got error:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
@@ -66,3 +66,30 @@ func GetGUID(ctx context.Context, orgClient org.Client, spaceClient Space, orgNa
 
 	return &space.GUID, nil
 }
+
+// ResolveListByName resolves the space references of a list by name. Only
+// references with spaceName and orgName, but without spaceRef or
+// spaceSelector, are resolved.
+func ResolveListByName(ctx context.Context, clientFn clients.ClientFn, mg resource.Managed, refs []v1alpha1.SpaceReference) error {
+	var spaceClient Space
+	var orgClient org.Client
+	for i := range refs {
+		sr := &refs[i]
+		if sr.SpaceName == nil || sr.OrgName == nil || sr.SpaceRef != nil || sr.SpaceSelector != nil {
+			continue
+		}
+		if spaceClient == nil {
+			cf, err := clientFn(mg)
+			if err != nil {
+				return errors.Wrap(err, "Could not connect to Cloud Foundry")
+			}
+			spaceClient, _, orgClient = NewClient(cf)
+		}
+		spaceGUID, err := GetGUID(ctx, orgClient, spaceClient, *sr.OrgName, *sr.SpaceName)
+		if err != nil {
+			return errors.Wrap(err, "Cannot resolve space reference by name")
+		}
+		sr.Space = spaceGUID
+	}
+	return nil
+}
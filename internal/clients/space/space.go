@@ -21,6 +21,8 @@ type Space interface {
 	Create(ctx context.Context, r *resource.SpaceCreate) (*resource.Space, error)
 	Update(ctx context.Context, guid string, r *resource.SpaceUpdate) (*resource.Space, error)
 	Delete(ctx context.Context, guid string) (string, error)
+	AssignIsolationSegment(ctx context.Context, guid, isolationSegmentGUID string) error
+	GetAssignedIsolationSegment(ctx context.Context, guid string) (string, error)
 }
 
 // Feature is the interface that defines the methods that a Feature client should implement.
@@ -75,8 +77,9 @@ func GenerateUpdate(mg xpresource.Managed, spec v1alpha1.SpaceParameters) *resou
 	}
 }
 
-// GenerateObservation takes a Space resource and returns *SpaceObservation.
-func GenerateObservation(o *resource.Space, ssh bool) v1alpha1.SpaceObservation {
+// GenerateObservation takes a Space resource and the GUID of its assigned
+// isolation segment and returns *SpaceObservation.
+func GenerateObservation(o *resource.Space, ssh bool, isolationSegment string) v1alpha1.SpaceObservation {
 	obs := v1alpha1.SpaceObservation{
 		ID:        o.GUID,
 		Name:      o.Name,
@@ -88,6 +91,9 @@ func GenerateObservation(o *resource.Space, ssh bool) v1alpha1.SpaceObservation
 	if o.Relationships.Quota != nil && o.Relationships.Quota.Data != nil {
 		obs.Quota = ptr.To(o.Relationships.Quota.Data.GUID)
 	}
+	if isolationSegment != "" {
+		obs.IsolationSegment = ptr.To(isolationSegment)
+	}
 	if o.Metadata != nil {
 		obs.Labels = o.Metadata.Labels
 		obs.Annotations = o.Metadata.Annotations
@@ -96,15 +102,15 @@ func GenerateObservation(o *resource.Space, ssh bool) v1alpha1.SpaceObservation
 }
 
 // LateInitialize fills the unassigned fields with values from a Space resource.
-func LateInitialize(cr *v1alpha1.Space, from *resource.Space, ssh bool) bool {
+func LateInitialize(cr v1alpha1.SpaceManaged, from *resource.Space, ssh bool) bool {
 	// nothing to late initialize
 	return false
 }
 
 // IsUpToDate checks whether current state is up-to-date compared to the given
 // set of parameters.
-func IsUpToDate(mg xpresource.Managed, spec v1alpha1.SpaceParameters, observed *resource.Space, ssh bool) bool {
-	upToDate := spec.Name == observed.Name && (spec.AllowSSH == ssh)
+func IsUpToDate(mg xpresource.Managed, spec v1alpha1.SpaceParameters, observed *resource.Space, ssh bool, isolationSegment string) bool {
+	upToDate := spec.Name == observed.Name && (spec.AllowSSH == ssh) && IsIsolationSegmentUpToDate(spec, isolationSegment)
 	desired := metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
 	var actualLabels, actualAnnotations map[string]*string
 	if observed.Metadata != nil {
@@ -114,6 +120,12 @@ func IsUpToDate(mg xpresource.Managed, spec v1alpha1.SpaceParameters, observed *
 	return upToDate && metadata.IsMetadataUpToDate(desired.Labels, desired.Annotations, actualLabels, actualAnnotations)
 }
 
+// IsIsolationSegmentUpToDate checks whether the assigned isolation segment
+// matches the desired one. An unset isolation segment is not managed.
+func IsIsolationSegmentUpToDate(spec v1alpha1.SpaceParameters, isolationSegment string) bool {
+	return spec.IsolationSegment == nil || *spec.IsolationSegment == isolationSegment
+}
+
 // IsSSHEnabled checks whether SSH is enabled for the given space.
 func IsSSHEnabled(ctx context.Context, f Feature, spaceGUID string) (bool, error) {
 	return f.IsSSHEnabled(ctx, spaceGUID)
@@ -178,6 +178,8 @@ func TestGenerateObservation(t *testing.T) {
 		wantSSH    bool
 		wantLabels map[string]*string
 		wantAnns   map[string]*string
+		segment    string
+		wantSeg    *string
 	}{
 		"WithMetadata": {
 			space: &resource.Space{
@@ -199,6 +201,20 @@ func TestGenerateObservation(t *testing.T) {
 			wantLabels: map[string]*string{"env": ptr.To("prod")},
 			wantAnns:   map[string]*string{"note": ptr.To("test")},
 		},
+		"WithIsolationSegment": {
+			space: &resource.Space{
+				Name: "test-space",
+				Relationships: &resource.SpaceRelationships{
+					Organization: &resource.ToOneRelationship{Data: &resource.Relationship{GUID: "org-guid"}},
+				},
+				Resource: resource.Resource{GUID: "space-guid"},
+			},
+			segment:  "segment-guid",
+			wantID:   "space-guid",
+			wantName: "test-space",
+			wantOrg:  "org-guid",
+			wantSeg:  ptr.To("segment-guid"),
+		},
 		"NilMetadata": {
 			space: &resource.Space{
 				Name: "test-space",
@@ -216,7 +232,7 @@ func TestGenerateObservation(t *testing.T) {
 
 	for n, tc := range cases {
 		t.Run(n, func(t *testing.T) {
-			result := GenerateObservation(tc.space, tc.ssh)
+			result := GenerateObservation(tc.space, tc.ssh, tc.segment)
 			if result.ID != tc.wantID {
 				t.Errorf("ID: want %q, got %q", tc.wantID, result.ID)
 			}
@@ -229,6 +245,9 @@ func TestGenerateObservation(t *testing.T) {
 			if result.AllowSSH != tc.wantSSH {
 				t.Errorf("AllowSSH: want %v, got %v", tc.wantSSH, result.AllowSSH)
 			}
+			if diff := cmp.Diff(tc.wantSeg, result.IsolationSegment); diff != "" {
+				t.Errorf("IsolationSegment: -want, +got:\n%s", diff)
+			}
 			if diff := cmp.Diff(tc.wantLabels, result.Labels); diff != "" {
 				t.Errorf("Labels: -want, +got:\n%s", diff)
 			}
@@ -244,6 +263,7 @@ func TestIsUpToDate(t *testing.T) {
 		spec     v1alpha1.SpaceParameters
 		observed *resource.Space
 		ssh      bool
+		segment  string
 		want     bool
 	}{
 		"UpToDateNoLabels": {
@@ -314,6 +334,35 @@ func TestIsUpToDate(t *testing.T) {
 			ssh:  false,
 			want: true,
 		},
+		"IsolationSegmentUnmanaged": {
+			spec:     v1alpha1.SpaceParameters{Name: "test-space"},
+			observed: &resource.Space{Name: "test-space"},
+			segment:  "segment-guid",
+			want:     true,
+		},
+		"IsolationSegmentMatch": {
+			spec:     v1alpha1.SpaceParameters{Name: "test-space", IsolationSegment: ptr.To("segment-guid")},
+			observed: &resource.Space{Name: "test-space"},
+			segment:  "segment-guid",
+			want:     true,
+		},
+		"IsolationSegmentDrift": {
+			spec:     v1alpha1.SpaceParameters{Name: "test-space", IsolationSegment: ptr.To("segment-guid")},
+			observed: &resource.Space{Name: "test-space"},
+			segment:  "other-guid",
+			want:     false,
+		},
+		"IsolationSegmentNotAssigned": {
+			spec:     v1alpha1.SpaceParameters{Name: "test-space", IsolationSegment: ptr.To("segment-guid")},
+			observed: &resource.Space{Name: "test-space"},
+			want:     false,
+		},
+		"IsolationSegmentUnassign": {
+			spec:     v1alpha1.SpaceParameters{Name: "test-space", IsolationSegment: ptr.To("")},
+			observed: &resource.Space{Name: "test-space"},
+			segment:  "segment-guid",
+			want:     false,
+		},
 		"ObservedNilMetadata": {
 			spec:     v1alpha1.SpaceParameters{Name: "test-space"},
 			observed: &resource.Space{Name: "test-space"},
@@ -324,7 +373,7 @@ func TestIsUpToDate(t *testing.T) {
 
 	for n, tc := range cases {
 		t.Run(n, func(t *testing.T) {
-			result := IsUpToDate(nil, tc.spec, tc.observed, tc.ssh)
+			result := IsUpToDate(nil, tc.spec, tc.observed, tc.ssh, tc.segment)
 			if result != tc.want {
 				t.Errorf("IsUpToDate(...): want %v, got %v", tc.want, result)
 			}
@@ -0,0 +1,124 @@
+package task
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpresource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+// States of a task.
+const (
+	StatePending   = "PENDING"
+	StateRunning   = "RUNNING"
+	StateSucceeded = "SUCCEEDED"
+	StateCanceling = "CANCELING"
+	StateFailed    = "FAILED"
+)
+
+// Client is the interface that defines the methods that a Task client should
+// implement.
+type Client interface {
+	Get(ctx context.Context, guid string) (*resource.Task, error)
+	Create(ctx context.Context, appGUID string, r *resource.TaskCreate) (*resource.Task, error)
+	Update(ctx context.Context, guid string, r *resource.TaskUpdate) (*resource.Task, error)
+	Cancel(ctx context.Context, guid string) (*resource.Task, error)
+}
+
+// NewClient creates a new Task client
+func NewClient(cf *client.Client) Client {
+	return cf.Tasks
+}
+
+// GenerateCreate generates the TaskCreate from an *TaskParameters.
+func GenerateCreate(mg xpresource.Managed, spec v1alpha1.TaskParameters) *resource.TaskCreate {
+	create := resource.NewTaskCreateWithCommand(spec.Command)
+	create.Name = spec.Name
+	create.MemoryInMB = spec.MemoryInMB
+	create.DiskInMB = spec.DiskInMB
+	create.LogRateLimitInBytesPerSecond = spec.LogRateLimitInBytesPerSecond
+	create.DropletGUID = spec.Droplet
+	create.Metadata = metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	return create
+}
+
+// GenerateUpdate generates the TaskUpdate from an *TaskParameters. Only the
+// metadata of a task can be updated.
+func GenerateUpdate(mg xpresource.Managed, spec v1alpha1.TaskParameters) *resource.TaskUpdate {
+	return &resource.TaskUpdate{
+		Metadata: metadata.BuildMetadata(mg, spec.Labels, spec.Annotations),
+	}
+}
+
+// GenerateObservation takes a Task resource and returns *TaskObservation.
+func GenerateObservation(o *resource.Task) v1alpha1.TaskObservation {
+	obs := v1alpha1.TaskObservation{
+		ID:                           ptr.To(o.GUID),
+		Name:                         ptr.To(o.Name),
+		SequenceID:                   ptr.To(o.SequenceID),
+		State:                        ptr.To(o.State),
+		FailureReason:                o.Result.FailureReason,
+		MemoryInMB:                   ptr.To(o.MemoryInMB),
+		DiskInMB:                     ptr.To(o.DiskInMB),
+		LogRateLimitInBytesPerSecond: ptr.To(o.LogRateLimitInBytesPerSecond),
+		Droplet:                      ptr.To(o.DropletGUID),
+		CreatedAt:                    ptr.To(o.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:                    ptr.To(o.UpdatedAt.Format(time.RFC3339)),
+	}
+	if o.Metadata != nil {
+		obs.Labels = o.Metadata.Labels
+		obs.Annotations = o.Metadata.Annotations
+	}
+	return obs
+}
+
+// IsUpToDate checks whether the metadata of the observed task matches the
+// given set of parameters. The other parameters are compared by SpecHash, as a
+// task cannot be changed once it runs.
+func IsUpToDate(mg xpresource.Managed, spec v1alpha1.TaskParameters, observed *resource.Task) bool {
+	if observed == nil {
+		return false
+	}
+	desired := metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	var observedLabels, observedAnnotations map[string]*string
+	if observed.Metadata != nil {
+		observedLabels = observed.Metadata.Labels
+		observedAnnotations = observed.Metadata.Annotations
+	}
+	return metadata.IsMetadataUpToDate(desired.Labels, desired.Annotations, observedLabels, observedAnnotations)
+}
+
+// IsFinished checks whether a task in the given state has finished.
+func IsFinished(state string) bool {
+	return state == StateSucceeded || state == StateFailed
+}
+
+// IsCancelable checks whether a task in the given state can be canceled.
+func IsCancelable(state string) bool {
+	return state == StatePending || state == StateRunning
+}
+
+// SpecHash returns the hash of the parameters a task runs with. The metadata
+// and the references are not part of the hash.
+func SpecHash(spec v1alpha1.TaskParameters) string {
+	buf, _ := json.Marshal(struct { //nolint:errchkjson
+		Name                         *string `json:"name"`
+		Command                      string  `json:"command"`
+		App                          *string `json:"app"`
+		MemoryInMB                   *int    `json:"memoryInMB"`
+		DiskInMB                     *int    `json:"diskInMB"`
+		LogRateLimitInBytesPerSecond *int    `json:"logRateLimitInBytesPerSecond"`
+		Droplet                      *string `json:"droplet"`
+	}{spec.Name, spec.Command, spec.App, spec.MemoryInMB, spec.DiskInMB, spec.LogRateLimitInBytesPerSecond, spec.Droplet})
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,101 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+func TestGenerateCreate(t *testing.T) {
+	mg := &v1alpha1.Task{}
+	spec := v1alpha1.TaskParameters{
+		Name:       ptr.To("migrate"),
+		Command:    "bin/migrate",
+		App:        ptr.To("app-guid"),
+		MemoryInMB: ptr.To(512),
+		Droplet:    ptr.To("droplet-guid"),
+	}
+
+	want := &resource.TaskCreate{
+		Command:     ptr.To("bin/migrate"),
+		Name:        ptr.To("migrate"),
+		MemoryInMB:  ptr.To(512),
+		DropletGUID: ptr.To("droplet-guid"),
+		Metadata:    metadata.BuildMetadata(mg, nil, nil),
+	}
+	if diff := cmp.Diff(want, GenerateCreate(mg, spec)); diff != "" {
+		t.Errorf("GenerateCreate(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestSpecHash(t *testing.T) {
+	base := v1alpha1.TaskParameters{Command: "bin/migrate", App: ptr.To("app-guid")}
+
+	cases := map[string]struct {
+		spec v1alpha1.TaskParameters
+		want bool
+	}{
+		"Same": {
+			spec: v1alpha1.TaskParameters{Command: "bin/migrate", App: ptr.To("app-guid")},
+			want: true,
+		},
+		"IgnoresMetadata": {
+			spec: v1alpha1.TaskParameters{
+				Command:          "bin/migrate",
+				App:              ptr.To("app-guid"),
+				ResourceMetadata: v1alpha1.ResourceMetadata{Labels: map[string]*string{"team": ptr.To("a")}},
+			},
+			want: true,
+		},
+		"CommandChanged": {
+			spec: v1alpha1.TaskParameters{Command: "bin/migrate --all", App: ptr.To("app-guid")},
+			want: false,
+		},
+		"MemoryChanged": {
+			spec: v1alpha1.TaskParameters{Command: "bin/migrate", App: ptr.To("app-guid"), MemoryInMB: ptr.To(256)},
+			want: false,
+		},
+		"AppChanged": {
+			spec: v1alpha1.TaskParameters{Command: "bin/migrate", App: ptr.To("other-app-guid")},
+			want: false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if got := SpecHash(tc.spec) == SpecHash(base); got != tc.want {
+				t.Errorf("SpecHash(...) equal: want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsFinished(t *testing.T) {
+	cases := map[string]struct {
+		state      string
+		finished   bool
+		cancelable bool
+	}{
+		"Pending":   {state: StatePending, cancelable: true},
+		"Running":   {state: StateRunning, cancelable: true},
+		"Canceling": {state: StateCanceling},
+		"Succeeded": {state: StateSucceeded, finished: true},
+		"Failed":    {state: StateFailed, finished: true},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if got := IsFinished(tc.state); got != tc.finished {
+				t.Errorf("IsFinished(%s): want %t, got %t", tc.state, tc.finished, got)
+			}
+			if got := IsCancelable(tc.state); got != tc.cancelable {
+				t.Errorf("IsCancelable(%s): want %t, got %t", tc.state, tc.cancelable, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+const (
+	errGetCABundle     = "cannot get CA bundle"
+	errEmptyCABundle   = "CA bundle is empty"
+	errInvalidCABundle = "CA bundle does not contain any PEM encoded certificate"
+)
+
+// newHTTPClient returns the http.Client used to talk to the CF API and UAA. It
+// trusts the given CA bundle in addition to the system roots and uses the
+// proxy, the request timeout and the rate limit of the ProviderConfig.
+func newHTTPClient(pc v1beta1.ProviderConfigObject, bundle []byte) (*http.Client, error) {
+	transport, err := newTransport(pc, bundle)
+	if err != nil {
+		return nil, err
+	}
+	spec := pc.GetSpec()
+	return &http.Client{
+		Transport: newRetryTransport(transport, spec.RateLimit),
+		Timeout:   requestTimeout(spec),
+	}, nil
+}
+
+// newTransport returns an http.Transport that trusts the given CA bundle in
+// addition to the system roots and uses the proxy of the ProviderConfig.
+func newTransport(pc v1beta1.ProviderConfigObject, bundle []byte) (*http.Transport, error) {
+	spec := pc.GetSpec()
+	proxy, err := proxyFunc(spec)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxy
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: spec.InsecureSkipTLSVerify, //nolint:gosec // explicit opt-in
+	}
+
+	if bundle != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, errors.New(errInvalidCABundle)
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+	return transport, nil
+}
+
+// GetDownloadClient returns the http.Client used to download artifacts, e.g.
+// the bits of a buildpack, for the given managed resource. It uses the CA
+// bundle and the proxy of the ProviderConfig, but neither its request timeout
+// nor its rate limit, as these apply to the CF API.
+func GetDownloadClient(ctx context.Context, kube client.Client, mg resource.Managed) (*http.Client, error) {
+	ref, err := ProviderConfigRefOf(mg)
+	if err != nil {
+		return nil, err
+	}
+	pc, err := getProviderConfig(ctx, kube, ref)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetProviderConfig)
+	}
+	if err := checkNamespaces(pc); err != nil {
+		return nil, err
+	}
+	bundle, err := getCABundle(ctx, kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCABundle)
+	}
+	transport, err := newTransport(pc, bundle)
+	if err != nil {
+		return nil, errors.Wrap(err, errConfigureHTTPClient)
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+// requestTimeout returns the request timeout of the ProviderConfig, or zero if
+// the default timeout of the CF client applies.
+func requestTimeout(spec *v1beta1.ProviderConfigSpec) time.Duration {
+	if spec.RequestTimeout == nil {
+		return 0
+	}
+	return spec.RequestTimeout.Duration
+}
+
+// getCABundle returns the PEM encoded CA bundle referenced by the
+// ProviderConfig, or nil if none is referenced.
+func getCABundle(ctx context.Context, kube client.Client, pc v1beta1.ProviderConfigObject) ([]byte, error) {
+	spec := pc.GetSpec()
+	var bundle []byte
+	switch {
+	case spec.CABundleSecretRef != nil:
+		ref := spec.CABundleSecretRef
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, err
+		}
+		bundle = s.Data[ref.Key]
+	case spec.CABundleConfigMapRef != nil:
+		ref := spec.CABundleConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return nil, err
+		}
+		bundle = []byte(cm.Data[ref.Key])
+	default:
+		return nil, nil
+	}
+	if len(bundle) == 0 {
+		return nil, errors.New(errEmptyCABundle)
+	}
+	return bundle, nil
+}
+
+// IsCertificateError returns true if the error is caused by a certificate that
+// could not be verified.
+func IsCertificateError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var verifyErr *tls.CertificateVerificationError
+	var authorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidErr x509.CertificateInvalidError
+	return errors.As(err, &verifyErr) ||
+		errors.As(err, &authorityErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidErr)
+}
+
+// setCertificateCondition records on the ProviderConfig whether the certificate
+// presented by the CF API could be verified. The status is only written if the
+// condition changes.
+func setCertificateCondition(ctx context.Context, kube client.Client, pc v1beta1.ProviderConfigObject, err error) {
+	var c xpv1.Condition
+	switch {
+	case pc.GetSpec().InsecureSkipTLSVerify:
+		c = v1beta1.CertificateVerificationSkipped()
+	case IsCertificateError(err):
+		c = v1beta1.CertificateVerificationFailed(err)
+	case err != nil:
+		// the error tells nothing about the certificate
+		return
+	default:
+		c = v1beta1.CertificateVerified()
+	}
+
+	if pc.GetCondition(c.Type).Equal(c) {
+		return
+	}
+	pc.SetConditions(c)
+	// best effort, a failed status update must not fail the managed resource
+	_ = kube.Status().Update(ctx, pc)
+}
@@ -0,0 +1,199 @@
+package clients
+
+import (
+	"context"
+	"encoding/pem"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+func TestGetCredentialConfigTLS(t *testing.T) {
+	credentials := []byte(`{"email": "user@example.com", "password": "secret"}`)
+
+	type args struct {
+		modify    func(pc *v1beta1.ProviderConfig)
+		configMap bool
+		caBundle  func(f *fakeUAA) []byte
+	}
+	type want struct {
+		err            bool
+		certificateErr bool
+		condition      xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"VerifyByDefault": {
+			args: args{},
+			want: want{
+				err:            true,
+				certificateErr: true,
+				condition:      xpv1.Condition{Type: v1beta1.TypeCertificateVerified, Status: "False", Reason: v1beta1.ReasonCertificateVerificationFailed},
+			},
+		},
+		"CABundleFromSecret": {
+			args: args{
+				modify: func(pc *v1beta1.ProviderConfig) {
+					pc.Spec.CABundleSecretRef = &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "ca", Namespace: "default"},
+						Key:             "ca.crt",
+					}
+				},
+				caBundle: caBundle,
+			},
+			want: want{
+				condition: xpv1.Condition{Type: v1beta1.TypeCertificateVerified, Status: "True", Reason: v1beta1.ReasonCertificateVerified},
+			},
+		},
+		"CABundleFromConfigMap": {
+			args: args{
+				modify: func(pc *v1beta1.ProviderConfig) {
+					pc.Spec.CABundleConfigMapRef = &v1beta1.ConfigMapKeySelector{Name: "ca", Namespace: "default", Key: "ca.crt"}
+				},
+				configMap: true,
+				caBundle:  caBundle,
+			},
+			want: want{
+				condition: xpv1.Condition{Type: v1beta1.TypeCertificateVerified, Status: "True", Reason: v1beta1.ReasonCertificateVerified},
+			},
+		},
+		"InvalidCABundle": {
+			args: args{
+				modify: func(pc *v1beta1.ProviderConfig) {
+					pc.Spec.CABundleSecretRef = &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "ca", Namespace: "default"},
+						Key:             "ca.crt",
+					}
+				},
+				caBundle: func(*fakeUAA) []byte { return []byte("not a certificate") },
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"InsecureSkipTLSVerify": {
+			args: args{
+				modify: func(pc *v1beta1.ProviderConfig) {
+					pc.Spec.InsecureSkipTLSVerify = true
+				},
+			},
+			want: want{
+				condition: xpv1.Condition{Type: v1beta1.TypeCertificateVerified, Status: "False", Reason: v1beta1.ReasonCertificateVerificationSkipped},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			f := newFakeUAA(t, true)
+			pc := fakeProviderConfig(f.URL, "", nil)
+			if tc.args.modify != nil {
+				tc.args.modify(pc)
+			}
+			kube := &fakeKube{
+				pc:         pc,
+				secrets:    map[string]map[string][]byte{credentialsSecret: {"credentials": credentials}},
+				configMaps: map[string]map[string]string{},
+			}
+			if tc.args.caBundle != nil {
+				if tc.args.configMap {
+					kube.configMaps["ca"] = map[string]string{"ca.crt": string(tc.args.caBundle(f))}
+				} else {
+					kube.secrets["ca"] = map[string][]byte{"ca.crt": tc.args.caBundle(f)}
+				}
+			}
+
+			_, err := GetCredentialConfig(context.Background(), kube.client(), fakeManaged())
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("GetCredentialConfig(...): -want error, +got error:\n%s\n%v", diff, err)
+			}
+			if diff := cmp.Diff(tc.want.certificateErr, IsCertificateError(err)); diff != "" {
+				t.Errorf("IsCertificateError(...): -want, +got:\n%s", diff)
+			}
+			got := pc.Status.GetCondition(v1beta1.TypeCertificateVerified)
+			if tc.want.condition.Type == "" {
+				return
+			}
+			// Condition.Equal ignores the LastTransitionTime
+			got.Message = ""
+			if diff := cmp.Diff(tc.want.condition, got); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetDownloadClient(t *testing.T) {
+	type args struct {
+		secure   bool
+		caBundle bool
+		proxy    bool
+	}
+	type want struct {
+		err     bool
+		proxied []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"VerifyByDefault": {
+			args: args{secure: true},
+			want: want{err: true},
+		},
+		"CABundle": {
+			args: args{secure: true, caBundle: true},
+			want: want{},
+		},
+		"Proxy": {
+			args: args{proxy: true},
+			want: want{proxied: []string{"/"}},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			f := newFakeUAA(t, tc.args.secure)
+			p := newFakeProxy(t, 0)
+			pc := fakeProviderConfig(f.URL, "", nil)
+			kube := &fakeKube{pc: pc, secrets: map[string]map[string][]byte{}}
+			if tc.args.caBundle {
+				pc.Spec.CABundleSecretRef = &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "ca", Namespace: "default"},
+					Key:             "ca.crt",
+				}
+				kube.secrets["ca"] = map[string][]byte{"ca.crt": caBundle(f)}
+			}
+			if tc.args.proxy {
+				pc.Spec.ProxyURL = ptr.To(p.URL)
+			}
+
+			hc, err := GetDownloadClient(context.Background(), kube.client(), fakeManaged())
+			if err != nil {
+				t.Fatalf("GetDownloadClient(...): %v", err)
+			}
+			resp, err := hc.Get(f.URL)
+			if err == nil {
+				resp.Body.Close() //nolint:errcheck
+			}
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("Get(...): -want error, +got error:\n%s\n%v", diff, err)
+			}
+			if diff := cmp.Diff(tc.want.proxied, p.proxied()); diff != "" {
+				t.Errorf("proxied requests: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func caBundle(f *fakeUAA) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: f.Certificate().Raw})
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nsv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/v1beta1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+const errUnknownManaged = "managed resource is neither cluster scoped nor namespaced"
+
+// NewProviderConfigUsageTracker returns a resource.Tracker that tracks the
+// usage of a legacy ProviderConfig by cluster scoped managed resources and the
+// usage of a ProviderConfig or ClusterProviderConfig by namespaced managed
+// resources.
+func NewProviderConfigUsageTracker(kube client.Client) resource.Tracker {
+	legacy := resource.NewLegacyProviderConfigUsageTracker(kube, &v1beta1.ProviderConfigUsage{})
+	modern := resource.NewProviderConfigUsageTracker(kube, &nsv1beta1.ProviderConfigUsage{})
+	return resource.TrackerFn(func(ctx context.Context, mg resource.Managed) error {
+		switch m := mg.(type) {
+		case resource.LegacyManaged:
+			return legacy.Track(ctx, m)
+		case resource.ModernManaged:
+			return modern.Track(ctx, m)
+		}
+		return errors.New(errUnknownManaged)
+	})
+}
@@ -0,0 +1,86 @@
+package clients
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	nsv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/v1beta1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+)
+
+func TestProviderConfigUsageTracker(t *testing.T) {
+	type want struct {
+		usage     k8s.Object
+		namespace string
+		labels    map[string]string
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"ClusterScoped": {
+			mg: func() resource.Managed {
+				s := &v1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "space", UID: "uid"}}
+				s.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+				return s
+			}(),
+			want: want{
+				usage:  &v1beta1.ProviderConfigUsage{},
+				labels: map[string]string{xpv1.LabelKeyProviderName: "default"},
+			},
+		},
+		"Namespaced": {
+			mg: func() resource.Managed {
+				s := &nsv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "space", UID: "uid"}}
+				s.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: nsv1beta1.ClusterProviderConfigKind, Name: "default"})
+				return s
+			}(),
+			want: want{
+				usage:     &nsv1beta1.ProviderConfigUsage{},
+				namespace: "team-a",
+				labels:    map[string]string{xpv1.LabelKeyProviderName: "default", xpv1.LabelKeyProviderKind: nsv1beta1.ClusterProviderConfigKind},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			var created k8s.Object
+			kube := &test.MockClient{
+				MockGet: func(context.Context, k8s.ObjectKey, k8s.Object) error {
+					return kerrors.NewNotFound(schema.GroupResource{}, "uid")
+				},
+				MockCreate: func(_ context.Context, obj k8s.Object, _ ...k8s.CreateOption) error {
+					created = obj
+					return nil
+				},
+			}
+
+			if err := NewProviderConfigUsageTracker(kube).Track(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Track(...): unexpected error: %v", err)
+			}
+			if reflect.TypeOf(created) != reflect.TypeOf(tc.want.usage) {
+				t.Fatalf("Track(...): want %T, got %T", tc.want.usage, created)
+			}
+			if diff := cmp.Diff(tc.want.namespace, created.GetNamespace()); diff != "" {
+				t.Errorf("Track(...): -want namespace, +got namespace:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.labels, created.GetLabels()); diff != "" {
+				t.Errorf("Track(...): -want labels, +got labels:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpresource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+// Client is the interface that defines the methods that a User client should
+// implement.
+type Client interface {
+	Get(ctx context.Context, guid string) (*resource.User, error)
+	Single(ctx context.Context, opts *client.UserListOptions) (*resource.User, error)
+	Create(ctx context.Context, r *resource.UserCreate) (*resource.User, error)
+	CreateWithUsername(ctx context.Context, r *resource.UserCreateWithUsername) (*resource.User, error)
+	Update(ctx context.Context, guid string, r *resource.UserUpdate) (*resource.User, error)
+	Delete(ctx context.Context, guid string) (string, error)
+}
+
+// NewClient creates a new User client and the job client to await the
+// deletion of users.
+func NewClient(cf *client.Client) (Client, job.Job) {
+	return cf.Users, cf.Jobs
+}
+
+// FindBySpec looks up a user by GUID or by username and origin when
+// external-name is empty.
+func FindBySpec(ctx context.Context, c Client, spec v1alpha1.UserParameters) (*resource.User, error) {
+	if spec.GUID != nil {
+		return c.Get(ctx, *spec.GUID)
+	}
+	opts := client.NewUserListOptions()
+	opts.UserNames.EqualTo(spec.Username)
+	opts.Origins.EqualTo(spec.Origin)
+	return c.Single(ctx, opts)
+}
+
+// Create creates the Cloud Foundry user with the GUID of an existing UAA user
+// if it is given, and by username and origin otherwise.
+func Create(ctx context.Context, c Client, mg xpresource.Managed, spec v1alpha1.UserParameters) (*resource.User, error) {
+	md := metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	if spec.GUID != nil {
+		create := resource.NewUserCreateWithGUID(*spec.GUID)
+		create.Metadata = md
+		return c.Create(ctx, create)
+	}
+	create := resource.NewUserCreateWithUsername(spec.Username, spec.Origin)
+	create.Metadata = md
+	return c.CreateWithUsername(ctx, create)
+}
+
+// GenerateUpdate generates the UserUpdate from an *UserParameters. Only the
+// metadata of a user can be updated.
+func GenerateUpdate(mg xpresource.Managed, spec v1alpha1.UserParameters) *resource.UserUpdate {
+	return &resource.UserUpdate{
+		Metadata: metadata.BuildMetadata(mg, spec.Labels, spec.Annotations),
+	}
+}
+
+// GenerateObservation takes a User resource and returns *UserObservation.
+func GenerateObservation(o *resource.User) v1alpha1.UserObservation {
+	obs := v1alpha1.UserObservation{
+		ID:               ptr.To(o.GUID),
+		Username:         o.Username,
+		Origin:           o.Origin,
+		PresentationName: ptr.To(o.PresentationName),
+		CreatedAt:        ptr.To(o.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:        ptr.To(o.UpdatedAt.Format(time.RFC3339)),
+	}
+	if o.Metadata != nil {
+		obs.Labels = o.Metadata.Labels
+		obs.Annotations = o.Metadata.Annotations
+	}
+	return obs
+}
+
+// IsUpToDate checks whether the metadata of the observed user matches the
+// given set of parameters. Username and origin are immutable.
+func IsUpToDate(mg xpresource.Managed, spec v1alpha1.UserParameters, observed *resource.User) bool {
+	if observed == nil {
+		return false
+	}
+	desired := metadata.BuildMetadata(mg, spec.Labels, spec.Annotations)
+	var observedLabels, observedAnnotations map[string]*string
+	if observed.Metadata != nil {
+		observedLabels = observed.Metadata.Labels
+		observedAnnotations = observed.Metadata.Annotations
+	}
+	return metadata.IsMetadataUpToDate(desired.Labels, desired.Annotations, observedLabels, observedAnnotations)
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"bytes"
 	"context"
+	"strings"
 
 	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -14,39 +15,54 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/docker/cli/cli/config/configfile"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	pcv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/app"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/deployment"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/space"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
 )
 
 var (
-	resourceKind       = v1alpha1.App_Kind
-	errWrongKind       = "Wrong resource kind (expected " + resourceKind + " resource)"
-	errTrackUsage      = "Cannot track usage"
-	errConnect         = "Cannot connect to Cloud Foundry"
-	errObserveResource = "Cannot observe" + resourceKind + " by ID or using forProvider spec"
-	errCreateResource  = "Cannot create " + resourceKind + " resource in Cloud Foundry"
-	errUpdateResource  = "Cannot update " + resourceKind + " in Cloud Foundry"
-	errDeleteResource  = "Cannot delete " + resourceKind + " in Cloud Foundry"
-	errSecret          = "Cannot extract credentials from secret"
+	resourceKind        = v1alpha1.App_Kind
+	errWrongKind        = "Wrong resource kind (expected " + resourceKind + " resource)"
+	errTrackUsage       = "Cannot track usage"
+	errConnect          = "Cannot connect to Cloud Foundry"
+	errObserveResource  = "Cannot observe" + resourceKind + " by ID or using forProvider spec"
+	errCreateResource   = "Cannot create " + resourceKind + " resource in Cloud Foundry"
+	errUpdateResource   = "Cannot update " + resourceKind + " in Cloud Foundry"
+	errDeleteResource   = "Cannot delete " + resourceKind + " in Cloud Foundry"
+	errSecret           = "Cannot extract credentials from secret"
+	errDeploy           = "Cannot deploy " + resourceKind + " in Cloud Foundry"
+	errDeploymentAction = "Cannot %s the deployment of " + resourceKind + " in Cloud Foundry"
+	errUpdateCR         = "Cannot update the managed resource"
+	msgDeployment       = "deployment is "
 )
 
-// Setup adds a controller that reconciles App resources.
+// Setup adds controllers that reconcile cluster scoped and namespaced App
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(resourceKind)
+	if err := setup(mgr, o, v1alpha1.App_GroupVersionKind, &v1alpha1.App{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.App_GroupVersionKind, &nsv1alpha1.App{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
-		managed.WithExternalConnector(
+		managed.WithExternalConnector(cferrors.NewConnector(
 			&connector{kube: mgr.GetClient(),
-				usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &pcv1beta1.ProviderConfigUsage{}),
-			}),
+				usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+			})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -60,20 +76,20 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.App_GroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.App{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
 type connector struct {
 	kube  k8s.Client
-	usage resource.LegacyTracker
+	usage resource.Tracker
 }
 
 // Connect typically produces an ExternalClient by:
@@ -82,11 +98,11 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.App); !ok {
+	if _, ok := mg.(v1alpha1.AppManaged); !ok {
 		return nil, errors.New(errWrongKind)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackUsage)
 	}
 
@@ -109,7 +125,7 @@ type external struct {
 
 // Observe managed resource
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.App)
+	cr, ok := mg.(v1alpha1.AppManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errWrongKind)
 	}
@@ -152,57 +168,77 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}, nil
 }
 
-func (c *external) updateObservedStatus(ctx context.Context, cr *v1alpha1.App, res *cfresource.App) (bool, error) {
+func (c *external) updateObservedStatus(ctx context.Context, cr v1alpha1.AppManaged, res *cfresource.App) (bool, error) {
 	// Preserve previously observed routes so they survive a transient
 	// failure from the Routes API.
-	prevRoutes := cr.Status.AtProvider.Routes
+	prevRoutes := cr.GetAtProvider().Routes
 
 	// Update the status of the resource
-	cr.Status.AtProvider = app.GenerateObservation(res)
+	*cr.GetAtProvider() = app.GenerateObservation(res)
 	appManifest, err := c.client.GenerateManifest(ctx, res.GUID)
 	if err != nil {
 		return false, errors.Wrap(err, errObserveResource)
 	}
-	cr.Status.AtProvider.AppManifest = appManifest
+	cr.GetAtProvider().AppManifest = appManifest
+
+	// Observe the user sidecars once they are managed
+	if cr.GetForProvider().Sidecars != nil {
+		sidecars, err := c.client.ObserveSidecars(ctx, res.GUID)
+		if err != nil {
+			return false, errors.Wrap(err, errObserveResource)
+		}
+		cr.GetAtProvider().Sidecars = sidecars
+	}
 
 	// Fetch routes for the application. On success, update the status with
 	// the fresh data; on error, restore the previously observed routes so
 	// that a transient CF API failure does not erase known route information.
 	if routes, err := c.client.FetchRoutes(ctx, res.GUID); err == nil {
-		cr.Status.AtProvider.Routes = routes
+		cr.GetAtProvider().Routes = routes
 	} else {
-		cr.Status.AtProvider.Routes = prevRoutes
+		cr.GetAtProvider().Routes = prevRoutes
 		klog.Warningf("failed to fetch routes for app %q, preserving previous observations: %v", res.GUID, err)
 	}
 
+	// Observe the latest deployment when changes are rolled out with a strategy
+	if cr.GetForProvider().Strategy != nil {
+		d, err := c.client.Deployment.Latest(ctx, res.GUID)
+		if err != nil {
+			return false, errors.Wrap(err, errObserveResource)
+		}
+		cr.GetAtProvider().Deployment = deployment.GenerateObservation(d)
+	}
+
 	// Set condition according to app State
-	switch cr.Status.AtProvider.State {
-	case "STARTED":
+	switch {
+	case deployment.IsActive(cr.GetAtProvider().Deployment):
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgDeployment + strings.ToLower(cr.GetAtProvider().Deployment.Reason)))
+	case cr.GetAtProvider().State == "STARTED":
 		cr.SetConditions(xpv1.Available())
-	case "STOPPED":
+	case cr.GetAtProvider().State == "STOPPED":
 		cr.SetConditions(xpv1.Unavailable())
 	default:
 		cr.SetConditions(xpv1.Unavailable())
 	}
 
-	return app.IsUpToDate(cr, cr.Spec.ForProvider, cr.Status.AtProvider)
+	return app.IsUpToDate(cr, *cr.GetForProvider(), *cr.GetAtProvider())
 }
 
 // Create managed resource
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.App)
+	cr, ok := mg.(v1alpha1.AppManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errWrongKind)
 	}
 
-	dockerCredentials, err := getDockerCredential(ctx, c.kube, cr.Spec.ForProvider)
+	dockerCredentials, err := getDockerCredential(ctx, c.kube, cr)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errSecret)
 	}
 
 	cr.SetConditions(xpv1.Creating())
 
-	application, err := c.client.CreateAndPush(ctx, cr, cr.Spec.ForProvider, dockerCredentials)
+	application, err := c.client.CreateAndPush(ctx, cr, *cr.GetForProvider(), dockerCredentials)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateResource)
 	}
@@ -213,7 +249,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Update managed resource
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1alpha1.App)
+	cr, ok := mg.(v1alpha1.AppManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errWrongKind)
 	}
@@ -225,7 +261,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 	guid := meta.GetExternalName(cr)
 
-	changes, err := app.DetectChanges(cr, cr.Spec.ForProvider, cr.Status.AtProvider)
+	changes, err := app.DetectChanges(cr, *cr.GetForProvider(), *cr.GetAtProvider())
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateResource+": Failed to detect changes")
 	}
@@ -237,25 +273,130 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalUpdate{}, nil
 }
 
-func (c *external) applyAppUpdates(ctx context.Context, guid string, cr *v1alpha1.App, changes *app.ChangeDetection) error {
-	dockerChanged, err := c.updateDockerImageIfChanged(ctx, guid, cr, changes)
-	if err != nil {
-		return err
+func (c *external) applyAppUpdates(ctx context.Context, guid string, cr v1alpha1.AppManaged, changes *app.ChangeDetection) error {
+	// A deployment action is applied on its own, the other changes are
+	// applied on the next reconciliation.
+	if changes.HasField("deployment_action") {
+		return c.applyDeploymentAction(ctx, guid, cr)
 	}
 
-	if err := c.updateEnvironmentIfChanged(ctx, guid, cr, changes, dockerChanged); err != nil {
+	if err := c.rollOutIfChanged(ctx, guid, cr, changes); err != nil {
 		return err
 	}
 
-	if !changes.HasOtherChanges("docker_image", "environment") {
+	if !changes.HasOtherChanges("docker_image", "environment", "sidecars") {
 		return nil
 	}
 
-	_, err = c.client.Update(ctx, guid, cr, cr.Spec.ForProvider)
+	_, err := c.client.Update(ctx, guid, cr, *cr.GetForProvider())
 	return errors.Wrap(err, errUpdateResource)
 }
 
-func (c *external) updateDockerImageIfChanged(ctx context.Context, guid string, cr *v1alpha1.App, changes *app.ChangeDetection) (bool, error) {
+// rollOutIfChanged rolls out a changed docker image, environment or sidecars.
+// A started app with a strategy is deployed without downtime, otherwise the
+// app is pushed or restarted.
+func (c *external) rollOutIfChanged(ctx context.Context, guid string, cr v1alpha1.AppManaged, changes *app.ChangeDetection) error {
+	if !changes.HasField("docker_image") && !changes.HasField("environment") && !changes.HasField("sidecars") {
+		return nil
+	}
+	if changes.HasField("sidecars") {
+		if err := c.client.UpdateSidecars(ctx, guid, *cr.GetForProvider()); err != nil {
+			return errors.Wrap(err, errUpdateResource)
+		}
+	}
+	if cr.GetForProvider().Strategy != nil && cr.GetAtProvider().State == "STARTED" {
+		return c.deploy(ctx, guid, cr, changes)
+	}
+
+	dockerChanged, err := c.updateDockerImageIfChanged(ctx, guid, cr, changes)
+	if err != nil {
+		return err
+	}
+	if changes.HasField("environment") {
+		return c.updateEnvironmentIfChanged(ctx, guid, cr, changes, dockerChanged)
+	}
+	if dockerChanged {
+		return nil
+	}
+	// only the sidecars changed
+	return c.restart(ctx, guid, cr)
+}
+
+// deploy updates the environment of the app and creates a deployment that
+// rolls out the changes, including updated sidecars, with the strategy of the
+// app. A changed docker image is staged into a new droplet first.
+func (c *external) deploy(ctx context.Context, guid string, cr v1alpha1.AppManaged, changes *app.ChangeDetection) error {
+	if changes.HasField("environment") {
+		// the deployment restarts the app with the new environment
+		if err := c.updateEnvVars(ctx, guid, cr, true); err != nil {
+			return err
+		}
+	}
+
+	var droplet string
+	if changes.HasField("docker_image") {
+		dockerCredentials, err := getDockerCredential(ctx, c.kube, cr)
+		if err != nil {
+			return errors.Wrap(err, errSecret)
+		}
+		droplet, err = c.client.Deployment.Stage(ctx, guid, cr.GetForProvider().Docker.Image, (*cfresource.DockerCredentials)(dockerCredentials))
+		if err != nil {
+			return errors.Wrap(err, errDeploy)
+		}
+	}
+
+	d, err := c.client.Deployment.Create(ctx, deployment.GenerateCreate(guid, droplet, *cr.GetForProvider()))
+	if err != nil {
+		return errors.Wrap(err, errDeploy)
+	}
+	cr.GetAtProvider().Deployment = deployment.GenerateObservation(d)
+	return nil
+}
+
+// applyDeploymentAction continues, cancels or rolls back the latest deployment
+// as requested by the deployment action annotation, and removes the
+// annotation. An action that does not apply to the state of the deployment is
+// ignored.
+func (c *external) applyDeploymentAction(ctx context.Context, guid string, cr v1alpha1.AppManaged) error {
+	action := cr.GetAnnotations()[deployment.ActionKey]
+	d := cr.GetAtProvider().Deployment
+
+	var err error
+	switch action {
+	case deployment.ActionContinue:
+		if deployment.IsActive(d) && d.Reason == deployment.ReasonPaused {
+			err = c.client.Deployment.Continue(ctx, d.GUID)
+		}
+	case deployment.ActionCancel:
+		if deployment.IsActive(d) {
+			err = c.client.Deployment.Cancel(ctx, d.GUID)
+		}
+	case deployment.ActionRollback:
+		switch {
+		case deployment.IsActive(d):
+			// canceling an active deployment rolls back to the previous droplet
+			err = c.client.Deployment.Cancel(ctx, d.GUID)
+		case d != nil && d.PreviousDroplet != "" && !d.RolledBack:
+			_, err = c.client.Deployment.Create(ctx, deployment.GenerateRollback(guid, d, *cr.GetForProvider()))
+		}
+	}
+	if err != nil {
+		return errors.Wrapf(err, errDeploymentAction, action)
+	}
+
+	// The annotation is removed from a copy, the update reads back the stored
+	// status and would discard the status observed in this reconciliation.
+	u := cr.DeepCopyObject().(v1alpha1.AppManaged)
+	meta.RemoveAnnotations(u, deployment.ActionKey)
+	if err := c.kube.Update(ctx, u); err != nil {
+		return errors.Wrap(err, errUpdateCR)
+	}
+	meta.RemoveAnnotations(cr, deployment.ActionKey)
+	cr.SetResourceVersion(u.GetResourceVersion())
+	return nil
+}
+
+func (c *external) updateDockerImageIfChanged(ctx context.Context, guid string, cr v1alpha1.AppManaged, changes *app.ChangeDetection) (bool, error) {
 	if !changes.HasField("docker_image") {
 		return false, nil
 	}
@@ -265,7 +406,7 @@ func (c *external) updateDockerImageIfChanged(ctx context.Context, guid string,
 	return true, nil
 }
 
-func (c *external) updateEnvironmentIfChanged(ctx context.Context, guid string, cr *v1alpha1.App, changes *app.ChangeDetection, dockerChanged bool) error {
+func (c *external) updateEnvironmentIfChanged(ctx context.Context, guid string, cr v1alpha1.AppManaged, changes *app.ChangeDetection, dockerChanged bool) error {
 	if !changes.HasField("environment") {
 		return nil
 	}
@@ -273,23 +414,23 @@ func (c *external) updateEnvironmentIfChanged(ctx context.Context, guid string,
 }
 
 // updateDockerImage pushes a new docker image for the app.
-func (c *external) updateDockerImage(ctx context.Context, guid string, cr *v1alpha1.App) error {
-	dockerCredentials, err := getDockerCredential(ctx, c.kube, cr.Spec.ForProvider)
+func (c *external) updateDockerImage(ctx context.Context, guid string, cr v1alpha1.AppManaged) error {
+	dockerCredentials, err := getDockerCredential(ctx, c.kube, cr)
 	if err != nil {
 		return errors.Wrap(err, errSecret)
 	}
-	_, err = c.client.UpdateAndPush(ctx, guid, cr, cr.Spec.ForProvider, dockerCredentials)
+	_, err = c.client.UpdateAndPush(ctx, guid, cr, *cr.GetForProvider(), dockerCredentials)
 	return errors.Wrap(err, errUpdateResource)
 }
 
 // updateEnvVars updates the environment variables of the app via the CF API directly.
 // It sets new/updated vars and sends nil for vars that exist in CF but were removed from spec.
 // If the app is currently STOPPED, the restart is skipped (env vars take effect on next start).
-// If dockerAlsoChanged is true, the restart is also skipped because the docker push already restarted the app.
-func (c *external) updateEnvVars(ctx context.Context, guid string, cr *v1alpha1.App, dockerAlsoChanged bool) error {
+// If skipRestart is true, the restart is also skipped because the docker push or the deployment restarts the app.
+func (c *external) updateEnvVars(ctx context.Context, guid string, cr v1alpha1.AppManaged, skipRestart bool) error {
 	// Build desired env vars from spec
 	envVars := map[string]*string{}
-	for k, v := range cr.Spec.ForProvider.Environment {
+	for k, v := range cr.GetForProvider().Environment {
 		v := v
 		envVars[k] = &v
 	}
@@ -308,21 +449,30 @@ func (c *external) updateEnvVars(ctx context.Context, guid string, cr *v1alpha1.
 		return errors.Wrap(err, errUpdateResource)
 	}
 	// Restart the app so the updated environment takes effect in the running process.
-	// Skip if the app is stopped (env vars take effect on next start) or if
-	// docker was also updated (the push already restarted the app).
-	if cr.Status.AtProvider.State == "STOPPED" || dockerAlsoChanged {
+	// Skip if the app is restarted otherwise (the push or the deployment restarts the app).
+	if skipRestart {
+		return nil
+	}
+	return c.restart(ctx, guid, cr)
+}
+
+// restart restarts the app so that updated settings take effect in the running
+// process. A stopped app is not started, the settings take effect on its next
+// start.
+func (c *external) restart(ctx context.Context, guid string, cr v1alpha1.AppManaged) error {
+	if cr.GetAtProvider().State == "STOPPED" {
 		return nil
 	}
-	if _, err = c.client.Stop(ctx, guid); err != nil {
+	if _, err := c.client.Stop(ctx, guid); err != nil {
 		return errors.Wrap(err, errUpdateResource)
 	}
-	_, err = c.client.Start(ctx, guid)
+	_, err := c.client.Start(ctx, guid)
 	return errors.Wrap(err, errUpdateResource)
 }
 
 // Delete managed resource
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.App)
+	cr, ok := mg.(v1alpha1.AppManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errWrongKind)
 	}
@@ -353,12 +503,12 @@ func (c *external) Disconnect(ctx context.Context) error {
 // resolveExternalName sets the external-name on the App CR if it is empty,
 // by looking up the app by spec (name and space).
 // Returns (lateInitialized, exists, error).
-func (c *external) resolveExternalName(ctx context.Context, cr *v1alpha1.App) (bool, bool, error) {
+func (c *external) resolveExternalName(ctx context.Context, cr v1alpha1.AppManaged) (bool, bool, error) {
 	if meta.GetExternalName(cr) != "" {
 		return false, true, nil
 	}
 
-	res, err := c.client.GetBySpec(ctx, cr.Spec.ForProvider)
+	res, err := c.client.GetBySpec(ctx, *cr.GetForProvider())
 	if err != nil {
 		if clients.ErrorIsNotFound(err) {
 			return false, false, nil
@@ -371,13 +521,14 @@ func (c *external) resolveExternalName(ctx context.Context, cr *v1alpha1.App) (b
 }
 
 // getDockerCredential extracts the Docker credentials from the secret
-func getDockerCredential(ctx context.Context, kube k8s.Client, forProvider v1alpha1.AppParameters) (*app.DockerCredentials, error) {
+func getDockerCredential(ctx context.Context, kube k8s.Client, cr v1alpha1.AppManaged) (*app.DockerCredentials, error) {
+	forProvider := cr.GetForProvider()
 	// return immediately if the lifecycle is not docker or credentials are not provided
 	if forProvider.Lifecycle != "docker" || forProvider.Docker == nil || forProvider.Docker.Credentials == nil {
 		return nil, nil
 	}
 
-	buf, err := clients.ExtractSecret(ctx, kube, forProvider.Docker.Credentials, ".dockerconfigjson")
+	buf, err := clients.ExtractManagedSecret(ctx, kube, cr, forProvider.Docker.Credentials, ".dockerconfigjson")
 	if err != nil {
 		return nil, errors.Wrap(err, errSecret)
 	}
@@ -409,12 +560,12 @@ type spaceInitializer initializer
 
 // / Initialize implements the Initializer interface
 func (c *spaceInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
-	cr, ok := mg.(*v1alpha1.App)
+	cr, ok := mg.(v1alpha1.AppManaged)
 	if !ok {
 		return errors.New(errWrongKind)
 	}
 
-	if cr.Spec.ForProvider.SpaceRef != nil || cr.Spec.ForProvider.SpaceSelector != nil {
+	if cr.GetForProvider().SpaceRef != nil || cr.GetForProvider().SpaceSelector != nil {
 		return cr.ResolveReferences(ctx, c.kube)
 	}
 
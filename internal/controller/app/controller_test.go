@@ -20,6 +20,7 @@ import (
 
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/app"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/deployment"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
 )
 
@@ -29,6 +30,28 @@ var (
 	spaceGUID   = "a46808d1-d09a-4eef-add1-30872dec82f7"
 	guid        = "2d8b0d04-d537-4e4e-8c6f-f09ca0e7f56f"
 	envVarValue = "hello"
+
+	deploymentGUID = "5f0c8e2a-3b1d-4c6e-9a7f-2d4b6c8e0a1f"
+	dropletGUID    = "8a1b3c5d-7e9f-4a2b-8c4d-6e8f0a2b4c6d"
+
+	activeDeployment = &deployment.Deployment{
+		Resource: cfresource.Resource{GUID: deploymentGUID},
+		Strategy: deployment.StrategyRolling,
+		Status:   deployment.DeploymentStatus{Value: deployment.DeploymentActive, Reason: deployment.ReasonDeploying},
+	}
+	pausedDeployment = &deployment.Deployment{
+		Resource: cfresource.Resource{GUID: deploymentGUID},
+		Strategy: deployment.StrategyCanary,
+		Status:   deployment.DeploymentStatus{Value: deployment.DeploymentActive, Reason: deployment.ReasonPaused},
+	}
+	finalizedDeployment = &deployment.Deployment{
+		Resource:        cfresource.Resource{GUID: deploymentGUID},
+		Strategy:        deployment.StrategyRolling,
+		Status:          deployment.DeploymentStatus{Value: deployment.DeploymentFinalized, Reason: deployment.ReasonDeployed},
+		PreviousDroplet: &cfresource.Relationship{GUID: dropletGUID},
+	}
+
+	sidecar = v1alpha1.SidecarConfiguration{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}, Memory: ptr.To(uint(64))}
 )
 
 func assertErrAndObs[T any](t *testing.T, wantErr, gotErr error, wantObs, gotObs T) {
@@ -117,6 +140,36 @@ func withLabels(labels map[string]*string) modifier {
 	}
 }
 
+func withStrategy(strategy string) modifier {
+	return func(r *v1alpha1.App) {
+		r.Spec.ForProvider.Strategy = &strategy
+	}
+}
+
+func withDeployment(d *v1alpha1.AppDeploymentObservation) modifier {
+	return func(r *v1alpha1.App) {
+		r.Status.AtProvider.Deployment = d
+	}
+}
+
+func withSidecars(sidecars ...v1alpha1.SidecarConfiguration) modifier {
+	return func(r *v1alpha1.App) {
+		r.Spec.ForProvider.Sidecars = &sidecars
+	}
+}
+
+func withObservedSidecars(sidecars ...v1alpha1.SidecarConfiguration) modifier {
+	return func(r *v1alpha1.App) {
+		r.Status.AtProvider.Sidecars = sidecars
+	}
+}
+
+func withAnnotation(k, v string) modifier {
+	return func(r *v1alpha1.App) {
+		r.Annotations[k] = v
+	}
+}
+
 func newApp(typ string, m ...modifier) *v1alpha1.App {
 	r := &v1alpha1.App{
 		TypeMeta: metav1.TypeMeta{
@@ -176,6 +229,8 @@ func TestObserve(t *testing.T) {
 		service      service
 		kube         k8s.Client
 		routeFetcher *fake.MockRouteFetcher
+		deployment   *fake.MockDeployment
+		sidecar      *fake.MockSidecar
 		push         func() *fake.MockPush
 	}{
 		"Nil": {
@@ -492,6 +547,125 @@ func TestObserve(t *testing.T) {
 				return m
 			}(),
 		},
+		"PausedCanaryDeployment": {
+			args: args{
+				mg: newApp("docker", withExternalName(guid), withSpace(spaceGUID), withDefaultMetadataLabels(), withStrategy("canary")),
+			},
+			want: want{
+				mg: newApp("docker",
+					withExternalName(guid),
+					withSpace(spaceGUID),
+					withStrategy("canary"),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withAppManifest("applications:\n- name: "+name),
+					withDeployment(deployment.GenerateObservation(pausedDeployment)),
+					withConditions(xpv1.Unavailable().WithMessage("deployment is paused")),
+					withObservedLabels(map[string]*string{
+						"crossplane-kind": ptr.To("app.cloudfoundry.crossplane.io"),
+						"crossplane-name": ptr.To("my-app"),
+					}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			service: func() *fake.MockApp {
+				m := &fake.MockApp{}
+				m.On("Get", guid).Return(
+					&fake.NewApp("docker").SetName(name).SetGUID(guid).SetLabels(map[string]*string{
+						"crossplane-kind": ptr.To("app.cloudfoundry.crossplane.io"),
+						"crossplane-name": ptr.To("my-app"),
+					}).SetState("STARTED").App,
+					nil,
+				)
+				return m
+			},
+			deployment: func() *fake.MockDeployment {
+				m := &fake.MockDeployment{}
+				m.On("Latest", guid).Return(pausedDeployment, nil)
+				return m
+			}(),
+		},
+		"UserSidecarsRemoved": {
+			args: args{
+				mg: newApp("docker", withExternalName(guid), withSpace(spaceGUID), withDefaultMetadataLabels(), withSidecars()),
+			},
+			want: want{
+				mg: newApp("docker",
+					withExternalName(guid),
+					withSpace(spaceGUID),
+					withSidecars(),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withAppManifest("applications:\n- name: "+name),
+					withObservedSidecars(v1alpha1.SidecarConfiguration{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}}),
+					withConditions(xpv1.Available()),
+					withObservedLabels(map[string]*string{
+						"crossplane-kind": ptr.To("app.cloudfoundry.crossplane.io"),
+						"crossplane-name": ptr.To("my-app"),
+					}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+			service: func() *fake.MockApp {
+				m := &fake.MockApp{}
+				m.On("Get", guid).Return(
+					&fake.NewApp("docker").SetName(name).SetGUID(guid).SetLabels(map[string]*string{
+						"crossplane-kind": ptr.To("app.cloudfoundry.crossplane.io"),
+						"crossplane-name": ptr.To("my-app"),
+					}).SetState("STARTED").App,
+					nil,
+				)
+				return m
+			},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", guid).Return([]*cfresource.Sidecar{
+					{Resource: cfresource.Resource{GUID: "proxy-guid"}, Name: "proxy", Command: "./proxy", ProcessTypes: []string{"web"}, Origin: "user"},
+					{Resource: cfresource.Resource{GUID: "agent-guid"}, Name: "agent", Command: "./agent", ProcessTypes: []string{"web"}, Origin: "buildpack"},
+				}, nil)
+				return m
+			}(),
+		},
+		"BuildpackSidecarsIgnored": {
+			args: args{
+				mg: newApp("docker", withExternalName(guid), withSpace(spaceGUID), withDefaultMetadataLabels(), withSidecars()),
+			},
+			want: want{
+				mg: newApp("docker",
+					withExternalName(guid),
+					withSpace(spaceGUID),
+					withSidecars(),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withAppManifest("applications:\n- name: "+name),
+					withObservedSidecars(),
+					withConditions(xpv1.Available()),
+					withObservedLabels(map[string]*string{
+						"crossplane-kind": ptr.To("app.cloudfoundry.crossplane.io"),
+						"crossplane-name": ptr.To("my-app"),
+					}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			service: func() *fake.MockApp {
+				m := &fake.MockApp{}
+				m.On("Get", guid).Return(
+					&fake.NewApp("docker").SetName(name).SetGUID(guid).SetLabels(map[string]*string{
+						"crossplane-kind": ptr.To("app.cloudfoundry.crossplane.io"),
+						"crossplane-name": ptr.To("my-app"),
+					}).SetState("STARTED").App,
+					nil,
+				)
+				return m
+			},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", guid).Return([]*cfresource.Sidecar{
+					{Resource: cfresource.Resource{GUID: "agent-guid"}, Name: "agent", Command: "./agent", ProcessTypes: []string{"web"}, Origin: "buildpack"},
+				}, nil)
+				return m
+			}(),
+		},
 	}
 
 	for n, tc := range cases {
@@ -514,6 +688,12 @@ func TestObserve(t *testing.T) {
 			if tc.routeFetcher != nil {
 				c.client.RouteFetcher = tc.routeFetcher
 			}
+			if tc.deployment != nil {
+				c.client.Deployment = tc.deployment
+			}
+			if tc.sidecar != nil {
+				c.client.Sidecars = tc.sidecar
+			}
 
 			obs, err := c.Observe(context.Background(), tc.args.mg)
 
@@ -661,10 +841,12 @@ func TestUpdate(t *testing.T) {
 	}
 
 	cases := map[string]struct {
-		args    args
-		want    want
-		service service
-		push    func() *fake.MockPush
+		args       args
+		want       want
+		service    service
+		push       func() *fake.MockPush
+		deployment func() *fake.MockDeployment
+		sidecar    func() *fake.MockSidecar
 		job
 		kube k8s.Client
 	}{
@@ -1002,6 +1184,357 @@ func TestUpdate(t *testing.T) {
 			},
 		},
 
+		"RollingImageUpdate": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withImage("cloudfoundry/test-app:v2"),
+					withStrategy("rolling"),
+					withAppManifest("applications:\n- name: "+name+"\n  docker:\n    image: cloudfoundry/test-app:v1")),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withImage("cloudfoundry/test-app:v2"),
+					withStrategy("rolling"),
+					withAppManifest("applications:\n- name: "+name+"\n  docker:\n    image: cloudfoundry/test-app:v1"),
+					withDeployment(deployment.GenerateObservation(activeDeployment))),
+			},
+			service: func() *fake.MockApp {
+				m := &fake.MockApp{}
+				m.On("Update", guid).Return(&fake.NewApp("docker").SetName(name).SetGUID(guid).App, nil)
+				return m
+			},
+			deployment: func() *fake.MockDeployment {
+				m := &fake.MockDeployment{}
+				spec := newApp("docker", withImage("cloudfoundry/test-app:v2"), withStrategy("rolling")).Spec.ForProvider
+				m.On("Stage", guid, "cloudfoundry/test-app:v2").Return(dropletGUID, nil)
+				m.On("Create", deployment.GenerateCreate(guid, dropletGUID, spec)).Return(activeDeployment, nil)
+				return m
+			},
+		},
+
+		"RollingEnvVarUpdate": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withStrategy("rolling"),
+					withEnvironment(map[string]string{"MY_VAR": envVarValue})),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withStrategy("rolling"),
+					withEnvironment(map[string]string{"MY_VAR": envVarValue}),
+					withDeployment(deployment.GenerateObservation(activeDeployment))),
+			},
+			service: func() *fake.MockApp {
+				m := &fake.MockApp{}
+				v := envVarValue
+				m.On("GetEnvironmentVariables", guid).Return(map[string]*string{}, nil)
+				m.On("SetEnvironmentVariables", guid, map[string]*string{"MY_VAR": &v}).Return(map[string]*string{}, nil)
+				m.On("Update", guid).Return(&fake.NewApp("docker").SetName(name).SetGUID(guid).App, nil)
+				return m
+			},
+			deployment: func() *fake.MockDeployment {
+				m := &fake.MockDeployment{}
+				spec := newApp("docker", withStrategy("rolling"), withEnvironment(map[string]string{"MY_VAR": envVarValue})).Spec.ForProvider
+				m.On("Create", deployment.GenerateCreate(guid, "", spec)).Return(activeDeployment, nil)
+				return m
+			},
+		},
+
+		"DeployError": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withImage("cloudfoundry/test-app:v2"),
+					withStrategy("rolling")),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withImage("cloudfoundry/test-app:v2"),
+					withStrategy("rolling")),
+				err: errors.Wrap(errBoom, errDeploy),
+			},
+			service: func() *fake.MockApp {
+				return &fake.MockApp{}
+			},
+			deployment: func() *fake.MockDeployment {
+				m := &fake.MockDeployment{}
+				m.On("Stage", guid, "cloudfoundry/test-app:v2").Return("", errBoom)
+				return m
+			},
+		},
+
+		"ContinueCanary": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withStrategy("canary"),
+					withDeployment(deployment.GenerateObservation(pausedDeployment)),
+					withAnnotation(deployment.ActionKey, deployment.ActionContinue)),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withStrategy("canary"),
+					withDeployment(deployment.GenerateObservation(pausedDeployment))),
+			},
+			service: func() *fake.MockApp {
+				return &fake.MockApp{}
+			},
+			deployment: func() *fake.MockDeployment {
+				m := &fake.MockDeployment{}
+				m.On("Continue", deploymentGUID).Return(nil)
+				return m
+			},
+		},
+
+		"ActionKeepsObservedStatus": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withStrategy("canary"),
+					withDeployment(deployment.GenerateObservation(pausedDeployment)),
+					withConditions(xpv1.Unavailable().WithMessage("deployment is paused")),
+					withAnnotation(deployment.ActionKey, deployment.ActionContinue)),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withStrategy("canary"),
+					withDeployment(deployment.GenerateObservation(pausedDeployment)),
+					withConditions(xpv1.Unavailable().WithMessage("deployment is paused"))),
+			},
+			service: func() *fake.MockApp {
+				return &fake.MockApp{}
+			},
+			deployment: func() *fake.MockDeployment {
+				m := &fake.MockDeployment{}
+				m.On("Continue", deploymentGUID).Return(nil)
+				return m
+			},
+			kube: &test.MockClient{
+				MockUpdate: func(_ context.Context, obj k8s.Object, _ ...k8s.UpdateOption) error {
+					// the API server reads back the stored status on update
+					obj.(*v1alpha1.App).Status = v1alpha1.AppStatus{}
+					return nil
+				},
+			},
+		},
+
+		"CancelFinalizedDeployment": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withStrategy("rolling"),
+					withDeployment(deployment.GenerateObservation(finalizedDeployment)),
+					withAnnotation(deployment.ActionKey, deployment.ActionCancel)),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withStrategy("rolling"),
+					withDeployment(deployment.GenerateObservation(finalizedDeployment))),
+			},
+			service: func() *fake.MockApp {
+				return &fake.MockApp{}
+			},
+			deployment: func() *fake.MockDeployment {
+				return &fake.MockDeployment{}
+			},
+		},
+
+		"RollbackFinalizedDeployment": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withStrategy("rolling"),
+					withDeployment(deployment.GenerateObservation(finalizedDeployment)),
+					withAnnotation(deployment.ActionKey, deployment.ActionRollback)),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withStrategy("rolling"),
+					withDeployment(deployment.GenerateObservation(finalizedDeployment))),
+			},
+			service: func() *fake.MockApp {
+				return &fake.MockApp{}
+			},
+			deployment: func() *fake.MockDeployment {
+				m := &fake.MockDeployment{}
+				spec := newApp("docker", withStrategy("rolling")).Spec.ForProvider
+				m.On("Create", deployment.GenerateRollback(guid, deployment.GenerateObservation(finalizedDeployment), spec)).Return(activeDeployment, nil)
+				return m
+			},
+		},
+
+		"SidecarCreated": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withAppManifest("applications:\n- name: "+name),
+					withSidecars(sidecar)),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withAppManifest("applications:\n- name: "+name),
+					withSidecars(sidecar)),
+			},
+			service: func() *fake.MockApp {
+				m := &fake.MockApp{}
+				m.On("Stop", guid).Return(&fake.NewApp("docker").SetName(name).SetGUID(guid).App, nil)
+				m.On("Start", guid).Return(&fake.NewApp("docker").SetName(name).SetGUID(guid).App, nil)
+				m.On("Update", guid).Return(&fake.NewApp("docker").SetName(name).SetGUID(guid).App, nil)
+				return m
+			},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", guid).Return([]*cfresource.Sidecar{}, nil)
+				m.On("Create", guid, cfresource.NewSidecarCreate("proxy", "./proxy", []string{"web"}).WithMemoryInMB(64)).Return(&cfresource.Sidecar{}, nil)
+				return m
+			},
+		},
+
+		"SidecarDeletedOnStoppedApp": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STOPPED"),
+					withObservedName(name),
+					withAppManifest("applications:\n- name: "+name),
+					withObservedSidecars(
+						v1alpha1.SidecarConfiguration{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}},
+						v1alpha1.SidecarConfiguration{Name: "old", Command: ptr.To("./old"), ProcessTypes: []string{"web"}}),
+					withSidecars(v1alpha1.SidecarConfiguration{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}})),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STOPPED"),
+					withObservedName(name),
+					withAppManifest("applications:\n- name: "+name),
+					withObservedSidecars(
+						v1alpha1.SidecarConfiguration{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}},
+						v1alpha1.SidecarConfiguration{Name: "old", Command: ptr.To("./old"), ProcessTypes: []string{"web"}}),
+					withSidecars(v1alpha1.SidecarConfiguration{Name: "proxy", Command: ptr.To("./proxy"), ProcessTypes: []string{"web"}})),
+			},
+			service: func() *fake.MockApp {
+				m := &fake.MockApp{}
+				m.On("Update", guid).Return(&fake.NewApp("docker").SetName(name).SetGUID(guid).App, nil)
+				return m
+			},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", guid).Return([]*cfresource.Sidecar{
+					{Resource: cfresource.Resource{GUID: "proxy-guid"}, Name: "proxy", Command: "./proxy", ProcessTypes: []string{"web"}, Origin: "user"},
+					{Resource: cfresource.Resource{GUID: "old-guid"}, Name: "old", Command: "./old", ProcessTypes: []string{"web"}, Origin: "user"},
+				}, nil)
+				m.On("Delete", "old-guid").Return(nil)
+				return m
+			},
+		},
+
+		"SidecarError": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withAppManifest("applications:\n- name: "+name),
+					withSidecars(sidecar)),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withObservedName(name),
+					withAppManifest("applications:\n- name: "+name),
+					withSidecars(sidecar)),
+				err: errors.Wrap(errBoom, errUpdateResource),
+			},
+			service: func() *fake.MockApp {
+				return &fake.MockApp{}
+			},
+			sidecar: func() *fake.MockSidecar {
+				m := &fake.MockSidecar{}
+				m.On("ListForAppAll", guid).Return([]*cfresource.Sidecar{}, errBoom)
+				return m
+			},
+		},
+
+		"UnknownDeploymentAction": {
+			args: args{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withStrategy("canary"),
+					withAnnotation(deployment.ActionKey, "promote")),
+			},
+			want: want{
+				mg: newApp("docker",
+					withSpace(spaceGUID),
+					withExternalName(guid),
+					withStatus(guid, "STARTED"),
+					withStrategy("canary"),
+					withAnnotation(deployment.ActionKey, "promote")),
+			},
+			service: func() *fake.MockApp {
+				m := &fake.MockApp{}
+				m.On("Update", guid).Return(&fake.NewApp("docker").SetName(name).SetGUID(guid).App, nil)
+				return m
+			},
+		},
+
 		"EmptyGUID": {
 			args: args{
 				mg: newApp("docker", withSpace(spaceGUID)),
@@ -1035,6 +1568,20 @@ func TestUpdate(t *testing.T) {
 					PushClient: pushMock,
 				},
 			}
+			if tc.kube != nil {
+				c.kube = tc.kube
+			}
+			var deploymentMock *fake.MockDeployment
+			if tc.deployment != nil {
+				deploymentMock = tc.deployment()
+				c.client.Deployment = deploymentMock
+			}
+
+			var sidecarMock *fake.MockSidecar
+			if tc.sidecar != nil {
+				sidecarMock = tc.sidecar()
+				c.client.Sidecars = sidecarMock
+			}
 
 			obs, err := c.Update(context.Background(), tc.args.mg)
 
@@ -1046,6 +1593,12 @@ func TestUpdate(t *testing.T) {
 			if tc.push != nil {
 				pushMock.AssertExpectations(t)
 			}
+			if deploymentMock != nil {
+				deploymentMock.AssertExpectations(t)
+			}
+			if sidecarMock != nil {
+				sidecarMock.AssertExpectations(t)
+			}
 		})
 	}
 }
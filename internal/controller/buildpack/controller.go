@@ -0,0 +1,293 @@
+package buildpack
+
+import (
+	"context"
+	"time"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/buildpack"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
+)
+
+const (
+	resourceType         = "Buildpack"
+	externalSystem       = "Cloud Foundry"
+	errWrongKind         = "managed resource is not of kind " + resourceType
+	errTrackUsage        = "cannot track usage"
+	errGetClient         = "cannot create a client to talk to the API of " + externalSystem
+	errGetDownloadClient = "cannot create a client to download the bits of the " + resourceType
+	errGet               = "cannot get " + resourceType + " in " + externalSystem
+	errGetJob            = "cannot get the upload job of the " + resourceType
+	errCreate            = "cannot create " + resourceType + " in " + externalSystem
+	errUpdate            = "cannot update " + resourceType
+	errFetchBits         = "cannot fetch the bits of the " + resourceType
+	errUpload            = "cannot upload the bits of the " + resourceType
+	errDelete            = "cannot delete " + resourceType
+	msgUploadInProcess   = "processing of the uploaded bits in progress"
+	msgUploadFailed      = "processing of the uploaded bits failed: "
+	msgAwaitingUpload    = "the buildpack awaits the upload of its bits"
+)
+
+// Setup adds controllers that reconcile cluster scoped and namespaced
+// Buildpack managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := setup(mgr, o, v1alpha1.Buildpack_GroupVersionKind, &v1alpha1.Buildpack{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.Buildpack_GroupVersionKind, &nsv1alpha1.Buildpack{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+
+	options := []managed.ReconcilerOption{
+		managed.WithInitializers(),
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
+			kube:  mgr.GetClient(),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithTimeout(5 * time.Minute), // increase timeout for downloading and uploading the bits
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		options = append(options, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(gvk),
+		options...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
+}
+
+// A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
+type connector struct {
+	kube  k8s.Client
+	usage resource.Tracker
+}
+
+// Connect tracks the usage of the ProviderConfig and creates a
+// Buildpack client from its credentials.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(v1alpha1.BuildpackManaged); !ok {
+		return nil, errors.New(errWrongKind)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetClient)
+	}
+
+	hc, err := clients.GetDownloadClient(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetDownloadClient)
+	}
+
+	b, j := buildpack.NewClient(cf)
+	return &external{client: b, job: j, fetch: buildpack.NewFetcher(hc)}, nil
+}
+
+// An external is a managed.ExternalClient that is using the CloudFoundry API to observe and modify resources.
+type external struct {
+	client buildpack.Client
+	job    job.Tracker
+	fetch  buildpack.Fetcher
+}
+
+// Disconnect implements the managed.ExternalClient interface
+func (c *external) Disconnect(ctx context.Context) error {
+	// No cleanup needed for Cloud Foundry client
+	return nil
+}
+
+// Observe managed resource Buildpack
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(v1alpha1.BuildpackManaged)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errWrongKind)
+	}
+
+	lateInitialized := false
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		// adopt an existing buildpack of the same name and stack
+		b, err := buildpack.FindBySpec(ctx, c.client, *cr.GetForProvider())
+		if err != nil {
+			if clients.ErrorIsNotFound(err) {
+				return managed.ExternalObservation{ResourceExists: false}, nil
+			}
+			return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+		}
+		guid = b.GUID
+		meta.SetExternalName(cr, guid)
+		lateInitialized = true
+	}
+
+	if !clients.IsValidGUID(guid) {
+		return managed.ExternalObservation{}, errors.Errorf("external-name '%s' is not a valid GUID format", guid)
+	}
+
+	b, err := c.client.Get(ctx, guid)
+	if err != nil {
+		if clients.ErrorIsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	lastJob := cr.GetAtProvider().LastJob
+	if lastJob != nil && job.IsInProgress(cfresource.JobState(lastJob.State)) {
+		j, err := c.job.Get(ctx, lastJob.GUID)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetJob)
+		}
+		lastJob = buildpack.GenerateJob(j)
+	}
+
+	obs := buildpack.GenerateObservation(b)
+	obs.Checksum = cr.GetAtProvider().Checksum
+	obs.LastJob = lastJob
+	*cr.GetAtProvider() = obs
+
+	upToDate := buildpack.IsUpToDate(cr, *cr.GetForProvider(), b)
+	switch {
+	case lastJob != nil && job.IsInProgress(cfresource.JobState(lastJob.State)):
+		// do not interrupt the processing of the uploaded bits with an update
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgUploadInProcess))
+		upToDate = true
+	case lastJob != nil && lastJob.State == string(cfresource.JobStateFailed):
+		// the bits are uploaded again once the checksum of the source changes
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgUploadFailed + lastJob.Errors))
+		upToDate = upToDate && !buildpack.NeedsUpload(cr.GetForProvider().Source, obs)
+	case b.State == buildpack.StateAwaitingUpload:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgAwaitingUpload))
+		upToDate = upToDate && !buildpack.NeedsUpload(cr.GetForProvider().Source, obs)
+	default:
+		cr.SetConditions(xpv1.Available())
+		upToDate = upToDate && !buildpack.NeedsUpload(cr.GetForProvider().Source, obs)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+// Create managed resource Buildpack. The bits are uploaded by Update, as the
+// reconciler reverts the status changes made during Create that record the
+// upload.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(v1alpha1.BuildpackManaged)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	b, err := c.client.Create(ctx, buildpack.GenerateCreate(cr, *cr.GetForProvider()))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, b.GUID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update managed resource Buildpack and upload its bits if it has none yet
+// or their checksum has changed
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(v1alpha1.BuildpackManaged)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errWrongKind)
+	}
+
+	guid := meta.GetExternalName(cr)
+	if _, err := c.client.Update(ctx, guid, buildpack.GenerateUpdate(cr, *cr.GetForProvider(), cr.GetAtProvider().Stack)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	if source := cr.GetForProvider().Source; buildpack.NeedsUpload(source, *cr.GetAtProvider()) {
+		if err := c.upload(ctx, cr, guid, *source); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete managed resource Buildpack
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(v1alpha1.BuildpackManaged)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	jobGUID, err := c.client.Delete(ctx, guid)
+	if err != nil {
+		if clients.ErrorIsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
+	}
+
+	return managed.ExternalDelete{}, job.PollJobComplete(ctx, c.job, jobGUID)
+}
+
+// upload fetches the bits from the source and uploads them to the buildpack.
+// The upload job and the checksum of the bits are recorded, so that Observe
+// tracks the processing of the bits and detects a new checksum.
+func (c *external) upload(ctx context.Context, cr v1alpha1.BuildpackManaged, guid string, source v1alpha1.BuildpackSource) error {
+	bits, err := c.fetch(ctx, source)
+	if err != nil {
+		return errors.Wrap(err, errFetchBits)
+	}
+	defer bits.Close() //nolint:errcheck
+
+	jobGUID, _, err := c.client.Upload(ctx, guid, bits.FileName, bits)
+	if err != nil {
+		return errors.Wrap(err, errUpload)
+	}
+
+	cr.GetAtProvider().LastJob = &v1alpha1.BuildpackJob{GUID: jobGUID, State: string(cfresource.JobStateProcessing)}
+	cr.GetAtProvider().Checksum = ptr.To(buildpack.Checksum(source))
+	return nil
+}
@@ -0,0 +1,575 @@
+package buildpack
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/buildpack"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	resourceName = "my-buildpack"
+	guid         = "6f1d8c7a-3b2e-4f5a-9c8d-7e6f5a4b3c2d"
+	name         = "my_buildpack"
+	jobGUID      = "job-guid"
+	checksum     = "sha256:" + strings.Repeat("a", 64)
+	newChecksum  = "sha256:" + strings.Repeat("b", 64)
+	source       = &v1alpha1.BuildpackSource{
+		URL:      ptr.To("https://example.com/my_buildpack.zip"),
+		Checksum: ptr.To(checksum),
+	}
+)
+
+type modifier func(*v1alpha1.Buildpack)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.Buildpack) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withSource(s *v1alpha1.BuildpackSource) modifier {
+	return func(r *v1alpha1.Buildpack) {
+		r.Spec.ForProvider.Source = s
+	}
+}
+
+func withChecksum(checksum string) modifier {
+	return func(r *v1alpha1.Buildpack) {
+		r.Status.AtProvider.Checksum = ptr.To(checksum)
+	}
+}
+
+func withLastJob(guid string, state cfresource.JobState, errs string) modifier {
+	return func(r *v1alpha1.Buildpack) {
+		r.Status.AtProvider.LastJob = &v1alpha1.BuildpackJob{GUID: guid, State: string(state), Errors: errs}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.Buildpack) { r.Status.SetConditions(c...) }
+}
+
+func newBuildpack(m ...modifier) *v1alpha1.Buildpack {
+	r := &v1alpha1.Buildpack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.BuildpackSpec{
+			ForProvider: v1alpha1.BuildpackParameters{
+				Name: name,
+			},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+func cfBuildpack(m ...func(*cfresource.Buildpack)) *cfresource.Buildpack {
+	b := &cfresource.Buildpack{
+		Resource: cfresource.Resource{GUID: guid},
+		Name:     name,
+		Stack:    ptr.To("cflinuxfs4"),
+		Position: 1,
+		Enabled:  true,
+		State:    "READY",
+		Metadata: metadata.BuildMetadata(newBuildpack(), nil, nil),
+	}
+	for _, f := range m {
+		f(b)
+	}
+	return b
+}
+
+func awaitingUpload(b *cfresource.Buildpack) {
+	b.State = buildpack.StateAwaitingUpload
+}
+
+func fetch(err error) buildpack.Fetcher {
+	return func(_ context.Context, _ v1alpha1.BuildpackSource) (*buildpack.Bits, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &buildpack.Bits{ReadCloser: io.NopCloser(strings.NewReader("zip")), FileName: "my_buildpack.zip"}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockBuildpack
+		job     func() *fake.MockJob
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockBuildpack { return &fake.MockBuildpack{} },
+			job:     func() *fake.MockJob { return &fake.MockJob{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"NotFoundByName": {
+			mg: newBuildpack(),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Single").Return(fake.BuildpackNil, fake.ErrNoResultReturned)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  newBuildpack(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AdoptByName": {
+			mg: newBuildpack(),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Single").Return(cfBuildpack(), nil)
+				m.On("Get", guid).Return(cfBuildpack(), nil)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  newBuildpack(withExternalName(guid), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"InvalidGUID": {
+			mg:      newBuildpack(withExternalName("not-a-guid")),
+			service: func() *fake.MockBuildpack { return &fake.MockBuildpack{} },
+			job:     func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  newBuildpack(withExternalName("not-a-guid")),
+				err: errors.New("external-name 'not-a-guid' is not a valid GUID format"),
+			},
+		},
+		"AwaitingUpload": {
+			mg: newBuildpack(withExternalName(guid), withSource(source)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Get", guid).Return(cfBuildpack(awaitingUpload), nil)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  newBuildpack(withExternalName(guid), withSource(source), withConditions(xpv1.Unavailable().WithMessage(msgAwaitingUpload))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"UploadInProgress": {
+			mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum), withLastJob(jobGUID, cfresource.JobStateProcessing, "")),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Get", guid).Return(cfBuildpack(awaitingUpload), nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("Get", jobGUID).Return(&cfresource.Job{Resource: cfresource.Resource{GUID: jobGUID}, State: cfresource.JobStateProcessing}, nil)
+				return j
+			},
+			want: want{
+				mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum), withLastJob(jobGUID, cfresource.JobStateProcessing, ""),
+					withConditions(xpv1.Unavailable().WithMessage(msgUploadInProcess))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UploadCompleted": {
+			mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum), withLastJob(jobGUID, cfresource.JobStateProcessing, "")),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Get", guid).Return(cfBuildpack(), nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("Get", jobGUID).Return(&cfresource.Job{Resource: cfresource.Resource{GUID: jobGUID}, State: cfresource.JobStateComplete}, nil)
+				return j
+			},
+			want: want{
+				mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum), withLastJob(jobGUID, cfresource.JobStateComplete, ""),
+					withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UploadFailed": {
+			mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum), withLastJob(jobGUID, cfresource.JobStateProcessing, "")),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Get", guid).Return(cfBuildpack(awaitingUpload), nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("Get", jobGUID).Return(&cfresource.Job{
+					Resource: cfresource.Resource{GUID: jobGUID},
+					State:    cfresource.JobStateFailed,
+					Errors:   []cfresource.CloudFoundryError{{Detail: "invalid zip"}},
+				}, nil)
+				return j
+			},
+			want: want{
+				mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum), withLastJob(jobGUID, cfresource.JobStateFailed, "invalid zip"),
+					withConditions(xpv1.Unavailable().WithMessage(msgUploadFailed+"invalid zip"))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ChecksumChanged": {
+			mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(newChecksum)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Get", guid).Return(cfBuildpack(), nil)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  newBuildpack(withExternalName(guid), withSource(source), withChecksum(newChecksum), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"PositionOutdated": {
+			mg: newBuildpack(withExternalName(guid), func(r *v1alpha1.Buildpack) { r.Spec.ForProvider.Position = ptr.To(3) }),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Get", guid).Return(cfBuildpack(), nil)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  newBuildpack(withExternalName(guid), func(r *v1alpha1.Buildpack) { r.Spec.ForProvider.Position = ptr.To(3) }, withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"GetJobError": {
+			mg: newBuildpack(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateProcessing, "")),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Get", guid).Return(cfBuildpack(), nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("Get", jobGUID).Return(nil, errBoom)
+				return j
+			},
+			want: want{
+				mg:  newBuildpack(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateProcessing, "")),
+				err: errors.Wrap(errBoom, errGetJob),
+			},
+		},
+		"Deleted": {
+			mg: newBuildpack(withExternalName(guid)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Get", guid).Return(fake.BuildpackNil, cfresource.NewResourceNotFoundError())
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  newBuildpack(withExternalName(guid)),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			mg: newBuildpack(withExternalName(guid)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Get", guid).Return(fake.BuildpackNil, errBoom)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  newBuildpack(withExternalName(guid)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc, j := tc.service(), tc.job()
+			c := &external{client: svc, job: j}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				opts := cmp.Options{test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.BuildpackObservation{},
+					"ID", "Name", "Stack", "Position", "Enabled", "Locked", "State", "Filename", "CreatedAt", "UpdatedAt", "ResourceMetadata")}
+				if diff := cmp.Diff(tc.want.mg, tc.mg, opts); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+			svc.AssertExpectations(t)
+			j.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockBuildpack
+		want    want
+	}{
+		"WithoutSource": {
+			mg: newBuildpack(),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				create := cfresource.NewBuildpackCreate(name)
+				create.Metadata = metadata.BuildMetadata(newBuildpack(), nil, nil)
+				m.On("Create", create).Return(cfBuildpack(awaitingUpload), nil)
+				return m
+			},
+			want: want{
+				mg: newBuildpack(withExternalName(guid), withConditions(xpv1.Creating())),
+			},
+		},
+		"WithSource": {
+			mg: newBuildpack(withSource(source)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Create", mock.Anything).Return(cfBuildpack(awaitingUpload), nil)
+				return m
+			},
+			want: want{
+				mg: newBuildpack(withExternalName(guid), withSource(source), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			mg: newBuildpack(),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Create", mock.Anything).Return(fake.BuildpackNil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  newBuildpack(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want mg, +got mg:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockBuildpack
+		fetch   buildpack.Fetcher
+		want    want
+	}{
+		"UpToDateBits": {
+			mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Update", guid, mock.Anything).Return(cfBuildpack(), nil)
+				return m
+			},
+			want: want{
+				mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum)),
+			},
+		},
+		"UploadNewBits": {
+			mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(newChecksum)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Update", guid, mock.Anything).Return(cfBuildpack(), nil)
+				m.On("Upload", guid, "my_buildpack.zip").Return(jobGUID, fake.BuildpackNil, nil)
+				return m
+			},
+			fetch: fetch(nil),
+			want: want{
+				mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum), withLastJob(jobGUID, cfresource.JobStateProcessing, "")),
+			},
+		},
+		"FailedUploadSameBits": {
+			mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum), withLastJob("old-job", cfresource.JobStateFailed, "invalid zip")),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Update", guid, mock.Anything).Return(cfBuildpack(awaitingUpload), nil)
+				return m
+			},
+			want: want{
+				mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum), withLastJob("old-job", cfresource.JobStateFailed, "invalid zip")),
+			},
+		},
+		"FailedUploadNewBits": {
+			mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(newChecksum), withLastJob("old-job", cfresource.JobStateFailed, "invalid zip")),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Update", guid, mock.Anything).Return(cfBuildpack(), nil)
+				m.On("Upload", guid, "my_buildpack.zip").Return(jobGUID, fake.BuildpackNil, nil)
+				return m
+			},
+			fetch: fetch(nil),
+			want: want{
+				mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(checksum), withLastJob(jobGUID, cfresource.JobStateProcessing, "")),
+			},
+		},
+		"FetchError": {
+			mg: newBuildpack(withExternalName(guid), withSource(source)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Update", guid, mock.Anything).Return(cfBuildpack(awaitingUpload), nil)
+				return m
+			},
+			fetch: fetch(errBoom),
+			want: want{
+				mg:  newBuildpack(withExternalName(guid), withSource(source)),
+				err: errors.Wrap(errBoom, errFetchBits),
+			},
+		},
+		"UploadError": {
+			mg: newBuildpack(withExternalName(guid), withSource(source), withChecksum(newChecksum)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Update", guid, mock.Anything).Return(cfBuildpack(), nil)
+				m.On("Upload", guid, "my_buildpack.zip").Return("", fake.BuildpackNil, errBoom)
+				return m
+			},
+			fetch: fetch(nil),
+			want: want{
+				mg:  newBuildpack(withExternalName(guid), withSource(source), withChecksum(newChecksum)),
+				err: errors.Wrap(errBoom, errUpload),
+			},
+		},
+		"UpdateError": {
+			mg: newBuildpack(withExternalName(guid)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Update", guid, mock.Anything).Return(fake.BuildpackNil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  newBuildpack(withExternalName(guid)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc, fetch: tc.fetch}
+			_, err := c.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Update(...): -want mg, +got mg:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockBuildpack
+		job     func() *fake.MockJob
+		err     error
+	}{
+		"Success": {
+			mg: newBuildpack(withExternalName(guid)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Delete", guid).Return(jobGUID, nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("PollComplete").Return(nil)
+				return j
+			},
+		},
+		"AlreadyDeleted": {
+			mg: newBuildpack(withExternalName(guid)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Delete", guid).Return("", cfresource.NewResourceNotFoundError())
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+		},
+		"DeleteError": {
+			mg: newBuildpack(withExternalName(guid)),
+			service: func() *fake.MockBuildpack {
+				m := &fake.MockBuildpack{}
+				m.On("Delete", guid).Return("", errBoom)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			err: errors.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc, j := tc.service(), tc.job()
+			c := &external{client: svc, job: j}
+			_, err := c.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+			j.AssertExpectations(t)
+		})
+	}
+}
@@ -10,14 +10,26 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/app"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/buildpack"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/domain"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/environmentvariablegroup"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/featureflag"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/isolationsegment"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/networkpolicy"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/org"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/orgmembers"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/orgquota"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/orgrole"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/securitygroup"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/servicebroker"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/serviceoffering"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/serviceplan"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/serviceplanvisibility"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/serviceroutebinding"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/spacemembers"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/spacerole"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/task"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/user"
 
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/route"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/controller/servicecredentialbinding"
@@ -33,6 +45,7 @@ import (
 func CustomSetup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		providerconfig.Setup,
+		providerconfig.SetupHealth,
 		app.Setup,
 		org.Setup,
 		orgrole.Setup,
@@ -46,7 +59,19 @@ func CustomSetup(mgr ctrl.Manager, o controller.Options) error {
 		servicecredentialbinding.Setup,
 		spacequota.Setup,
 		domain.Setup,
+		buildpack.Setup,
+		environmentvariablegroup.Setup,
+		featureflag.Setup,
+		isolationsegment.Setup,
+		networkpolicy.Setup,
+		securitygroup.Setup,
+		servicebroker.Setup,
+		serviceoffering.Setup,
+		serviceplan.Setup,
+		serviceplanvisibility.Setup,
 		serviceroutebinding.Setup,
+		task.Setup,
+		user.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
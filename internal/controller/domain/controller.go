@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -17,9 +18,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	pcv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	domain "github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/domain"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/org"
@@ -41,16 +43,24 @@ const (
 	errUpdate        = "cannot update " + resourceType
 )
 
-// Setup adds a controller that reconciles Domain resources.
+// Setup adds controllers that reconcile cluster scoped and namespaced Domain
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.Domain_GroupKind)
+	if err := setup(mgr, o, v1alpha1.Domain_GroupVersionKind, &v1alpha1.Domain{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.Domain_GroupVersionKind, &nsv1alpha1.Domain{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
 
-		managed.WithExternalConnector(&connector{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
 			kube:  mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &pcv1beta1.ProviderConfigUsage{}),
-		}),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -64,20 +74,20 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.Domain_GroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.Domain{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
 type connector struct {
 	kube  k8s.Client
-	usage resource.LegacyTracker
+	usage resource.Tracker
 }
 
 // Connect typically produces an ExternalClient by:
@@ -86,11 +96,11 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.Domain); !ok {
+	if _, ok := mg.(v1alpha1.DomainManaged); !ok {
 		return nil, errors.New(errNotDomainKind)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackUsage)
 	}
 
@@ -127,7 +137,7 @@ type external struct {
 
 // Observe managed resource Domain
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.Domain)
+	cr, ok := mg.(v1alpha1.DomainManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotDomainKind)
 	}
@@ -159,12 +169,12 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	cr.Status.AtProvider = domain.GenerateObservation(d)
+	*cr.GetAtProvider() = domain.GenerateObservation(d)
 	cr.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
-		ResourceExists:          cr.Status.AtProvider.ID != nil,
-		ResourceUpToDate:        domain.IsUpToDate(cr, cr.Spec.ForProvider, d),
+		ResourceExists:          cr.GetAtProvider().ID != nil,
+		ResourceUpToDate:        domain.IsUpToDate(cr, *cr.GetForProvider(), d),
 		ResourceLateInitialized: resourceLateInitialized,
 	}, nil
 }
@@ -172,12 +182,12 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 // resolveExternalName sets the external-name on the Domain CR if it is empty,
 // by looking up the domain by spec.
 // Returns (lateInitialized, exists, error).
-func (c *external) resolveExternalName(ctx context.Context, cr *v1alpha1.Domain) (bool, bool, error) {
+func (c *external) resolveExternalName(ctx context.Context, cr v1alpha1.DomainManaged) (bool, bool, error) {
 	if meta.GetExternalName(cr) != "" {
 		return false, true, nil
 	}
 
-	d, err := c.client.FindDomainBySpec(ctx, cr.Spec.ForProvider)
+	d, err := c.client.FindDomainBySpec(ctx, *cr.GetForProvider())
 	if err != nil {
 		if clients.ErrorIsNotFound(err) {
 			return false, false, nil
@@ -194,14 +204,14 @@ func (c *external) resolveExternalName(ctx context.Context, cr *v1alpha1.Domain)
 
 // Create a managed resource Domain
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.Domain)
+	cr, ok := mg.(v1alpha1.DomainManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotDomainKind)
 	}
 
 	cr.SetConditions(xpv1.Creating())
 
-	o, err := c.client.Create(ctx, domain.GenerateCreate(cr, cr.Spec.ForProvider))
+	o, err := c.client.Create(ctx, domain.GenerateCreate(cr, *cr.GetForProvider()))
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
@@ -217,7 +227,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Update managed resource Domain
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1alpha1.Domain)
+	cr, ok := mg.(v1alpha1.DomainManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotDomainKind)
 	}
@@ -227,7 +237,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
-	_, err := c.client.Update(ctx, *cr.Status.AtProvider.ID, domain.GenerateUpdate(cr, cr.Spec.ForProvider))
+	_, err := c.client.Update(ctx, *cr.GetAtProvider().ID, domain.GenerateUpdate(cr, *cr.GetForProvider()))
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 	}
@@ -237,7 +247,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Delete managed resource Domain
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.Domain)
+	cr, ok := mg.(v1alpha1.DomainManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotDomainKind)
 	}
@@ -267,27 +277,27 @@ type initializer struct {
 // Initialize method resolves the references which are not resolved by
 // the crossplane reconciler.
 func (i initializer) Initialize(ctx context.Context, mg resource.Managed) error {
-	cr, ok := mg.(*v1alpha1.Domain)
+	cr, ok := mg.(v1alpha1.DomainManaged)
 	if !ok {
 		return errors.New(errNotDomainKind)
 	}
 
 	// check if the name is already set
-	if cr.Spec.ForProvider.Name == "" {
+	if cr.GetForProvider().Name == "" {
 		// if name is not set, calculate name by domain and subdomain
-		if cr.Spec.ForProvider.SubDomain == nil || cr.Spec.ForProvider.Domain == nil {
+		if cr.GetForProvider().SubDomain == nil || cr.GetForProvider().Domain == nil {
 			return errors.New(errNameRequired) // if subdomain is not set
 		}
 
-		cr.Spec.ForProvider.Name = fmt.Sprintf("%s.%s", *cr.Spec.ForProvider.SubDomain, *cr.Spec.ForProvider.Domain)
+		cr.GetForProvider().Name = fmt.Sprintf("%s.%s", *cr.GetForProvider().SubDomain, *cr.GetForProvider().Domain)
 	}
 	// Resolve orgRef/orgSelector references so spec.Org is populated before Observe/Create
-	if cr.Spec.ForProvider.OrgRef != nil || cr.Spec.ForProvider.OrgSelector != nil {
+	if cr.GetForProvider().OrgRef != nil || cr.GetForProvider().OrgSelector != nil {
 		return cr.ResolveReferences(ctx, i.client)
 	}
 
 	// If orgName is provided, resolve by orgName
-	if cr.Spec.ForProvider.OrgName != nil {
+	if cr.GetForProvider().OrgName != nil {
 		return org.ResolveByName(ctx, clients.ClientFnBuilder(ctx, i.client), mg)
 	}
 
@@ -0,0 +1,273 @@
+package environmentvariablegroup
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/environmentvariablegroup"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
+)
+
+const (
+	resourceType    = "EnvironmentVariableGroup"
+	externalSystem  = "Cloud Foundry"
+	errWrongKind    = "managed resource is not of kind " + resourceType
+	errTrackUsage   = "cannot track usage"
+	errGetClient    = "cannot create a client to talk to the API of " + externalSystem
+	errGet          = "cannot get " + resourceType + " in " + externalSystem
+	errGetVariables = "cannot get the environment variables of the " + resourceType
+	errUpdate       = "cannot update " + resourceType
+	errDelete       = "cannot remove the environment variables of the " + resourceType
+	errForeignRef   = "%s %q must be in the namespace %q of the managed resource"
+	errGetSecret    = "cannot get Secret %q"
+	errGetConfigMap = "cannot get ConfigMap %q"
+)
+
+// Setup adds controllers that reconcile cluster scoped and namespaced
+// EnvironmentVariableGroup managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := setup(mgr, o, v1alpha1.EnvironmentVariableGroup_GroupVersionKind, &v1alpha1.EnvironmentVariableGroup{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.EnvironmentVariableGroup_GroupVersionKind, &nsv1alpha1.EnvironmentVariableGroup{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+
+	options := []managed.ReconcilerOption{
+		managed.WithInitializers(),
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
+			kube:  mgr.GetClient(),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		options = append(options, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(gvk),
+		options...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
+}
+
+// A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
+type connector struct {
+	kube  k8s.Client
+	usage resource.Tracker
+}
+
+// Connect tracks the usage of the ProviderConfig and creates an
+// EnvironmentVariableGroup client from its credentials.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(v1alpha1.EnvironmentVariableGroupManaged); !ok {
+		return nil, errors.New(errWrongKind)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetClient)
+	}
+
+	return &external{kube: c.kube, client: environmentvariablegroup.NewClient(cf)}, nil
+}
+
+// An external is a managed.ExternalClient that is using the CloudFoundry API to observe and modify resources.
+type external struct {
+	kube   k8s.Client
+	client environmentvariablegroup.Client
+}
+
+// Disconnect implements the managed.ExternalClient interface
+func (c *external) Disconnect(ctx context.Context) error {
+	// No cleanup needed for Cloud Foundry client
+	return nil
+}
+
+// Observe managed resource EnvironmentVariableGroup. Environment variable
+// groups always exist; once the resource is deleted, the group is gone when
+// all its variables have been removed.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(v1alpha1.EnvironmentVariableGroupManaged)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errWrongKind)
+	}
+
+	lateInitialized := false
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		name = cr.GetForProvider().Type
+		meta.SetExternalName(cr, name)
+		lateInitialized = true
+	}
+
+	g, err := c.client.Get(ctx, name)
+	if err != nil {
+		if clients.ErrorIsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	*cr.GetAtProvider() = environmentvariablegroup.GenerateObservation(g)
+
+	if meta.WasDeleted(cr) {
+		// the referenced Secrets and ConfigMaps may be gone already
+		return managed.ExternalObservation{ResourceExists: !environmentvariablegroup.IsEmpty(g), ResourceUpToDate: true}, nil
+	}
+
+	vars, err := c.variables(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetVariables)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        environmentvariablegroup.IsUpToDate(vars, g),
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+// Create managed resource EnvironmentVariableGroup. Environment variable
+// groups cannot be created, so the desired state is applied like in Update.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(v1alpha1.EnvironmentVariableGroupManaged)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := c.update(ctx, cr)
+	return managed.ExternalCreation{}, err
+}
+
+// Update managed resource EnvironmentVariableGroup
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(v1alpha1.EnvironmentVariableGroupManaged)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errWrongKind)
+	}
+
+	return c.update(ctx, cr)
+}
+
+// update replaces the variables of the group with the desired ones.
+func (c *external) update(ctx context.Context, cr v1alpha1.EnvironmentVariableGroupManaged) (managed.ExternalUpdate, error) {
+	vars, err := c.variables(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetVariables)
+	}
+
+	update := environmentvariablegroup.GenerateUpdate(vars, cr.GetAtProvider().VariableNames)
+	if _, err := c.client.Update(ctx, cr.GetForProvider().Type, update); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete managed resource EnvironmentVariableGroup. All variables are removed
+// from the group; with the deletion policy Orphan they are left as they are.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(v1alpha1.EnvironmentVariableGroupManaged)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	reset := environmentvariablegroup.GenerateReset(cr.GetAtProvider().VariableNames)
+	if _, err := c.client.Update(ctx, name, reset); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(clients.IgnoreNotFoundErr(err), errDelete)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+// variables returns the desired variables of the group: the keys and values
+// of the referenced Secrets and ConfigMaps in their order, overridden by the
+// inline variables. A namespaced managed resource can only reference Secrets
+// and ConfigMaps in its own namespace.
+func (c *external) variables(ctx context.Context, cr v1alpha1.EnvironmentVariableGroupManaged) (map[string]string, error) {
+	spec := cr.GetForProvider()
+	vars := map[string]string{}
+	for _, from := range spec.VariablesFrom {
+		switch {
+		case from.SecretRef != nil:
+			ref := types.NamespacedName{Namespace: from.SecretRef.Namespace, Name: from.SecretRef.Name}
+			if err := checkNamespace(cr, "Secret", ref); err != nil {
+				return nil, err
+			}
+			s := &corev1.Secret{}
+			if err := c.kube.Get(ctx, ref, s); err != nil {
+				return nil, errors.Wrapf(err, errGetSecret, ref.String())
+			}
+			for k, v := range s.Data {
+				vars[k] = string(v)
+			}
+		case from.ConfigMapRef != nil:
+			ref := types.NamespacedName{Namespace: from.ConfigMapRef.Namespace, Name: from.ConfigMapRef.Name}
+			if err := checkNamespace(cr, "ConfigMap", ref); err != nil {
+				return nil, err
+			}
+			cm := &corev1.ConfigMap{}
+			if err := c.kube.Get(ctx, ref, cm); err != nil {
+				return nil, errors.Wrapf(err, errGetConfigMap, ref.String())
+			}
+			for k, v := range cm.Data {
+				vars[k] = v
+			}
+		}
+	}
+	for k, v := range spec.Variables {
+		vars[k] = v
+	}
+	return vars, nil
+}
+
+func checkNamespace(mg resource.Managed, kind string, ref types.NamespacedName) error {
+	if ns := mg.GetNamespace(); ns != "" && ref.Namespace != ns {
+		return errors.Errorf(errForeignRef, kind, ref.String(), ns)
+	}
+	return nil
+}
@@ -0,0 +1,349 @@
+package environmentvariablegroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	resourceName = "running-env"
+	running      = "running"
+)
+
+type modifier func(*v1alpha1.EnvironmentVariableGroup)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.EnvironmentVariableGroup) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withDeletionTimestamp() modifier {
+	return func(r *v1alpha1.EnvironmentVariableGroup) {
+		r.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1, 0)})
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.EnvironmentVariableGroup) { r.Status.SetConditions(c...) }
+}
+
+func withVariablesFrom(from ...v1alpha1.EnvironmentVariableSource) modifier {
+	return func(r *v1alpha1.EnvironmentVariableGroup) {
+		r.Spec.ForProvider.VariablesFrom = from
+	}
+}
+
+func withObservation(names ...string) modifier {
+	return func(r *v1alpha1.EnvironmentVariableGroup) {
+		r.Status.AtProvider = v1alpha1.EnvironmentVariableGroupObservation{
+			Type:          ptr.To(running),
+			VariableNames: names,
+			UpdatedAt:     ptr.To("0001-01-01T00:00:00Z"),
+		}
+	}
+}
+
+func envGroup(m ...modifier) *v1alpha1.EnvironmentVariableGroup {
+	r := &v1alpha1.EnvironmentVariableGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.EnvironmentVariableGroupSpec{
+			ForProvider: v1alpha1.EnvironmentVariableGroupParameters{
+				Type:      running,
+				Variables: map[string]string{"HTTP_PROXY": "http://proxy:3128"},
+			},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+func group(vars map[string]string) *cfresource.EnvVarGroup {
+	return &cfresource.EnvVarGroup{Name: running, Var: vars}
+}
+
+var (
+	secretRef    = v1alpha1.EnvironmentVariableSource{SecretRef: &xpv1.SecretReference{Name: "proxy-auth", Namespace: "default"}}
+	configMapRef = v1alpha1.EnvironmentVariableSource{ConfigMapRef: &v1alpha1.ConfigMapReference{Name: "proxy", Namespace: "default"}}
+)
+
+// kube serves the Secret proxy-auth and the ConfigMap proxy.
+func kube() k8s.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key k8s.ObjectKey, obj k8s.Object) error {
+			switch o := obj.(type) {
+			case *corev1.Secret:
+				if key.Name != "proxy-auth" {
+					return kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, key.Name)
+				}
+				o.Data = map[string][]byte{"PROXY_PASSWORD": []byte("s3cr3t")}
+			case *corev1.ConfigMap:
+				o.Data = map[string]string{"HTTP_PROXY": "http://other:3128", "NO_PROXY": "localhost"}
+			}
+			return nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockEnvironmentVariableGroup
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockEnvironmentVariableGroup { return &fake.MockEnvironmentVariableGroup{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"UpToDate": {
+			mg: envGroup(),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Get", running).Return(group(map[string]string{"HTTP_PROXY": "http://proxy:3128"}), nil)
+				return m
+			},
+			want: want{
+				mg:  envGroup(withExternalName(running), withObservation("HTTP_PROXY"), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"ExtraVariable": {
+			mg: envGroup(withExternalName(running)),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Get", running).Return(group(map[string]string{"HTTP_PROXY": "http://proxy:3128", "FOO": "bar"}), nil)
+				return m
+			},
+			want: want{
+				mg:  envGroup(withExternalName(running), withObservation("FOO", "HTTP_PROXY"), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"SecretAndConfigMapUpToDate": {
+			mg: envGroup(withExternalName(running), withVariablesFrom(configMapRef, secretRef)),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Get", running).Return(group(map[string]string{
+					"HTTP_PROXY":     "http://proxy:3128",
+					"NO_PROXY":       "localhost",
+					"PROXY_PASSWORD": "s3cr3t",
+				}), nil)
+				return m
+			},
+			want: want{
+				mg: envGroup(withExternalName(running), withVariablesFrom(configMapRef, secretRef),
+					withObservation("HTTP_PROXY", "NO_PROXY", "PROXY_PASSWORD"), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SecretValueChanged": {
+			mg: envGroup(withExternalName(running), withVariablesFrom(secretRef)),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Get", running).Return(group(map[string]string{"HTTP_PROXY": "http://proxy:3128", "PROXY_PASSWORD": "old"}), nil)
+				return m
+			},
+			want: want{
+				mg: envGroup(withExternalName(running), withVariablesFrom(secretRef),
+					withObservation("HTTP_PROXY", "PROXY_PASSWORD"), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"SecretNotFound": {
+			mg: envGroup(withExternalName(running), withVariablesFrom(v1alpha1.EnvironmentVariableSource{SecretRef: &xpv1.SecretReference{Name: "missing", Namespace: "default"}})),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Get", running).Return(group(nil), nil)
+				return m
+			},
+			want: want{
+				mg: envGroup(withExternalName(running), withVariablesFrom(v1alpha1.EnvironmentVariableSource{SecretRef: &xpv1.SecretReference{Name: "missing", Namespace: "default"}}),
+					withObservation()),
+				err: errors.Wrap(errors.Wrapf(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "missing"), errGetSecret, "default/missing"), errGetVariables),
+			},
+		},
+		"RemovedOnDeletion": {
+			mg: envGroup(withExternalName(running), withDeletionTimestamp()),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Get", running).Return(group(map[string]string{}), nil)
+				return m
+			},
+			want: want{
+				mg:  envGroup(withExternalName(running), withDeletionTimestamp(), withObservation()),
+				obs: managed.ExternalObservation{ResourceExists: false, ResourceUpToDate: true},
+			},
+		},
+		"NotYetRemovedOnDeletion": {
+			mg: envGroup(withExternalName(running), withDeletionTimestamp(), withVariablesFrom(v1alpha1.EnvironmentVariableSource{SecretRef: &xpv1.SecretReference{Name: "missing", Namespace: "default"}})),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Get", running).Return(group(map[string]string{"HTTP_PROXY": "http://proxy:3128"}), nil)
+				return m
+			},
+			want: want{
+				mg: envGroup(withExternalName(running), withDeletionTimestamp(), withVariablesFrom(v1alpha1.EnvironmentVariableSource{SecretRef: &xpv1.SecretReference{Name: "missing", Namespace: "default"}}),
+					withObservation("HTTP_PROXY")),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GetError": {
+			mg: envGroup(withExternalName(running)),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Get", running).Return(nil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  envGroup(withExternalName(running)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{kube: kube(), client: m}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockEnvironmentVariableGroup
+		err     error
+	}{
+		"ReplaceVariables": {
+			mg: envGroup(withExternalName(running), withVariablesFrom(secretRef), withObservation("FOO", "HTTP_PROXY")),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Update", running, map[string]*string{
+					"FOO":            nil,
+					"HTTP_PROXY":     ptr.To("http://proxy:3128"),
+					"PROXY_PASSWORD": ptr.To("s3cr3t"),
+				}).Return(group(nil), nil)
+				return m
+			},
+		},
+		"ForeignNamespace": {
+			mg: func() resource.Managed {
+				r := &nsv1alpha1.EnvironmentVariableGroup{ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "team-a"}}
+				r.Spec.ForProvider = v1alpha1.EnvironmentVariableGroupParameters{Type: running, VariablesFrom: []v1alpha1.EnvironmentVariableSource{configMapRef}}
+				return r
+			}(),
+			service: func() *fake.MockEnvironmentVariableGroup { return &fake.MockEnvironmentVariableGroup{} },
+			err:     errors.Wrap(errors.Errorf(errForeignRef, "ConfigMap", "default/proxy", "team-a"), errGetVariables),
+		},
+		"UpdateError": {
+			mg: envGroup(withExternalName(running)),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Update", running, map[string]*string{"HTTP_PROXY": ptr.To("http://proxy:3128")}).Return(nil, errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errUpdate),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{kube: kube(), client: m}
+			_, err := c.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockEnvironmentVariableGroup
+		err     error
+	}{
+		"RemoveVariables": {
+			mg: envGroup(withExternalName(running), withObservation("HTTP_PROXY", "NO_PROXY")),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Update", running, map[string]*string{"HTTP_PROXY": nil, "NO_PROXY": nil}).Return(group(nil), nil)
+				return m
+			},
+		},
+		"NoExternalName": {
+			mg:      envGroup(),
+			service: func() *fake.MockEnvironmentVariableGroup { return &fake.MockEnvironmentVariableGroup{} },
+		},
+		"UpdateError": {
+			mg: envGroup(withExternalName(running), withObservation("HTTP_PROXY")),
+			service: func() *fake.MockEnvironmentVariableGroup {
+				m := &fake.MockEnvironmentVariableGroup{}
+				m.On("Update", running, map[string]*string{"HTTP_PROXY": nil}).Return(nil, errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{client: m}
+			_, err := c.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
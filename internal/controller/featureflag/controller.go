@@ -0,0 +1,208 @@
+package featureflag
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/featureflag"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
+)
+
+const (
+	resourceType   = "FeatureFlag"
+	externalSystem = "Cloud Foundry"
+	errWrongKind   = "managed resource is not of kind " + resourceType
+	errTrackUsage  = "cannot track usage"
+	errGetClient   = "cannot create a client to talk to the API of " + externalSystem
+	errGet         = "cannot get " + resourceType + " in " + externalSystem
+	errUpdate      = "cannot update " + resourceType
+	errDelete      = "cannot reset " + resourceType + " to its default"
+)
+
+// Setup adds controllers that reconcile cluster scoped and namespaced
+// FeatureFlag managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := setup(mgr, o, v1alpha1.FeatureFlag_GroupVersionKind, &v1alpha1.FeatureFlag{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.FeatureFlag_GroupVersionKind, &nsv1alpha1.FeatureFlag{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+
+	options := []managed.ReconcilerOption{
+		managed.WithInitializers(),
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
+			kube:  mgr.GetClient(),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		options = append(options, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(gvk),
+		options...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
+}
+
+// A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
+type connector struct {
+	kube  k8s.Client
+	usage resource.Tracker
+}
+
+// Connect tracks the usage of the ProviderConfig and creates a
+// FeatureFlag client from its credentials.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(v1alpha1.FeatureFlagManaged); !ok {
+		return nil, errors.New(errWrongKind)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetClient)
+	}
+
+	return &external{client: featureflag.NewClient(cf)}, nil
+}
+
+// An external is a managed.ExternalClient that is using the CloudFoundry API to observe and modify resources.
+type external struct {
+	client featureflag.Client
+}
+
+// Disconnect implements the managed.ExternalClient interface
+func (c *external) Disconnect(ctx context.Context) error {
+	// No cleanup needed for Cloud Foundry client
+	return nil
+}
+
+// Observe managed resource FeatureFlag. Feature flags always exist; once the
+// resource is deleted, the flag is gone when it has been reset to its default.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(v1alpha1.FeatureFlagManaged)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errWrongKind)
+	}
+
+	lateInitialized := false
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		name = cr.GetForProvider().Name
+		meta.SetExternalName(cr, name)
+		lateInitialized = true
+	}
+
+	f, err := c.client.Get(ctx, name)
+	if err != nil {
+		if clients.ErrorIsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	if meta.WasDeleted(cr) && featureflag.IsDefault(name, f) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	*cr.GetAtProvider() = featureflag.GenerateObservation(f)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        featureflag.IsUpToDate(*cr.GetForProvider(), f),
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+// Create managed resource FeatureFlag. Feature flags cannot be created, so
+// the desired state is applied like in Update.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(v1alpha1.FeatureFlagManaged)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := c.update(ctx, cr)
+	return managed.ExternalCreation{}, err
+}
+
+// Update managed resource FeatureFlag
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(v1alpha1.FeatureFlagManaged)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errWrongKind)
+	}
+
+	return c.update(ctx, cr)
+}
+
+func (c *external) update(ctx context.Context, cr v1alpha1.FeatureFlagManaged) (managed.ExternalUpdate, error) {
+	if _, err := c.client.Update(ctx, meta.GetExternalName(cr), featureflag.GenerateUpdate(*cr.GetForProvider())); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete managed resource FeatureFlag. The feature flag is reset to its
+// default; a feature flag without a known default and, with the deletion
+// policy Orphan, any feature flag is left as it is.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(v1alpha1.FeatureFlagManaged)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	reset, ok := featureflag.GenerateReset(name)
+	if !ok {
+		return managed.ExternalDelete{}, nil
+	}
+
+	if _, err := c.client.Update(ctx, name, reset); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(clients.IgnoreNotFoundErr(err), errDelete)
+	}
+	return managed.ExternalDelete{}, nil
+}
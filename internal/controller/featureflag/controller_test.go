@@ -0,0 +1,281 @@
+package featureflag
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	resourceName = "diego-docker"
+	name         = "diego_docker"
+)
+
+type modifier func(*v1alpha1.FeatureFlag)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.FeatureFlag) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withDeletionTimestamp() modifier {
+	return func(r *v1alpha1.FeatureFlag) {
+		r.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1, 0)})
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.FeatureFlag) { r.Status.SetConditions(c...) }
+}
+
+func withObservation(enabled bool) modifier {
+	return func(r *v1alpha1.FeatureFlag) {
+		r.Status.AtProvider = v1alpha1.FeatureFlagObservation{
+			Name:               ptr.To(name),
+			Enabled:            ptr.To(enabled),
+			CustomErrorMessage: ptr.To(""),
+			UpdatedAt:          ptr.To("0001-01-01T00:00:00Z"),
+		}
+	}
+}
+
+func featureFlag(m ...modifier) *v1alpha1.FeatureFlag {
+	r := &v1alpha1.FeatureFlag{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.FeatureFlagSpec{
+			ForProvider: v1alpha1.FeatureFlagParameters{Name: name, Enabled: ptr.To(true)},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+func flag(enabled bool) *cfresource.FeatureFlag {
+	return &cfresource.FeatureFlag{Name: name, Enabled: enabled}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockFeatureFlag
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockFeatureFlag { return &fake.MockFeatureFlag{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"AdoptByName": {
+			mg: featureFlag(),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Get", name).Return(flag(true), nil)
+				return m
+			},
+			want: want{
+				mg:  featureFlag(withExternalName(name), withObservation(true), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"Drift": {
+			mg: featureFlag(withExternalName(name)),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Get", name).Return(flag(false), nil)
+				return m
+			},
+			want: want{
+				mg:  featureFlag(withExternalName(name), withObservation(false), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ResetOnDeletion": {
+			mg: featureFlag(withExternalName(name), withDeletionTimestamp()),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Get", name).Return(flag(false), nil)
+				return m
+			},
+			want: want{
+				mg:  featureFlag(withExternalName(name), withDeletionTimestamp()),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FlagUnknownToClient": {
+			mg: featureFlag(withExternalName("new_flag")),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Get", "new_flag").Return(flag(true), nil)
+				return m
+			},
+			want: want{
+				mg:  featureFlag(withExternalName("new_flag"), withObservation(true), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FlagUnknownToClientOnDeletion": {
+			mg: featureFlag(withExternalName("new_flag"), withDeletionTimestamp()),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Get", "new_flag").Return(flag(true), nil)
+				return m
+			},
+			want: want{
+				mg:  featureFlag(withExternalName("new_flag"), withDeletionTimestamp()),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			mg: featureFlag(withExternalName(name)),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Get", name).Return(nil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  featureFlag(withExternalName(name)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockFeatureFlag
+		err     error
+	}{
+		"Success": {
+			mg: featureFlag(withExternalName(name)),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Update", name, &cfresource.FeatureFlagUpdate{Enabled: ptr.To(true)}).Return(flag(true), nil)
+				return m
+			},
+		},
+		"ExternalName": {
+			mg: featureFlag(withExternalName("task_creation")),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Update", "task_creation", &cfresource.FeatureFlagUpdate{Enabled: ptr.To(true)}).Return(flag(true), nil)
+				return m
+			},
+		},
+		"UpdateError": {
+			mg: featureFlag(withExternalName(name)),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Update", name, mock.Anything).Return(nil, errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errUpdate),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockFeatureFlag
+		err     error
+	}{
+		"ResetToDefault": {
+			mg: featureFlag(withExternalName(name)),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Update", name, &cfresource.FeatureFlagUpdate{Enabled: ptr.To(false), CustomErrorMessage: ptr.To("")}).Return(flag(false), nil)
+				return m
+			},
+		},
+		"FlagWithoutDefault": {
+			mg:      featureFlag(withExternalName("new_flag")),
+			service: func() *fake.MockFeatureFlag { return &fake.MockFeatureFlag{} },
+		},
+		"NoExternalName": {
+			mg:      featureFlag(),
+			service: func() *fake.MockFeatureFlag { return &fake.MockFeatureFlag{} },
+		},
+		"ResetError": {
+			mg: featureFlag(withExternalName(name)),
+			service: func() *fake.MockFeatureFlag {
+				m := &fake.MockFeatureFlag{}
+				m.On("Update", name, mock.Anything).Return(nil, errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package isolationsegment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/isolationsegment"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
+)
+
+const (
+	resourceType       = "IsolationSegment"
+	externalSystem     = "Cloud Foundry"
+	errWrongKind       = "managed resource is not of kind " + resourceType
+	errTrackUsage      = "cannot track usage"
+	errGetClient       = "cannot create a client to talk to the API of " + externalSystem
+	errGet             = "cannot get " + resourceType + " in " + externalSystem
+	errGetEntitlements = "cannot get the organizations entitled to the " + resourceType
+	errCreate          = "cannot create " + resourceType + " in " + externalSystem
+	errUpdate          = "cannot update " + resourceType
+	errEntitle         = "cannot update the organizations entitled to the " + resourceType
+	errDelete          = "cannot delete " + resourceType
+)
+
+// Setup adds controllers that reconcile cluster scoped and namespaced
+// IsolationSegment managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := setup(mgr, o, v1alpha1.IsolationSegment_GroupVersionKind, &v1alpha1.IsolationSegment{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.IsolationSegment_GroupVersionKind, &nsv1alpha1.IsolationSegment{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+
+	options := []managed.ReconcilerOption{
+		managed.WithInitializers(),
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
+			kube:  mgr.GetClient(),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		options = append(options, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(gvk),
+		options...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
+}
+
+// A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
+type connector struct {
+	kube  k8s.Client
+	usage resource.Tracker
+}
+
+// Connect tracks the usage of the ProviderConfig and creates an
+// IsolationSegment client from its credentials.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(v1alpha1.IsolationSegmentManaged); !ok {
+		return nil, errors.New(errWrongKind)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetClient)
+	}
+
+	return &external{client: isolationsegment.NewClient(cf)}, nil
+}
+
+// An external is a managed.ExternalClient that is using the CloudFoundry API to observe and modify resources.
+type external struct {
+	client isolationsegment.Client
+}
+
+// Disconnect implements the managed.ExternalClient interface
+func (c *external) Disconnect(ctx context.Context) error {
+	// No cleanup needed for Cloud Foundry client
+	return nil
+}
+
+// Observe managed resource IsolationSegment
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(v1alpha1.IsolationSegmentManaged)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errWrongKind)
+	}
+
+	lateInitialized := false
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		// adopt an existing isolation segment of the same name
+		s, err := isolationsegment.FindBySpec(ctx, c.client, *cr.GetForProvider())
+		if err != nil {
+			if clients.ErrorIsNotFound(err) {
+				return managed.ExternalObservation{ResourceExists: false}, nil
+			}
+			return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+		}
+		guid = s.GUID
+		meta.SetExternalName(cr, guid)
+		lateInitialized = true
+	}
+
+	if !clients.IsValidGUID(guid) {
+		return managed.ExternalObservation{}, errors.Errorf("external-name '%s' is not a valid GUID format", guid)
+	}
+
+	s, err := c.client.Get(ctx, guid)
+	if err != nil {
+		if clients.ErrorIsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	orgs, err := c.client.ListOrganizationRelationships(ctx, guid)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEntitlements)
+	}
+
+	*cr.GetAtProvider() = isolationsegment.GenerateObservation(s, orgs)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isolationsegment.IsUpToDate(cr, *cr.GetForProvider(), s, orgs),
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+// Create a managed resource IsolationSegment
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(v1alpha1.IsolationSegmentManaged)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	s, err := c.client.Create(ctx, isolationsegment.GenerateCreate(cr, *cr.GetForProvider()))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, s.GUID)
+
+	if len(cr.GetForProvider().Orgs) > 0 {
+		if err := isolationsegment.UpdateEntitlements(ctx, c.client, s.GUID, cr.GetForProvider().Orgs); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errEntitle)
+		}
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update managed resource IsolationSegment
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(v1alpha1.IsolationSegmentManaged)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errWrongKind)
+	}
+
+	guid := meta.GetExternalName(cr)
+	if _, err := c.client.Update(ctx, guid, isolationsegment.GenerateUpdate(cr, *cr.GetForProvider())); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	if err := isolationsegment.UpdateEntitlements(ctx, c.client, guid, cr.GetForProvider().Orgs); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errEntitle)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete managed resource IsolationSegment. The entitlements are revoked
+// first, as CF refuses to delete an isolation segment entitled to
+// organizations.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(v1alpha1.IsolationSegmentManaged)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	if err := isolationsegment.UpdateEntitlements(ctx, c.client, guid, nil); err != nil {
+		if clients.ErrorIsNotFound(err) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errEntitle)
+	}
+
+	if err := c.client.Delete(ctx, guid); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(clients.IgnoreNotFoundErr(err), errDelete)
+	}
+	return managed.ExternalDelete{}, nil
+}
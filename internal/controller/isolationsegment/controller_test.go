@@ -0,0 +1,399 @@
+package isolationsegment
+
+import (
+	"context"
+	"testing"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	resourceName = "my-isolation-segment"
+	guid         = "0e6a5f3c-2f44-4a4c-9d3b-8a1a3f1b6c11"
+	name         = "my-segment"
+	orgGUID      = "6f5a2c9e-8f3b-4a3e-9a71-6a2b5b0c3e21"
+	otherOrgGUID = "9b1d7e44-3c2a-4f0e-8d6b-2e5c7a9f1b33"
+)
+
+type modifier func(*v1alpha1.IsolationSegment)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.IsolationSegment) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withOrgs(orgs ...string) modifier {
+	return func(r *v1alpha1.IsolationSegment) {
+		for _, org := range orgs {
+			r.Spec.ForProvider.Orgs = append(r.Spec.ForProvider.Orgs, ptr.To(org))
+		}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.IsolationSegment) { r.Status.SetConditions(c...) }
+}
+
+func withObservation(orgs ...string) modifier {
+	return func(r *v1alpha1.IsolationSegment) {
+		r.Status.AtProvider = v1alpha1.IsolationSegmentObservation{
+			ID:        ptr.To(guid),
+			Name:      ptr.To(name),
+			CreatedAt: ptr.To("0001-01-01T00:00:00Z"),
+			UpdatedAt: ptr.To("0001-01-01T00:00:00Z"),
+		}
+		for _, org := range orgs {
+			r.Status.AtProvider.Orgs = append(r.Status.AtProvider.Orgs, ptr.To(org))
+		}
+		if m := observedMetadata(); m != nil {
+			r.Status.AtProvider.Labels = m.Labels
+		}
+	}
+}
+
+func isolationSegment(m ...modifier) *v1alpha1.IsolationSegment {
+	r := &v1alpha1.IsolationSegment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.IsolationSegmentSpec{
+			ForProvider: v1alpha1.IsolationSegmentParameters{Name: name},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+// observedMetadata returns the metadata CF reports for an isolation segment
+// created by the provider.
+func observedMetadata() *cfresource.Metadata {
+	return metadata.BuildMetadata(isolationSegment(), nil, nil)
+}
+
+func segment() *cfresource.IsolationSegment {
+	s := fake.NewIsolationSegment().SetName(name).SetGUID(guid)
+	s.Metadata = observedMetadata()
+	return &s.IsolationSegment
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockIsolationSegment
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockIsolationSegment { return &fake.MockIsolationSegment{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"NotFoundByName": {
+			mg: isolationSegment(),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Single").Return(fake.IsolationSegmentNil, fake.ErrNoResultReturned)
+				return m
+			},
+			want: want{
+				mg:  isolationSegment(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AdoptByName": {
+			mg: isolationSegment(),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Single").Return(segment(), nil)
+				m.On("Get", guid).Return(segment(), nil)
+				m.On("ListOrganizationRelationships", guid).Return([]string{}, nil)
+				return m
+			},
+			want: want{
+				mg:  isolationSegment(withExternalName(guid), withObservation(), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"UpToDate": {
+			mg: isolationSegment(withExternalName(guid), withOrgs(orgGUID)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Get", guid).Return(segment(), nil)
+				m.On("ListOrganizationRelationships", guid).Return([]string{orgGUID}, nil)
+				return m
+			},
+			want: want{
+				mg:  isolationSegment(withExternalName(guid), withOrgs(orgGUID), withObservation(orgGUID), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"EntitlementsOutdated": {
+			mg: isolationSegment(withExternalName(guid), withOrgs(orgGUID)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Get", guid).Return(segment(), nil)
+				m.On("ListOrganizationRelationships", guid).Return([]string{otherOrgGUID}, nil)
+				return m
+			},
+			want: want{
+				mg:  isolationSegment(withExternalName(guid), withOrgs(orgGUID), withObservation(otherOrgGUID), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Deleted": {
+			mg: isolationSegment(withExternalName(guid)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Get", guid).Return(fake.IsolationSegmentNil, cfresource.NewResourceNotFoundError())
+				return m
+			},
+			want: want{
+				mg:  isolationSegment(withExternalName(guid)),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			mg: isolationSegment(withExternalName(guid)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Get", guid).Return(fake.IsolationSegmentNil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  isolationSegment(withExternalName(guid)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			c := &external{client: tc.service()}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockIsolationSegment
+		want    want
+	}{
+		"Success": {
+			mg: isolationSegment(),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Create").Return(segment(), nil)
+				return m
+			},
+			want: want{
+				mg: isolationSegment(withExternalName(guid), withConditions(xpv1.Creating())),
+			},
+		},
+		"SuccessWithOrgs": {
+			mg: isolationSegment(withOrgs(orgGUID)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Create").Return(segment(), nil)
+				m.On("ListOrganizationRelationships", guid).Return([]string{}, nil)
+				m.On("EntitleOrganizations", guid, []string{orgGUID}).Return(&cfresource.IsolationSegmentRelationship{}, nil)
+				return m
+			},
+			want: want{
+				mg: isolationSegment(withOrgs(orgGUID), withExternalName(guid), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			mg: isolationSegment(),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Create").Return(fake.IsolationSegmentNil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  isolationSegment(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"EntitleError": {
+			mg: isolationSegment(withOrgs(orgGUID)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Create").Return(segment(), nil)
+				m.On("ListOrganizationRelationships", guid).Return([]string{}, nil)
+				m.On("EntitleOrganizations", guid, []string{orgGUID}).Return(nil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  isolationSegment(withOrgs(orgGUID), withExternalName(guid), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errEntitle),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want mg, +got mg:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockIsolationSegment
+		err     error
+	}{
+		"EntitleAndRevoke": {
+			mg: isolationSegment(withExternalName(guid), withOrgs(orgGUID)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Update", guid).Return(segment(), nil)
+				m.On("ListOrganizationRelationships", guid).Return([]string{otherOrgGUID}, nil)
+				m.On("EntitleOrganizations", guid, []string{orgGUID}).Return(&cfresource.IsolationSegmentRelationship{}, nil)
+				m.On("RevokeOrganizations", guid, []string{otherOrgGUID}).Return(nil)
+				return m
+			},
+		},
+		"UpdateError": {
+			mg: isolationSegment(withExternalName(guid)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Update", guid).Return(fake.IsolationSegmentNil, errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errUpdate),
+		},
+		"RevokeError": {
+			mg: isolationSegment(withExternalName(guid)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("Update", guid).Return(segment(), nil)
+				m.On("ListOrganizationRelationships", guid).Return([]string{orgGUID}, nil)
+				m.On("RevokeOrganizations", guid, []string{orgGUID}).Return(errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errEntitle),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockIsolationSegment
+		err     error
+	}{
+		"RevokeAndDelete": {
+			mg: isolationSegment(withExternalName(guid), withOrgs(orgGUID)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("ListOrganizationRelationships", guid).Return([]string{orgGUID}, nil)
+				m.On("RevokeOrganizations", guid, []string{orgGUID}).Return(nil)
+				m.On("Delete", guid).Return(nil)
+				return m
+			},
+		},
+		"AlreadyDeleted": {
+			mg: isolationSegment(withExternalName(guid)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("ListOrganizationRelationships", guid).Return(nil, cfresource.NewResourceNotFoundError())
+				return m
+			},
+		},
+		"DeleteError": {
+			mg: isolationSegment(withExternalName(guid)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("ListOrganizationRelationships", guid).Return([]string{}, nil)
+				m.On("Delete", guid).Return(errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errDelete),
+		},
+		"RevokeConflict": {
+			mg: isolationSegment(withExternalName(guid)),
+			service: func() *fake.MockIsolationSegment {
+				m := &fake.MockIsolationSegment{}
+				m.On("ListOrganizationRelationships", guid).Return([]string{orgGUID}, nil)
+				m.On("RevokeOrganizations", guid, []string{orgGUID}).Return(errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errEntitle),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package networkpolicy
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/networkpolicy"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
+)
+
+const (
+	resourceType   = "NetworkPolicy"
+	externalSystem = "Cloud Foundry"
+	errWrongKind   = "managed resource is not of kind " + resourceType
+	errTrackUsage  = "cannot track usage"
+	errGetClient   = "cannot create a client to talk to the API of " + externalSystem
+	errGet         = "cannot get " + resourceType + " in " + externalSystem
+	errCreate      = "cannot create " + resourceType + " in " + externalSystem
+	errUpdate      = "cannot update " + resourceType + " in " + externalSystem
+	errUpdateCR    = "cannot update the managed resource"
+	errDelete      = "cannot delete " + resourceType + " in " + externalSystem
+)
+
+// Setup adds controllers that reconcile cluster scoped and namespaced
+// NetworkPolicy managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := setup(mgr, o, v1alpha1.NetworkPolicy_GroupVersionKind, &v1alpha1.NetworkPolicy{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.NetworkPolicy_GroupVersionKind, &nsv1alpha1.NetworkPolicy{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+
+	options := []managed.ReconcilerOption{
+		managed.WithInitializers(),
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
+			kube:  mgr.GetClient(),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		options = append(options, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(gvk),
+		options...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
+}
+
+// A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
+type connector struct {
+	kube  k8s.Client
+	usage resource.Tracker
+}
+
+// Connect tracks the usage of the ProviderConfig and creates a
+// NetworkPolicy client from its credentials.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(v1alpha1.NetworkPolicyManaged); !ok {
+		return nil, errors.New(errWrongKind)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetClient)
+	}
+
+	return &external{kube: c.kube, client: networkpolicy.NewClient(cf)}, nil
+}
+
+// An external is a managed.ExternalClient that is using the CloudFoundry API to observe and modify resources.
+type external struct {
+	kube   k8s.Client
+	client networkpolicy.Client
+}
+
+// Disconnect implements the managed.ExternalClient interface
+func (c *external) Disconnect(ctx context.Context) error {
+	// No cleanup needed for Cloud Foundry client
+	return nil
+}
+
+// Observe managed resource NetworkPolicy. The policy of the external name is
+// looked up among the policies of its source app; it is up to date if it is
+// the policy of the parameters.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(v1alpha1.NetworkPolicyManaged)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errWrongKind)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := networkpolicy.ParseExternalName(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	policies, err := c.client.List(ctx, observed.Source.ID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+	if networkpolicy.Find(policies, observed) == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	*cr.GetAtProvider() = networkpolicy.GenerateObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	desired, err := networkpolicy.GeneratePolicy(*cr.GetForProvider())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: err == nil && desired == observed,
+	}, nil
+}
+
+// Create managed resource NetworkPolicy.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(v1alpha1.NetworkPolicyManaged)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	desired, err := networkpolicy.GeneratePolicy(*cr.GetForProvider())
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	if err := c.client.Create(ctx, desired); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, networkpolicy.ExternalName(desired))
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update managed resource NetworkPolicy. A policy cannot be changed, so the
+// policy of the parameters is created before the policy of the external name
+// is deleted.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(v1alpha1.NetworkPolicyManaged)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errWrongKind)
+	}
+
+	observed, err := networkpolicy.ParseExternalName(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	desired, err := networkpolicy.GeneratePolicy(*cr.GetForProvider())
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	if err := c.client.Create(ctx, desired); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	// The reconciler does not persist the metadata changed during Update.
+	meta.SetExternalName(cr, networkpolicy.ExternalName(desired))
+	if err := c.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCR)
+	}
+
+	if err := c.client.Delete(ctx, observed); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete managed resource NetworkPolicy.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(v1alpha1.NetworkPolicyManaged)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	observed, err := networkpolicy.ParseExternalName(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+
+	if err := c.client.Delete(ctx, observed); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
+	}
+	return managed.ExternalDelete{}, nil
+}
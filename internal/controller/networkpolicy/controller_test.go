@@ -0,0 +1,389 @@
+package networkpolicy
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/networkpolicy"
+)
+
+var (
+	errBoom         = errors.New("boom")
+	resourceName    = "frontend-to-backend"
+	sourceGUID      = "6f1c2d3e-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	destinationGUID = "2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d"
+	externalName    = sourceGUID + "/" + destinationGUID + "/tcp/8080-8080"
+
+	policy = networkpolicy.Policy{
+		Source: networkpolicy.Source{ID: sourceGUID},
+		Destination: networkpolicy.Destination{
+			ID:       destinationGUID,
+			Protocol: "tcp",
+			Ports:    networkpolicy.Ports{Start: 8080, End: 8080},
+		},
+	}
+	widePolicy = networkpolicy.Policy{
+		Source: networkpolicy.Source{ID: sourceGUID},
+		Destination: networkpolicy.Destination{
+			ID:       destinationGUID,
+			Protocol: "tcp",
+			Ports:    networkpolicy.Ports{Start: 8080, End: 8090},
+		},
+	}
+)
+
+type modifier func(*v1alpha1.NetworkPolicy)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.NetworkPolicy) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.NetworkPolicy) { r.Status.SetConditions(c...) }
+}
+
+func withPorts(start, end int) modifier {
+	return func(r *v1alpha1.NetworkPolicy) {
+		r.Spec.ForProvider.Ports = &v1alpha1.NetworkPolicyPorts{Start: start, End: end}
+	}
+}
+
+func withObservation(p networkpolicy.Policy) modifier {
+	return func(r *v1alpha1.NetworkPolicy) {
+		r.Status.AtProvider = networkpolicy.GenerateObservation(p)
+	}
+}
+
+func newNetworkPolicy(m ...modifier) *v1alpha1.NetworkPolicy {
+	r := &v1alpha1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.NetworkPolicySpec{
+			ForProvider: v1alpha1.NetworkPolicyParameters{
+				Source:      ptr.To(sourceGUID),
+				Destination: ptr.To(destinationGUID),
+				Protocol:    "tcp",
+				Ports:       &v1alpha1.NetworkPolicyPorts{Start: 8080, End: 8080},
+			},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockNetworkPolicy
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockNetworkPolicy { return &fake.MockNetworkPolicy{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"NotCreatedYet": {
+			mg:      newNetworkPolicy(),
+			service: func() *fake.MockNetworkPolicy { return &fake.MockNetworkPolicy{} },
+			want: want{
+				mg:  newNetworkPolicy(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"InvalidExternalName": {
+			mg:      newNetworkPolicy(withExternalName(resourceName)),
+			service: func() *fake.MockNetworkPolicy { return &fake.MockNetworkPolicy{} },
+			want: want{
+				mg:  newNetworkPolicy(withExternalName(resourceName)),
+				err: errors.Errorf("external-name '%s' is not of the format <source-guid>/<destination-guid>/<protocol>/<start>-<end>", resourceName),
+			},
+		},
+		"UpToDate": {
+			mg: newNetworkPolicy(withExternalName(externalName)),
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("List", sourceGUID).Return([]networkpolicy.Policy{widePolicy, policy}, nil)
+				return m
+			},
+			want: want{
+				mg:  newNetworkPolicy(withExternalName(externalName), withObservation(policy), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PortsChanged": {
+			mg: newNetworkPolicy(withExternalName(externalName), withPorts(8080, 8090)),
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("List", sourceGUID).Return([]networkpolicy.Policy{policy}, nil)
+				return m
+			},
+			want: want{
+				mg:  newNetworkPolicy(withExternalName(externalName), withPorts(8080, 8090), withObservation(policy), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"RemovedOutsideOfProvider": {
+			mg: newNetworkPolicy(withExternalName(externalName)),
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("List", sourceGUID).Return([]networkpolicy.Policy{widePolicy}, nil)
+				return m
+			},
+			want: want{
+				mg:  newNetworkPolicy(withExternalName(externalName)),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ListError": {
+			mg: newNetworkPolicy(withExternalName(externalName)),
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("List", sourceGUID).Return([]networkpolicy.Policy(nil), errBoom)
+				return m
+			},
+			want: want{
+				mg:  newNetworkPolicy(withExternalName(externalName)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{client: m}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockNetworkPolicy
+		want    want
+	}{
+		"Success": {
+			mg: newNetworkPolicy(),
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("Create", []networkpolicy.Policy{policy}).Return(nil)
+				return m
+			},
+			want: want{
+				mg: newNetworkPolicy(withExternalName(externalName), withConditions(xpv1.Creating())),
+			},
+		},
+		"MissingSource": {
+			mg:      newNetworkPolicy(func(r *v1alpha1.NetworkPolicy) { r.Spec.ForProvider.Source = nil }),
+			service: func() *fake.MockNetworkPolicy { return &fake.MockNetworkPolicy{} },
+			want: want{
+				mg:  newNetworkPolicy(func(r *v1alpha1.NetworkPolicy) { r.Spec.ForProvider.Source = nil }, withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.New("the source and the destination apps of the network policy are not resolved"), errCreate),
+			},
+		},
+		"CreateError": {
+			mg: newNetworkPolicy(),
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("Create", []networkpolicy.Policy{policy}).Return(errBoom)
+				return m
+			},
+			want: want{
+				mg:  newNetworkPolicy(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{client: m}
+			_, err := c.Create(context.Background(), tc.mg)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want mg, +got mg:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	wideExternalName := sourceGUID + "/" + destinationGUID + "/tcp/8080-8090"
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		kube    k8s.Client
+		service func() *fake.MockNetworkPolicy
+		want    want
+	}{
+		"ReplacePolicy": {
+			mg:   newNetworkPolicy(withExternalName(externalName), withPorts(8080, 8090)),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("Create", []networkpolicy.Policy{widePolicy}).Return(nil)
+				m.On("Delete", []networkpolicy.Policy{policy}).Return(nil)
+				return m
+			},
+			want: want{
+				mg: newNetworkPolicy(withExternalName(wideExternalName), withPorts(8080, 8090)),
+			},
+		},
+		"CreateError": {
+			mg: newNetworkPolicy(withExternalName(externalName), withPorts(8080, 8090)),
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("Create", []networkpolicy.Policy{widePolicy}).Return(errBoom)
+				return m
+			},
+			want: want{
+				mg:  newNetworkPolicy(withExternalName(externalName), withPorts(8080, 8090)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+		"UpdateCRError": {
+			mg:   newNetworkPolicy(withExternalName(externalName), withPorts(8080, 8090)),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("Create", []networkpolicy.Policy{widePolicy}).Return(nil)
+				return m
+			},
+			want: want{
+				mg:  newNetworkPolicy(withExternalName(wideExternalName), withPorts(8080, 8090)),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"DeleteError": {
+			mg:   newNetworkPolicy(withExternalName(externalName), withPorts(8080, 8090)),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("Create", []networkpolicy.Policy{widePolicy}).Return(nil)
+				m.On("Delete", []networkpolicy.Policy{policy}).Return(errBoom)
+				return m
+			},
+			want: want{
+				mg:  newNetworkPolicy(withExternalName(wideExternalName), withPorts(8080, 8090)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{kube: tc.kube, client: m}
+			_, err := c.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Update(...): -want mg, +got mg:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockNetworkPolicy
+		err     error
+	}{
+		"Success": {
+			mg: newNetworkPolicy(withExternalName(externalName)),
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("Delete", []networkpolicy.Policy{policy}).Return(nil)
+				return m
+			},
+		},
+		"NotCreated": {
+			mg:      newNetworkPolicy(),
+			service: func() *fake.MockNetworkPolicy { return &fake.MockNetworkPolicy{} },
+		},
+		"DeleteError": {
+			mg: newNetworkPolicy(withExternalName(externalName)),
+			service: func() *fake.MockNetworkPolicy {
+				m := &fake.MockNetworkPolicy{}
+				m.On("Delete", []networkpolicy.Policy{policy}).Return(errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{client: m}
+			_, err := c.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
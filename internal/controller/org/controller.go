@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,9 +18,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	pcv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/org"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
@@ -37,15 +39,23 @@ const (
 	errDelete            = "cannot delete " + externalSystem + " organization"
 )
 
-// Setup adds a controller that reconciles Org resources.
+// Setup adds controllers that reconcile cluster scoped and namespaced Organization
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.Org_GroupKind)
+	if err := setup(mgr, o, v1alpha1.Org_GroupVersionKind, &v1alpha1.Organization{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.Org_GroupVersionKind, &nsv1alpha1.Organization{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
-		managed.WithExternalConnector(&connector{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
 			kube:  mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &pcv1beta1.ProviderConfigUsage{}),
-		}),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -57,20 +67,20 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.Org_GroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.Organization{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
 type connector struct {
 	kube  k8s.Client
-	usage resource.LegacyTracker
+	usage resource.Tracker
 }
 
 // Connect typically produces an ExternalClient by:
@@ -79,11 +89,11 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.Organization); !ok {
+	if _, ok := mg.(v1alpha1.OrganizationManaged); !ok {
 		return nil, errors.New(errNotOrgKind)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackUsage)
 	}
 
@@ -111,7 +121,7 @@ type external struct {
 
 // Observe managed resource Org
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.Organization)
+	cr, ok := mg.(v1alpha1.OrganizationManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotOrgKind)
 	}
@@ -120,7 +130,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	// ADR Step 1: Check if external-name is empty
 	if meta.GetExternalName(cr) == "" {
-		o, err := org.FindOrgBySpec(ctx, c.client, cr.Spec.ForProvider)
+		o, err := org.FindOrgBySpec(ctx, c.client, *cr.GetForProvider())
 		if err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errGetResource)
 		}
@@ -151,28 +161,28 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	org.LateInitialize(&cr.Spec.ForProvider, o)
-	cr.Status.AtProvider = org.GenerateObservation(o)
+	org.LateInitialize(cr.GetForProvider(), o)
+	*cr.GetAtProvider() = org.GenerateObservation(o)
 
-	if !ptr.Deref(cr.Status.AtProvider.Suspended, false) {
-		cr.Status.SetConditions(xpv1.Available())
+	if !ptr.Deref(cr.GetAtProvider().Suspended, false) {
+		cr.SetConditions(xpv1.Available())
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:          cr.Status.AtProvider.ID != nil,
-		ResourceUpToDate:        org.IsUpToDate(cr, cr.Spec.ForProvider, o),
+		ResourceExists:          cr.GetAtProvider().ID != nil,
+		ResourceUpToDate:        org.IsUpToDate(cr, *cr.GetForProvider(), o),
 		ResourceLateInitialized: resourceLateInitialized,
 	}, nil
 }
 
 // Create a managed resource Org
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.Organization)
+	cr, ok := mg.(v1alpha1.OrganizationManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotOrgKind)
 	}
 
-	o, err := c.client.Create(ctx, org.GenerateCreate(cr, cr.Spec.ForProvider))
+	o, err := c.client.Create(ctx, org.GenerateCreate(cr, *cr.GetForProvider()))
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
@@ -188,7 +198,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Update managed resource Org
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	_, ok := mg.(*v1alpha1.Organization)
+	_, ok := mg.(v1alpha1.OrganizationManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotOrgKind)
 	}
@@ -204,7 +214,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Delete managed resource Org
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.Organization)
+	cr, ok := mg.(v1alpha1.OrganizationManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotOrgKind)
 	}
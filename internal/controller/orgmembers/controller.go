@@ -5,9 +5,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/cloudfoundry/go-cfclient/v3/config"
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/pkg/errors"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -19,9 +20,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	apisv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/members"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
 )
@@ -97,15 +99,23 @@ func isValidOrgRoleType(roleType string) bool {
 	}
 }
 
-// Setup adds a controller that reconciles managed resources OrgMembers.
+// Setup adds controllers that reconcile cluster scoped and namespaced OrgMembers
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.OrgMembersGroupKind)
+	if err := setup(mgr, o, v1alpha1.OrgMembersGroupVersionKind, &v1alpha1.OrgMembers{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.OrgMembersGroupVersionKind, &nsv1alpha1.OrgMembers{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
-		managed.WithExternalConnector(&connector{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
 			kube:        mgr.GetClient(),
-			usage:       resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1beta1.ProviderConfigUsage{}),
-			newClientFn: members.NewClient}),
+			usage:       clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+			newClientFn: members.NewClient})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithPollInterval(o.PollInterval),
@@ -117,22 +127,22 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.OrgMembersGroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.OrgMembers{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
 	kube        k8s.Client
-	usage       resource.LegacyTracker
-	newClientFn func(*config.Config) (*members.Client, error)
+	usage       resource.Tracker
+	newClientFn func(*cfv3.Client) *members.Client
 }
 
 // Connect typically produces an ExternalClient by:
@@ -141,25 +151,20 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.OrgMembers); !ok {
+	if _, ok := mg.(v1alpha1.OrgMembersManaged); !ok {
 		return nil, errors.New(errWrongKind)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackUsage)
 	}
 
-	cfg, err := clients.GetCredentialConfig(ctx, c.kube, mg)
-	if err != nil {
-		return nil, errors.Wrap(err, errGetProviderConfig)
-	}
-
-	client, err := c.newClientFn(cfg)
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetClient)
 	}
 
-	return &external{client: client}, nil
+	return &external{client: c.newClientFn(cf)}, nil
 }
 
 // Disconnect implements the managed.ExternalClient interface
@@ -169,10 +174,10 @@ func (c *external) Disconnect(ctx context.Context) error {
 }
 
 type orgMemberClient interface {
-	ObserveOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) (*v1alpha1.RoleAssignments, bool, error)
-	AssignOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) (*v1alpha1.RoleAssignments, error)
-	UpdateOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) (*v1alpha1.RoleAssignments, error)
-	DeleteOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) error
+	ObserveOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) (*v1alpha1.RoleAssignments, bool, error)
+	AssignOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) (*v1alpha1.RoleAssignments, error)
+	UpdateOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) (*v1alpha1.RoleAssignments, error)
+	DeleteOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) error
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -181,7 +186,7 @@ type external struct {
 	client orgMemberClient
 }
 
-func resolveIdentity(cr *v1alpha1.OrgMembers) (orgGUID, roleType string, lateInitialized bool, err error) {
+func resolveIdentity(cr v1alpha1.OrgMembersManaged) (orgGUID, roleType string, lateInitialized bool, err error) {
 	externalName := meta.GetExternalName(cr)
 
 	switch {
@@ -194,14 +199,14 @@ func resolveIdentity(cr *v1alpha1.OrgMembers) (orgGUID, roleType string, lateIni
 	}
 }
 
-func resolveIdentityFromSpec(cr *v1alpha1.OrgMembers) (orgGUID, roleType string, lateInitialized bool, err error) {
-	if cr.Spec.ForProvider.Org == nil {
+func resolveIdentityFromSpec(cr v1alpha1.OrgMembersManaged) (orgGUID, roleType string, lateInitialized bool, err error) {
+	if cr.GetForProvider().Org == nil {
 		return "", "", false, errors.New(errOrgNotResolved)
 	}
-	if !isValidOrgRoleType(cr.Spec.ForProvider.RoleType) {
+	if !isValidOrgRoleType(cr.GetForProvider().RoleType) {
 		return "", "", false, errors.New(errRoleTypeRequired)
 	}
-	return *cr.Spec.ForProvider.Org, canonicalizeRoleType(cr.Spec.ForProvider.RoleType), true, nil
+	return *cr.GetForProvider().Org, canonicalizeRoleType(cr.GetForProvider().RoleType), true, nil
 }
 
 func resolveLegacyIdentity(externalName string) (orgGUID, roleType string, lateInitialized bool, err error) {
@@ -236,15 +241,15 @@ func hasCompoundExternalName(externalName, resourceName string) bool {
 	return externalName != "" && externalName != resourceName && !isOldExternalNameFormat(externalName)
 }
 
-func validateIdentityConflict(cr *v1alpha1.OrgMembers, orgGUID, roleType string) error {
+func validateIdentityConflict(cr v1alpha1.OrgMembersManaged, orgGUID, roleType string) error {
 	if !hasCompoundExternalName(meta.GetExternalName(cr), cr.GetName()) {
 		return nil
 	}
-	if cr.Spec.ForProvider.Org != nil && *cr.Spec.ForProvider.Org != orgGUID {
-		return errors.Errorf("identity conflict: external-name org (%s) differs from spec (%s)", orgGUID, *cr.Spec.ForProvider.Org)
+	if cr.GetForProvider().Org != nil && *cr.GetForProvider().Org != orgGUID {
+		return errors.Errorf("identity conflict: external-name org (%s) differs from spec (%s)", orgGUID, *cr.GetForProvider().Org)
 	}
-	if cr.Spec.ForProvider.RoleType != "" && canonicalizeRoleType(cr.Spec.ForProvider.RoleType) != roleType {
-		return errors.Errorf("identity conflict: external-name role type (%s) differs from spec (%s)", roleType, cr.Spec.ForProvider.RoleType)
+	if cr.GetForProvider().RoleType != "" && canonicalizeRoleType(cr.GetForProvider().RoleType) != roleType {
+		return errors.Errorf("identity conflict: external-name role type (%s) differs from spec (%s)", roleType, cr.GetForProvider().RoleType)
 	}
 	return nil
 }
@@ -272,12 +277,12 @@ func buildObservation(lateInitialized, exists bool, observed *v1alpha1.RoleAssig
 
 // isLaxDeletionComplete returns true when the resource is being deleted under Lax
 // enforcement and all tracked roles have already been removed.
-func isLaxDeletionComplete(cr *v1alpha1.OrgMembers) bool {
-	return meta.WasDeleted(cr) && cr.Spec.ForProvider.EnforcementPolicy != "Strict" && len(cr.Status.AtProvider.AssignedRoles) == 0
+func isLaxDeletionComplete(cr v1alpha1.OrgMembersManaged) bool {
+	return meta.WasDeleted(cr) && cr.GetForProvider().EnforcementPolicy != "Strict" && len(cr.GetAtProvider().AssignedRoles) == 0
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.OrgMembers)
+	cr, ok := mg.(v1alpha1.OrgMembersManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errWrongKind)
 	}
@@ -302,7 +307,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	if observed != nil && !meta.WasDeleted(cr) {
-		cr.Status.AtProvider.AssignedRoles = observed.AssignedRoles
+		cr.GetAtProvider().AssignedRoles = observed.AssignedRoles
 		cr.SetConditions(xpv1.Available())
 	}
 
@@ -317,7 +322,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.OrgMembers)
+	cr, ok := mg.(v1alpha1.OrgMembersManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errWrongKind)
 	}
@@ -339,7 +344,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	meta.SetExternalName(cr, composeExternalName(orgGUID, roleType))
 
 	// Collection resource — no single CF GUID, so set status directly.
-	cr.Status.AtProvider.AssignedRoles = created.AssignedRoles
+	cr.GetAtProvider().AssignedRoles = created.AssignedRoles
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -347,7 +352,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1alpha1.OrgMembers)
+	cr, ok := mg.(v1alpha1.OrgMembersManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errWrongKind)
 	}
@@ -363,7 +368,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	// External-name is immutable after initial creation/migration — do NOT rewrite it.
-	cr.Status.AtProvider.AssignedRoles = updated.AssignedRoles
+	cr.GetAtProvider().AssignedRoles = updated.AssignedRoles
 
 	return managed.ExternalUpdate{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -371,7 +376,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.OrgMembers)
+	cr, ok := mg.(v1alpha1.OrgMembersManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errWrongKind)
 	}
@@ -380,7 +385,7 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	// Lax resources only remove the roles tracked in status. If nothing is tracked,
 	// deletion is already complete and we should not block on resolving identity.
-	if cr.Spec.ForProvider.EnforcementPolicy != "Strict" && len(cr.Status.AtProvider.AssignedRoles) == 0 {
+	if cr.GetForProvider().EnforcementPolicy != "Strict" && len(cr.GetAtProvider().AssignedRoles) == 0 {
 		return managed.ExternalDelete{}, nil
 	}
 
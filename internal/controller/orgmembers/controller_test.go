@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/google/go-cmp/cmp"
@@ -87,32 +88,32 @@ type mockOrgMemberClient struct {
 	deleteFn  func(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) error
 }
 
-func (m *mockOrgMemberClient) ObserveOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) (*v1alpha1.RoleAssignments, bool, error) {
+func (m *mockOrgMemberClient) ObserveOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) (*v1alpha1.RoleAssignments, bool, error) {
 	if m.observeFn == nil {
 		return nil, false, nil
 	}
-	return m.observeFn(ctx, orgGUID, roleType, cr)
+	return m.observeFn(ctx, orgGUID, roleType, cr.(*v1alpha1.OrgMembers))
 }
 
-func (m *mockOrgMemberClient) AssignOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) (*v1alpha1.RoleAssignments, error) {
+func (m *mockOrgMemberClient) AssignOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) (*v1alpha1.RoleAssignments, error) {
 	if m.assignFn == nil {
 		return nil, nil
 	}
-	return m.assignFn(ctx, orgGUID, roleType, cr)
+	return m.assignFn(ctx, orgGUID, roleType, cr.(*v1alpha1.OrgMembers))
 }
 
-func (m *mockOrgMemberClient) UpdateOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) (*v1alpha1.RoleAssignments, error) {
+func (m *mockOrgMemberClient) UpdateOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) (*v1alpha1.RoleAssignments, error) {
 	if m.updateFn == nil {
 		return nil, nil
 	}
-	return m.updateFn(ctx, orgGUID, roleType, cr)
+	return m.updateFn(ctx, orgGUID, roleType, cr.(*v1alpha1.OrgMembers))
 }
 
-func (m *mockOrgMemberClient) DeleteOrgMembers(ctx context.Context, orgGUID, roleType string, cr *v1alpha1.OrgMembers) error {
+func (m *mockOrgMemberClient) DeleteOrgMembers(ctx context.Context, orgGUID, roleType string, cr v1alpha1.OrgMembersManaged) error {
 	if m.deleteFn == nil {
 		return nil
 	}
-	return m.deleteFn(ctx, orgGUID, roleType, cr)
+	return m.deleteFn(ctx, orgGUID, roleType, cr.(*v1alpha1.OrgMembers))
 }
 
 func TestObserve(t *testing.T) {
@@ -490,7 +491,7 @@ func TestDelete(t *testing.T) {
 			want: want{err: nil},
 			mock: mockOrgMemberClient{
 				deleteFn: func(ctx context.Context, _, _ string, cr *v1alpha1.OrgMembers) error {
-					return fmt.Errorf("NotFound: resource not found")
+					return cfresource.NewResourceNotFoundError()
 				},
 			},
 		},
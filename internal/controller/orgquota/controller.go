@@ -11,12 +11,14 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	apisv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/orgquota"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
 )
@@ -38,7 +40,7 @@ const (
 // the API used to sync and delete external resources.
 type externalConnector struct {
 	kubeClient   k8s.Client
-	usageTracker resource.LegacyTracker
+	usageTracker resource.Tracker
 }
 
 // externalConnector type implements managed.ExternalConnector
@@ -47,11 +49,11 @@ var _ managed.ExternalConnector = &externalConnector{}
 // Connect method connects to the provider specified by the supplied
 // managed resource and produce an ExternalClient.
 func (c *externalConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.OrgQuota); !ok {
+	if _, ok := mg.(v1alpha1.OrgQuotaManaged); !ok {
 		return nil, errors.New(errNotOrgQuota)
 	}
 
-	if err := c.usageTracker.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usageTracker.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
@@ -65,15 +67,24 @@ func (c *externalConnector) Connect(ctx context.Context, mg resource.Managed) (m
 
 // Setup function builds a new controller that will be started by the
 // provided Manager.
+// Setup adds controllers that reconcile cluster scoped and namespaced OrgQuota
+// managed resources.
 func Setup(mgr ctrl.Manager, controllerOptions controller.Options) error {
-	name := managed.ControllerName(v1alpha1.OrgQuota_GroupKind)
+	if err := setup(mgr, controllerOptions, v1alpha1.OrgQuota_GroupVersionKind, &v1alpha1.OrgQuota{}); err != nil {
+		return err
+	}
+	return setup(mgr, controllerOptions, nsv1alpha1.OrgQuota_GroupVersionKind, &nsv1alpha1.OrgQuota{})
+}
+
+func setup(mgr ctrl.Manager, controllerOptions controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
 		managed.WithInitializers(),
-		managed.WithExternalConnector(&externalConnector{
+		managed.WithExternalConnector(cferrors.NewConnector(&externalConnector{
 			kubeClient:   mgr.GetClient(),
-			usageTracker: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1beta1.ProviderConfigUsage{}),
-		}),
+			usageTracker: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
 		managed.WithLogger(controllerOptions.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithPollInterval(controllerOptions.PollInterval),
@@ -84,14 +95,14 @@ func Setup(mgr ctrl.Manager, controllerOptions controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.OrgQuota_GroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controllerOptions.ForControllerRuntime()).
-		For(&v1alpha1.OrgQuota{}).
-		Complete(ratelimiter.NewReconciler(name, r, controllerOptions.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, controllerOptions.PollInterval), controllerOptions.GlobalRateLimiter))
 }
 
 // Disconnect implements the managed.ExternalClient interface
@@ -113,7 +124,7 @@ var _ managed.ExternalClient = &externalClient{}
 // Observe the external resource the supplied Managed resource
 // represents, if any.
 func (e *externalClient) Observe(ctx context.Context, res resource.Managed) (managed.ExternalObservation, error) {
-	managedOrgQuota, ok := res.(*v1alpha1.OrgQuota)
+	managedOrgQuota, ok := res.(v1alpha1.OrgQuotaManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotOrgQuota)
 	}
@@ -123,7 +134,7 @@ func (e *externalClient) Observe(ctx context.Context, res resource.Managed) (man
 	// ADR Step 1: Check if external-name is empty
 	if meta.GetExternalName(managedOrgQuota) == "" {
 		// Backwards compatibility: lookup by spec name
-		r, err := orgquota.FindOrgQuotaBySpec(ctx, e.cloudFoundryClient, managedOrgQuota.Spec.ForProvider)
+		r, err := orgquota.FindOrgQuotaBySpec(ctx, e.cloudFoundryClient, *managedOrgQuota.GetForProvider())
 		if err != nil {
 			if clients.ErrorIsNotFound(err) {
 				return managed.ExternalObservation{ResourceExists: false}, nil
@@ -154,8 +165,8 @@ func (e *externalClient) Observe(ctx context.Context, res resource.Managed) (man
 	}
 
 	managedOrgQuota.SetConditions(xpv1.Available())
-	lateInitialized := orgquota.LateInitialize(&managedOrgQuota.Spec.ForProvider, externalOrgQuota)
-	managedOrgQuota.Status.AtProvider = orgquota.GenerateObservation(externalOrgQuota)
+	lateInitialized := orgquota.LateInitialize(managedOrgQuota.GetForProvider(), externalOrgQuota)
+	*managedOrgQuota.GetAtProvider() = orgquota.GenerateObservation(externalOrgQuota)
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
@@ -168,14 +179,14 @@ func (e *externalClient) Observe(ctx context.Context, res resource.Managed) (man
 // Managed resource. Called when Observe reports that the associated
 // external resource does not exist.
 func (e *externalClient) Create(ctx context.Context, res resource.Managed) (managed.ExternalCreation, error) {
-	managedOrgQuota, ok := res.(*v1alpha1.OrgQuota)
+	managedOrgQuota, ok := res.(v1alpha1.OrgQuotaManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotOrgQuota)
 	}
 
 	managedOrgQuota.SetConditions(xpv1.Creating())
 
-	externalOrgQuota, err := e.cloudFoundryClient.Create(ctx, orgquota.GenerateCreateOrUpdate(managedOrgQuota.Spec.ForProvider))
+	externalOrgQuota, err := e.cloudFoundryClient.Create(ctx, orgquota.GenerateCreateOrUpdate(*managedOrgQuota.GetForProvider()))
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
@@ -189,12 +200,12 @@ func (e *externalClient) Create(ctx context.Context, res resource.Managed) (mana
 // resource, if necessary. Called unless Observe reports that the
 // associated external resource is up to date.
 func (e *externalClient) Update(ctx context.Context, res resource.Managed) (managed.ExternalUpdate, error) {
-	managedOrgQuota, ok := res.(*v1alpha1.OrgQuota)
+	managedOrgQuota, ok := res.(v1alpha1.OrgQuotaManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotOrgQuota)
 	}
 
-	_, err := e.cloudFoundryClient.Update(ctx, meta.GetExternalName(managedOrgQuota), orgquota.GenerateCreateOrUpdate(managedOrgQuota.Spec.ForProvider))
+	_, err := e.cloudFoundryClient.Update(ctx, meta.GetExternalName(managedOrgQuota), orgquota.GenerateCreateOrUpdate(*managedOrgQuota.GetForProvider()))
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 	}
@@ -205,7 +216,7 @@ func (e *externalClient) Update(ctx context.Context, res resource.Managed) (mana
 // Delete the external resource upon deletion of its associated Managed
 // resource. Called when the managed resource has been deleted.
 func (e *externalClient) Delete(ctx context.Context, res resource.Managed) (managed.ExternalDelete, error) {
-	managedOrgQuota, ok := res.(*v1alpha1.OrgQuota)
+	managedOrgQuota, ok := res.(v1alpha1.OrgQuotaManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotOrgQuota)
 	}
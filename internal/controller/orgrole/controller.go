@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,11 +17,14 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	pcv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/org"
 	role "github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/role"
@@ -38,20 +42,31 @@ const (
 	errDelete            = "cannot delete organization role"
 )
 
-// Setup adds a controller that reconciles OrgRole resources.
+// Setup adds controllers that reconcile cluster scoped and namespaced OrgRole
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.OrgRole_GroupKind)
+	if err := setup(mgr, o, v1alpha1.OrgRole_GroupVersionKind, &v1alpha1.OrgRole{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.OrgRole_GroupVersionKind, &nsv1alpha1.OrgRole{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
-		managed.WithExternalConnector(&connector{kube: mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &pcv1beta1.ProviderConfigUsage{}),
-		}),
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{kube: mgr.GetClient(),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithInitializers(&orgInitializer{
-			kube: mgr.GetClient(),
-		}),
+		managed.WithInitializers(
+			&orgInitializer{
+				kube: mgr.GetClient(),
+			},
+			&originInitializer{kube: mgr.GetClient()},
+		),
 	}
 
 	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
@@ -59,20 +74,20 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.OrgRole_GroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.OrgRole{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
 type connector struct {
 	kube  k8s.Client
-	usage resource.LegacyTracker
+	usage resource.Tracker
 }
 
 // Connect typically produces an ExternalClient by:
@@ -81,11 +96,11 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.OrgRole); !ok {
+	if _, ok := mg.(v1alpha1.OrgRoleManaged); !ok {
 		return nil, errors.New(errWrongKind)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackUsage)
 	}
 
@@ -113,7 +128,7 @@ type external struct {
 
 // Observe managed resource OrgRole
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.OrgRole)
+	cr, ok := mg.(v1alpha1.OrgRoleManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errWrongKind)
 	}
@@ -123,7 +138,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	// ADR Step 1: Check if external-name is empty
 	if meta.GetExternalName(cr) == "" {
 		// Backwards compatibility required as previously lookup by spec was supported
-		r, err := role.FindOrgRole(ctx, c.role, cr.Spec.ForProvider)
+		r, err := role.FindOrgRole(ctx, c.role, *cr.GetForProvider())
 		if err != nil {
 			if clients.ErrorIsNotFound(err) {
 				return managed.ExternalObservation{ResourceExists: false}, nil
@@ -157,8 +172,8 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	cr.Status.AtProvider = role.GenerateOrgRoleObservation(r)
-	cr.Status.SetConditions(xpv1.Available())
+	*cr.GetAtProvider() = role.GenerateOrgRoleObservation(r)
+	cr.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
@@ -169,12 +184,12 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 // Create a managed resource OrgRole
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.OrgRole)
+	cr, ok := mg.(v1alpha1.OrgRoleManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errWrongKind)
 	}
 
-	spec := cr.Spec.ForProvider
+	spec := *cr.GetForProvider()
 	if spec.Org == nil || spec.Username == "" || spec.Type == "" {
 		return managed.ExternalCreation{}, errors.New(errCreate)
 	}
@@ -195,7 +210,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Update managed resource OrgRole
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	_, ok := mg.(*v1alpha1.OrgRole)
+	_, ok := mg.(v1alpha1.OrgRoleManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errWrongKind)
 	}
@@ -211,7 +226,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Delete managed resource OrgRole
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.OrgRole)
+	cr, ok := mg.(v1alpha1.OrgRoleManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errWrongKind)
 	}
@@ -243,19 +258,65 @@ type orgInitializer initializer
 
 // / Initialize implements the Initializer interface
 func (c *orgInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
-	cr, ok := mg.(*v1alpha1.OrgRole)
+	cr, ok := mg.(v1alpha1.OrgRoleManaged)
 	if !ok {
 		return errors.New(errWrongKind)
 	}
 
-	if cr.Spec.ForProvider.OrgRef != nil || cr.Spec.ForProvider.OrgSelector != nil {
+	if cr.GetForProvider().OrgRef != nil || cr.GetForProvider().OrgSelector != nil {
 		return cr.ResolveReferences(ctx, c.kube)
 	}
 
 	// If orgName is provided, resolve by orgName
-	if cr.Spec.ForProvider.OrgName != nil {
+	if cr.GetForProvider().OrgName != nil {
 		return org.ResolveByName(ctx, clients.ClientFnBuilder(ctx, c.kube), mg)
 	}
 
 	return nil
 }
+
+type originInitializer initializer
+
+// Initialize resolves the origin from the User referenced by userRef or
+// userSelector.
+func (c *originInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(v1alpha1.OrgRoleManaged)
+	if !ok {
+		return errors.New(errWrongKind)
+	}
+
+	if cr.GetForProvider().UserRef == nil && cr.GetForProvider().UserSelector == nil {
+		return nil
+	}
+	return ResolveOrigin(ctx, cr, c.kube)
+}
+
+// ResolveOrigin resolves the origin from the User referenced by userRef or
+// userSelector. A namespaced OrgRole references a User in its namespace.
+func ResolveOrigin(ctx context.Context, cr v1alpha1.OrgRoleManaged, c k8s.Reader) error {
+	fp := cr.GetForProvider()
+	req := reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(fp.Origin),
+		Extract:      resources.Origin(),
+		Reference:    fp.UserRef,
+		Selector:     fp.UserSelector,
+		To: reference.To{
+			List:    &v1alpha1.UserList{},
+			Managed: &v1alpha1.User{},
+		},
+	}
+	if _, ok := cr.(*nsv1alpha1.OrgRole); ok {
+		req.Namespace = cr.GetNamespace()
+		req.To = reference.To{
+			List:    &nsv1alpha1.UserList{},
+			Managed: &nsv1alpha1.User{},
+		}
+	}
+
+	rsp, err := reference.NewAPIResolver(c, cr).Resolve(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "mg.GetForProvider().Origin")
+	}
+	fp.Origin = reference.ToPtrValue(rsp.ResolvedValue)
+	return nil
+}
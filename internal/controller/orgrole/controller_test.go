@@ -90,6 +90,12 @@ func withOrigin(origin string) modifier {
 	}
 }
 
+func withUserRef(name string) modifier {
+	return func(r *v1alpha1.OrgRole) {
+		r.Spec.ForProvider.UserRef = &xpv1.Reference{Name: name}
+	}
+}
+
 func withExternalName(name string) modifier {
 	return func(r *v1alpha1.OrgRole) {
 		r.Annotations[meta.AnnotationKeyExternalName] = name
@@ -305,7 +311,7 @@ func TestObserve(t *testing.T) {
 				m := &fake.MockOrgRole{}
 				m.On("Get", guidRole).Return(
 					fake.OrganizationRoleNil,
-					errors.New("CF-ResourceNotFound: The role was not found"),
+					cfresource.NewResourceNotFoundError(),
 				)
 				return m
 			},
@@ -689,7 +695,7 @@ func TestDelete(t *testing.T) {
 
 				m.On("Delete").Return(
 					"",
-					errors.New("CF-ResourceNotFound: The role was not found"),
+					cfresource.NewResourceNotFoundError(),
 				)
 				return m
 			},
@@ -839,3 +845,56 @@ func TestInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestOriginInitializer(t *testing.T) {
+	getUser := func(_ context.Context, _ k8s.ObjectKey, obj k8s.Object) error {
+		obj.(*v1alpha1.User).Status.AtProvider.Origin = ptr.To("uaa")
+		return nil
+	}
+
+	type want struct {
+		cr  *v1alpha1.OrgRole
+		err error
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.OrgRole
+		kube k8s.Client
+		want want
+	}{
+		"NoUserReference": {
+			cr:   fakeOrgRole(withUsername("user1")),
+			want: want{cr: fakeOrgRole(withUsername("user1"))},
+		},
+		"UserRef": {
+			cr:   fakeOrgRole(withUserRef("user1")),
+			kube: &test.MockClient{MockGet: getUser},
+			want: want{cr: fakeOrgRole(withUserRef("user1"), withOrigin("uaa"))},
+		},
+		"OriginAlreadySet": {
+			cr:   fakeOrgRole(withUserRef("user1"), withOrigin("sap.ids")),
+			want: want{cr: fakeOrgRole(withUserRef("user1"), withOrigin("sap.ids"))},
+		},
+		"UserRefNotFound": {
+			cr:   fakeOrgRole(withUserRef("user1")),
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{
+				cr:  fakeOrgRole(withUserRef("user1")),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get referenced resource"), "mg.GetForProvider().Origin"),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			c := &originInitializer{kube: tc.kube}
+			err := c.Initialize(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("Initialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -6,31 +6,51 @@ package providerconfig
 
 import (
 	ctrl "sigs.k8s.io/controller-runtime"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
+	nsv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 )
 
-// Setup adds a controller that reconciles ProviderConfigs by accounting for
-// their current usage.
+// Setup adds controllers that reconcile ProviderConfigs and
+// ClusterProviderConfigs by accounting for their current usage.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
-
-	of := resource.ProviderConfigKinds{
+	if err := setup(mgr, o, v1beta1.ProviderConfigGroupKind, resource.ProviderConfigKinds{
 		Config:    v1beta1.ProviderConfigGroupVersionKind,
 		Usage:     v1beta1.ProviderConfigUsageGroupVersionKind,
 		UsageList: v1beta1.ProviderConfigUsageListGroupVersionKind,
+	}, &v1beta1.ProviderConfig{}, &v1beta1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{}); err != nil {
+		return err
+	}
+
+	if err := setup(mgr, o, nsv1beta1.ProviderConfigGroupKind, resource.ProviderConfigKinds{
+		Config:    nsv1beta1.ProviderConfigGroupVersionKind,
+		Usage:     nsv1beta1.ProviderConfigUsageGroupVersionKind,
+		UsageList: nsv1beta1.ProviderConfigUsageListGroupVersionKind,
+	}, &nsv1beta1.ProviderConfig{}, &nsv1beta1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{Kind: nsv1beta1.ProviderConfigKind}); err != nil {
+		return err
 	}
 
+	return setup(mgr, o, nsv1beta1.ClusterProviderConfigGroupKind, resource.ProviderConfigKinds{
+		Config:    nsv1beta1.ClusterProviderConfigGroupVersionKind,
+		Usage:     nsv1beta1.ProviderConfigUsageGroupVersionKind,
+		UsageList: nsv1beta1.ProviderConfigUsageListGroupVersionKind,
+	}, &nsv1beta1.ClusterProviderConfig{}, &nsv1beta1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{Kind: nsv1beta1.ClusterProviderConfigKind})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, kind string, of resource.ProviderConfigKinds, pc, usage k8s.Object, enqueue *resource.EnqueueRequestForProviderConfig) error {
+	name := providerconfig.ControllerName(kind)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1beta1.ProviderConfig{}).
-		Watches(&v1beta1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{}).
+		For(pc).
+		Watches(usage, enqueue).
 		Complete(providerconfig.NewReconciler(mgr, of,
 			providerconfig.WithLogger(o.Logger.WithValues("controller", name)),
 			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
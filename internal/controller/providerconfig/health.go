@@ -0,0 +1,143 @@
+/*
+Copyright 2023 SAP SE
+*/
+
+package providerconfig
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	nsv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/v1beta1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+)
+
+const (
+	errGetProviderConfig    = "cannot get ProviderConfig"
+	errUpdateProviderConfig = "cannot update ProviderConfig status"
+
+	healthCheckTimeout = 2 * time.Minute
+)
+
+// SetupHealth adds controllers that regularly check the connectivity of
+// ProviderConfigs and ClusterProviderConfigs.
+func SetupHealth(mgr ctrl.Manager, o controller.Options) error {
+	if err := setupHealth(mgr, o, v1beta1.ProviderConfigGroupVersionKind, func() v1beta1.ProviderConfigObject { return &v1beta1.ProviderConfig{} }); err != nil {
+		return err
+	}
+	if err := setupHealth(mgr, o, nsv1beta1.ProviderConfigGroupVersionKind, func() v1beta1.ProviderConfigObject { return &nsv1beta1.ProviderConfig{} }); err != nil {
+		return err
+	}
+	return setupHealth(mgr, o, nsv1beta1.ClusterProviderConfigGroupVersionKind, func() v1beta1.ProviderConfigObject { return &nsv1beta1.ClusterProviderConfig{} })
+}
+
+func setupHealth(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, newConfig func() v1beta1.ProviderConfigObject) error {
+	name := strings.ToLower(gvk.Kind) + "-health." + gvk.Group
+	r := &healthReconciler{
+		kube:      mgr.GetClient(),
+		kind:      gvk.Kind,
+		newConfig: newConfig,
+		check:     clients.SharedClientCache.CheckHealth,
+		poll:      o.PollInterval,
+		log:       o.Logger.WithValues("controller", name),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		// ignore status updates, the ProviderConfig is checked every poll interval anyway
+		For(newConfig(), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// checkFn checks the connectivity of the referenced ProviderConfig.
+type checkFn func(ctx context.Context, kube k8s.Client, ref clients.ProviderConfigRef) *clients.ProviderConfigHealth
+
+// A healthReconciler reports the connectivity of a ProviderConfig of one kind
+// in its status.
+type healthReconciler struct {
+	kube      k8s.Client
+	kind      string
+	newConfig func() v1beta1.ProviderConfigObject
+	check     checkFn
+	poll      time.Duration
+	log       logging.Logger
+}
+
+// Reconcile checks the connectivity of a ProviderConfig and reports it in the
+// status conditions.
+func (r *healthReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	pc := r.newConfig()
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(k8s.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	if pc.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	h := r.check(ctx, r.kube, clients.ProviderConfigRef{Kind: r.kind, Namespace: pc.GetNamespace(), Name: pc.GetName()})
+
+	// the check may have updated the status, e.g. the certificate condition
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(k8s.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	setHealth(pc, h)
+	if err := r.kube.Status().Update(ctx, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errUpdateProviderConfig)
+	}
+	log.Debug("Checked ProviderConfig connectivity", "reachable", h.UnreachableErr == nil, "authenticated", h.UnauthenticatedErr == nil)
+
+	return reconcile.Result{RequeueAfter: r.poll}, nil
+}
+
+// setHealth sets the observed connectivity in the ProviderConfig status.
+func setHealth(pc v1beta1.ProviderConfigObject, h *clients.ProviderConfigHealth) {
+	status := pc.GetStatus()
+	now := metav1.Now()
+	status.LastCheckTime = &now
+	if h.APIVersion != "" {
+		status.CFAPIVersion = h.APIVersion
+	}
+	if h.UAAEndpoint != "" {
+		status.UAAEndpoint = h.UAAEndpoint
+	}
+
+	var failures []string
+	if h.UnreachableErr != nil {
+		pc.SetConditions(v1beta1.Unreachable(h.UnreachableErr))
+		failures = append(failures, h.UnreachableErr.Error())
+	} else {
+		pc.SetConditions(v1beta1.Reachable())
+	}
+	if h.UnauthenticatedErr != nil {
+		pc.SetConditions(v1beta1.AuthenticationFailed(h.UnauthenticatedErr))
+		failures = append(failures, h.UnauthenticatedErr.Error())
+	} else {
+		pc.SetConditions(v1beta1.Authenticated())
+	}
+
+	if len(failures) > 0 {
+		pc.SetConditions(xpv1.Unavailable().WithMessage(strings.Join(failures, "; ")))
+		return
+	}
+	pc.SetConditions(xpv1.Available())
+}
@@ -0,0 +1,121 @@
+package providerconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+)
+
+func TestHealthReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	poll := time.Minute
+
+	type want struct {
+		result     reconcile.Result
+		conditions map[xpv1.ConditionType]corev1.ConditionStatus
+		apiVersion string
+	}
+
+	cases := map[string]struct {
+		health *clients.ProviderConfigHealth
+		want   want
+	}{
+		"Healthy": {
+			health: &clients.ProviderConfigHealth{APIVersion: "3.180.0", UAAEndpoint: "https://uaa.example.com"},
+			want: want{
+				result: reconcile.Result{RequeueAfter: poll},
+				conditions: map[xpv1.ConditionType]corev1.ConditionStatus{
+					xpv1.TypeReady:            corev1.ConditionTrue,
+					v1beta1.TypeReachable:     corev1.ConditionTrue,
+					v1beta1.TypeAuthenticated: corev1.ConditionTrue,
+				},
+				apiVersion: "3.180.0",
+			},
+		},
+		"BrokenCredentials": {
+			health: &clients.ProviderConfigHealth{APIVersion: "3.180.0", UnauthenticatedErr: errBoom},
+			want: want{
+				result: reconcile.Result{RequeueAfter: poll},
+				conditions: map[xpv1.ConditionType]corev1.ConditionStatus{
+					xpv1.TypeReady:            corev1.ConditionFalse,
+					v1beta1.TypeReachable:     corev1.ConditionTrue,
+					v1beta1.TypeAuthenticated: corev1.ConditionFalse,
+				},
+				apiVersion: "3.180.0",
+			},
+		},
+		"Unreachable": {
+			health: &clients.ProviderConfigHealth{UnreachableErr: errBoom, UnauthenticatedErr: errBoom},
+			want: want{
+				result: reconcile.Result{RequeueAfter: poll},
+				conditions: map[xpv1.ConditionType]corev1.ConditionStatus{
+					xpv1.TypeReady:            corev1.ConditionFalse,
+					v1beta1.TypeReachable:     corev1.ConditionFalse,
+					v1beta1.TypeAuthenticated: corev1.ConditionFalse,
+				},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			var updated *v1beta1.ProviderConfig
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ k8s.ObjectKey, obj k8s.Object) error {
+					obj.(*v1beta1.ProviderConfig).ObjectMeta = metav1.ObjectMeta{Name: "default"}
+					return nil
+				},
+				MockStatusUpdate: func(_ context.Context, obj k8s.Object, _ ...k8s.SubResourceUpdateOption) error {
+					updated = obj.(*v1beta1.ProviderConfig)
+					return nil
+				},
+			}
+			r := &healthReconciler{
+				kube:      kube,
+				kind:      v1beta1.ProviderConfigKind,
+				newConfig: func() v1beta1.ProviderConfigObject { return &v1beta1.ProviderConfig{} },
+				check: func(_ context.Context, _ k8s.Client, ref clients.ProviderConfigRef) *clients.ProviderConfigHealth {
+					if diff := cmp.Diff(clients.ProviderConfigRef{Kind: v1beta1.ProviderConfigKind, Name: "default"}, ref); diff != "" {
+						t.Errorf("check(...): -want ref, +got ref:\n%s", diff)
+					}
+					return tc.health
+				},
+				poll: poll,
+				log:  logging.NewNopLogger(),
+			}
+
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}})
+			if err != nil {
+				t.Fatalf("Reconcile(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("Reconcile(...): -want, +got:\n%s", diff)
+			}
+			if updated == nil {
+				t.Fatalf("Reconcile(...): status not updated")
+			}
+			for ct, status := range tc.want.conditions {
+				if diff := cmp.Diff(status, updated.Status.GetCondition(ct).Status); diff != "" {
+					t.Errorf("condition %s: -want, +got:\n%s", ct, diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.apiVersion, updated.Status.CFAPIVersion); diff != "" {
+				t.Errorf("CFAPIVersion: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -18,10 +19,11 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	apisv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/domain"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/route"
@@ -35,6 +37,9 @@ type RouteService interface {
 	Create(ctx context.Context, mg resource.Managed, forProvider v1alpha1.RouteParameters) (string, error)
 	Update(ctx context.Context, guid string, mg resource.Managed, forProvider v1alpha1.RouteParameters) error
 	Delete(ctx context.Context, guid string) (string, error)
+	TransferOwnership(ctx context.Context, guid string, spaceGUID string) error
+	AreSharedSpacesUpToDate(ctx context.Context, guid string, desired []v1alpha1.SpaceReference) (bool, error)
+	UpdateSharedSpaces(ctx context.Context, guid string, desired []v1alpha1.SpaceReference) error
 }
 
 const (
@@ -48,21 +53,33 @@ const (
 	errUpdate        = "cannot update cloudfoundry Route"
 	errDelete        = "cannot delete cloudfoundry Route"
 	errActiveBinding = "cannot delete route with active bindings. Please remove the bindings first."
+
+	errTransferOwnership  = "cannot transfer cloudfoundry Route to space"
+	errCheckSharedSpaces  = "cannot check shared spaces"
+	errUpdateSharedSpaces = "cannot update shared spaces"
 )
 
-// Setup adds a controller that reconciles Org managed resources.
+// Setup adds controllers that reconcile cluster scoped and namespaced Route
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.RouteGroupKind)
+	if err := setup(mgr, o, v1alpha1.RouteGroupVersionKind, &v1alpha1.Route{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.RouteGroupVersionKind, &nsv1alpha1.Route{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
 		managed.WithInitializers(
 			domainInitializer{client: mgr.GetClient()},
 			spaceInitializer{client: mgr.GetClient()},
 		),
-		managed.WithExternalConnector(&connector{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
 			kube:  mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1beta1.ProviderConfigUsage{}),
-		}),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithPollInterval(o.PollInterval),
@@ -73,21 +90,21 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.RouteGroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.Route{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
 	kube  k8s.Client
-	usage resource.LegacyTracker
+	usage resource.Tracker
 }
 
 // Connect typically produces an ExternalClient by:
@@ -96,11 +113,11 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.Route); !ok {
+	if _, ok := mg.(v1alpha1.RouteManaged); !ok {
 		return nil, errors.New(errNotRoute)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
@@ -129,7 +146,7 @@ type external struct {
 
 // Observe generates observation for Route's
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.Route)
+	cr, ok := mg.(v1alpha1.RouteManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotRoute)
 	}
@@ -138,7 +155,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	if meta.GetExternalName(cr) == "" {
 		// Backwards compatibility: lookup by spec fields
-		observed, exists, err := c.FindRouteBySpec(ctx, cr.Spec.ForProvider)
+		observed, exists, err := c.FindRouteBySpec(ctx, *cr.GetForProvider())
 		if err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errGet)
 		}
@@ -163,26 +180,36 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	cr.Status.AtProvider = *observed
-	cr.Status.SetConditions(xpv1.Available())
+	*cr.GetAtProvider() = *observed
+	cr.SetConditions(xpv1.Available())
+
+	upToDate := route.IsUpToDate(cr, *cr.GetForProvider(), *cr.GetAtProvider())
+
+	// Check if shared spaces are up to date (only if field is explicitly set)
+	if upToDate && cr.GetForProvider().SharedSpaces != nil {
+		upToDate, err = c.AreSharedSpacesUpToDate(ctx, guid, cr.GetForProvider().SharedSpaces)
+		if err != nil {
+			return managed.ExternalObservation{ResourceExists: true}, errors.Wrap(err, errCheckSharedSpaces)
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        route.IsUpToDate(cr, cr.Spec.ForProvider, cr.Status.AtProvider),
+		ResourceUpToDate:        upToDate,
 		ResourceLateInitialized: resourceLateInitialized,
 	}, nil
 }
 
 // Create a route
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.Route)
+	cr, ok := mg.(v1alpha1.RouteManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotRoute)
 	}
 
 	cr.SetConditions(xpv1.Creating())
 
-	guid, err := c.RouteService.Create(ctx, cr, cr.Spec.ForProvider)
+	guid, err := c.RouteService.Create(ctx, cr, *cr.GetForProvider())
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
@@ -196,17 +223,32 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Update updates a route
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1alpha1.Route)
+	cr, ok := mg.(v1alpha1.RouteManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotRoute)
 	}
 
 	guid := meta.GetExternalName(cr)
-	err := c.RouteService.Update(ctx, guid, cr, cr.Spec.ForProvider)
+	err := c.RouteService.Update(ctx, guid, cr, *cr.GetForProvider())
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 	}
 
+	// The space the route is transferred from keeps access to it as a shared
+	// space, until it is unshared below.
+	if !route.IsOwnedBySpace(*cr.GetForProvider(), *cr.GetAtProvider()) {
+		if err := c.TransferOwnership(ctx, guid, *cr.GetForProvider().Space); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errTransferOwnership)
+		}
+	}
+
+	// Update shared spaces (only if field is explicitly set)
+	if cr.GetForProvider().SharedSpaces != nil {
+		if err := c.UpdateSharedSpaces(ctx, guid, cr.GetForProvider().SharedSpaces); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSharedSpaces)
+		}
+	}
+
 	return managed.ExternalUpdate{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
@@ -216,13 +258,13 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Delete deletes a route
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.Route)
+	cr, ok := mg.(v1alpha1.RouteManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotRoute)
 	}
 
 	// Prevent delete if there are bindings.
-	if len(cr.Status.AtProvider.Destinations) > 0 {
+	if len(cr.GetAtProvider().Destinations) > 0 {
 		return managed.ExternalDelete{}, errors.New(errActiveBinding)
 	}
 
@@ -241,27 +283,27 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 // ResolveReferences of this Route.
-func ResolveReferences(ctx context.Context, mg *v1alpha1.Route, c k8s.Reader) error {
+func ResolveReferences(ctx context.Context, mg v1alpha1.RouteManaged, c k8s.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
 
 	var rsp reference.ResolutionResponse
 	var err error
 
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
-		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Domain),
+		CurrentValue: reference.FromPtrValue(mg.GetForProvider().Domain),
 		Extract:      resources.ExternalID(),
-		Reference:    mg.Spec.ForProvider.DomainRef,
-		Selector:     mg.Spec.ForProvider.DomainSelector,
+		Reference:    mg.GetForProvider().DomainRef,
+		Selector:     mg.GetForProvider().DomainSelector,
 		To: reference.To{
 			List:    &v1alpha1.DomainList{},
 			Managed: &v1alpha1.Domain{},
 		},
 	})
 	if err != nil {
-		return errors.Wrap(err, "mg.Spec.ForProvider.Domain")
+		return errors.Wrap(err, "mg.GetForProvider().Domain")
 	}
-	mg.Spec.ForProvider.Domain = reference.ToPtrValue(rsp.ResolvedValue)
-	mg.Spec.ForProvider.DomainRef = rsp.ResolvedReference
+	mg.GetForProvider().Domain = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.GetForProvider().DomainRef = rsp.ResolvedReference
 
 	return nil
 }
@@ -276,12 +318,12 @@ type domainInitializer initializer
 // Initialize method resolves the references which are not resolved by
 // the crossplane reconciler.
 func (i domainInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
-	cr, ok := mg.(*v1alpha1.Route)
+	cr, ok := mg.(v1alpha1.RouteManaged)
 	if !ok {
 		return errors.New(errNotRoute)
 	}
 
-	if cr.Spec.ForProvider.DomainRef != nil || cr.Spec.ForProvider.DomainSelector != nil {
+	if cr.GetForProvider().DomainRef != nil || cr.GetForProvider().DomainSelector != nil {
 		return ResolveReferences(ctx, cr, i.client)
 	}
 	return domain.ResolveByName(ctx, clients.ClientFnBuilder(ctx, i.client), mg)
@@ -290,14 +332,20 @@ func (i domainInitializer) Initialize(ctx context.Context, mg resource.Managed)
 type spaceInitializer initializer
 
 func (s spaceInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
-	cr, ok := mg.(*v1alpha1.Route)
+	cr, ok := mg.(v1alpha1.RouteManaged)
 	if !ok {
 		return errors.New(errNotRoute)
 	}
 
-	if cr.Spec.ForProvider.SpaceRef != nil || cr.Spec.ForProvider.SpaceSelector != nil {
-		return cr.ResolveReferences(ctx, s.client)
+	clientFn := clients.ClientFnBuilder(ctx, s.client)
+	if cr.GetForProvider().SpaceRef != nil || cr.GetForProvider().SpaceSelector != nil {
+		if err := cr.ResolveReferences(ctx, s.client); err != nil {
+			return err
+		}
+	} else if err := space.ResolveByName(ctx, clientFn, mg); err != nil {
+		return err
 	}
 
-	return space.ResolveByName(ctx, clients.ClientFnBuilder(ctx, s.client), mg)
+	// the shared spaces given by spaceName and orgName
+	return space.ResolveListByName(ctx, clientFn, mg, cr.GetForProvider().SharedSpaces)
 }
@@ -51,6 +51,21 @@ func (m *Mock) Delete(ctx context.Context, guid string) (string, error) {
 	return args.String(0), args.Error(1)
 }
 
+func (m *Mock) TransferOwnership(ctx context.Context, guid string, spaceGUID string) error {
+	args := m.Called(guid, spaceGUID)
+	return args.Error(0)
+}
+
+func (m *Mock) AreSharedSpacesUpToDate(ctx context.Context, guid string, desired []v1alpha1.SpaceReference) (bool, error) {
+	args := m.Called(guid, desired)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *Mock) UpdateSharedSpaces(ctx context.Context, guid string, desired []v1alpha1.SpaceReference) error {
+	args := m.Called(guid, desired)
+	return args.Error(0)
+}
+
 var (
 	spaceGUID  = "11fd5b0b-4f3b-4b1b-8b3d-3b5f7b4b3b4b"
 	domainGUID = "22fd5b0b-4f3b-4b1b-8b3d-3b5f7b4b3b4b"
@@ -58,6 +73,9 @@ var (
 	name       = "test-route"
 	errBoom    = errors.New("boom")
 
+	otherSpaceGUID = "44fd5b0b-4f3b-4b1b-8b3d-3b5f7b4b3b4b"
+	sharedSpaces   = []v1alpha1.SpaceReference{{Space: &otherSpaceGUID}}
+
 	nilObservation *v1alpha1.RouteObservation
 )
 
@@ -85,6 +103,18 @@ func withDestinations(destinations []v1alpha1.RouteDestination) modifier {
 	}
 }
 
+func withSharedSpaces(spaces []v1alpha1.SpaceReference) modifier {
+	return func(r *v1alpha1.Route) {
+		r.Spec.ForProvider.SharedSpaces = spaces
+	}
+}
+
+func withObservedSpace(space string) modifier {
+	return func(r *v1alpha1.Route) {
+		r.Status.AtProvider.Space = &space
+	}
+}
+
 func fakeRoute(m ...modifier) *v1alpha1.Route {
 	r := &v1alpha1.Route{
 		ObjectMeta: metav1.ObjectMeta{
@@ -253,6 +283,67 @@ func TestObserve(t *testing.T) {
 				return m
 			},
 		},
+		"SharedSpacesNotUpToDate": {
+			args: args{
+				mg: fakeRoute(
+					withExternalName(guid),
+					withDefaultMetadataLabels(),
+					withSharedSpaces(sharedSpaces),
+				),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				err: nil,
+			},
+			service: func() *Mock {
+				m := &Mock{}
+				m.On("GetRouteByGUID", guid).Return(
+					fakeRouteObservation(guid), true, nil,
+				)
+				m.On("AreSharedSpacesUpToDate", guid, sharedSpaces).Return(false, nil)
+				return m
+			},
+		},
+		"SharedSpacesError": {
+			args: args{
+				mg: fakeRoute(
+					withExternalName(guid),
+					withDefaultMetadataLabels(),
+					withSharedSpaces(sharedSpaces),
+				),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+				err: errors.Wrap(errBoom, errCheckSharedSpaces),
+			},
+			service: func() *Mock {
+				m := &Mock{}
+				m.On("GetRouteByGUID", guid).Return(
+					fakeRouteObservation(guid), true, nil,
+				)
+				m.On("AreSharedSpacesUpToDate", guid, sharedSpaces).Return(false, errBoom)
+				return m
+			},
+		},
+		"OwnedByAnotherSpace": {
+			args: args{
+				mg: fakeRoute(
+					withExternalName(guid),
+					withDefaultMetadataLabels(),
+				),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				err: nil,
+			},
+			service: func() *Mock {
+				m := &Mock{}
+				o := fakeRouteObservation(guid)
+				o.Space = &otherSpaceGUID
+				m.On("GetRouteByGUID", guid).Return(o, true, nil)
+				return m
+			},
+		},
 		"SetExternalNameNotFound": {
 			args: args{
 				mg: fakeRoute(withExternalName(guid)),
@@ -401,6 +492,127 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	type service func() *Mock
+	type args struct {
+		mg resource.Managed
+	}
+
+	type want struct {
+		obs managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    want
+		service service
+	}{
+		"Successful": {
+			args: args{
+				mg: fakeRoute(withExternalName(guid), withObservedSpace(spaceGUID)),
+			},
+			want: want{
+				obs: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}},
+			},
+			service: func() *Mock {
+				m := &Mock{}
+				m.On("Update").Return(nil)
+				return m
+			},
+		},
+		"UpdateError": {
+			args: args{
+				mg: fakeRoute(withExternalName(guid)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+			service: func() *Mock {
+				m := &Mock{}
+				m.On("Update").Return(errBoom)
+				return m
+			},
+		},
+		"TransferOwnership": {
+			args: args{
+				mg: fakeRoute(withExternalName(guid), withObservedSpace(otherSpaceGUID)),
+			},
+			want: want{
+				obs: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}},
+			},
+			service: func() *Mock {
+				m := &Mock{}
+				m.On("Update").Return(nil)
+				m.On("TransferOwnership", guid, spaceGUID).Return(nil)
+				return m
+			},
+		},
+		"TransferOwnershipError": {
+			args: args{
+				mg: fakeRoute(withExternalName(guid), withObservedSpace(otherSpaceGUID)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errTransferOwnership),
+			},
+			service: func() *Mock {
+				m := &Mock{}
+				m.On("Update").Return(nil)
+				m.On("TransferOwnership", guid, spaceGUID).Return(errBoom)
+				return m
+			},
+		},
+		"SharedSpaces": {
+			args: args{
+				mg: fakeRoute(withExternalName(guid), withObservedSpace(spaceGUID), withSharedSpaces(sharedSpaces)),
+			},
+			want: want{
+				obs: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}},
+			},
+			service: func() *Mock {
+				m := &Mock{}
+				m.On("Update").Return(nil)
+				m.On("UpdateSharedSpaces", guid, sharedSpaces).Return(nil)
+				return m
+			},
+		},
+		"SharedSpacesError": {
+			args: args{
+				mg: fakeRoute(withExternalName(guid), withObservedSpace(spaceGUID), withSharedSpaces(sharedSpaces)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateSharedSpaces),
+			},
+			service: func() *Mock {
+				m := &Mock{}
+				m.On("Update").Return(nil)
+				m.On("UpdateSharedSpaces", guid, sharedSpaces).Return(errBoom)
+				return m
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{
+				kube:         &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				RouteService: m,
+			}
+
+			obs, err := c.Update(context.Background(), tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	type service func() *Mock
 	type args struct {
@@ -0,0 +1,250 @@
+package securitygroup
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/securitygroup"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/space"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
+)
+
+const (
+	resourceType   = "SecurityGroup"
+	externalSystem = "Cloud Foundry"
+	errWrongKind   = "managed resource is not of kind " + resourceType
+	errTrackUsage  = "cannot track usage"
+	errGetClient   = "cannot create a client to talk to the API of " + externalSystem
+	errGet         = "cannot get " + resourceType + " in " + externalSystem
+	errCreate      = "cannot create " + resourceType + " in " + externalSystem
+	errUpdate      = "cannot update " + resourceType
+	errBind        = "cannot update the spaces bound to the " + resourceType
+	errDelete      = "cannot delete " + resourceType
+)
+
+// Setup adds controllers that reconcile cluster scoped and namespaced
+// SecurityGroup managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := setup(mgr, o, v1alpha1.SecurityGroup_GroupVersionKind, &v1alpha1.SecurityGroup{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.SecurityGroup_GroupVersionKind, &nsv1alpha1.SecurityGroup{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+
+	options := []managed.ReconcilerOption{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
+			kube:  mgr.GetClient(),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithInitializers(&spaceInitializer{
+			kube: mgr.GetClient(),
+		}),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		options = append(options, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(gvk),
+		options...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
+}
+
+// A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
+type connector struct {
+	kube  k8s.Client
+	usage resource.Tracker
+}
+
+// Connect tracks the usage of the ProviderConfig and creates a SecurityGroup
+// client from its credentials.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(v1alpha1.SecurityGroupManaged); !ok {
+		return nil, errors.New(errWrongKind)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetClient)
+	}
+
+	client, j := securitygroup.NewClient(cf)
+	return &external{client: client, job: j}, nil
+}
+
+// An external is a managed.ExternalClient that is using the CloudFoundry API to observe and modify resources.
+type external struct {
+	client securitygroup.Client
+	job    job.Job
+}
+
+// Disconnect implements the managed.ExternalClient interface
+func (c *external) Disconnect(ctx context.Context) error {
+	// No cleanup needed for Cloud Foundry client
+	return nil
+}
+
+// Observe managed resource SecurityGroup
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(v1alpha1.SecurityGroupManaged)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errWrongKind)
+	}
+
+	lateInitialized := false
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		// adopt an existing security group of the same name
+		s, err := securitygroup.FindBySpec(ctx, c.client, *cr.GetForProvider())
+		if err != nil {
+			if clients.ErrorIsNotFound(err) {
+				return managed.ExternalObservation{ResourceExists: false}, nil
+			}
+			return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+		}
+		guid = s.GUID
+		meta.SetExternalName(cr, guid)
+		lateInitialized = true
+	}
+
+	if !clients.IsValidGUID(guid) {
+		return managed.ExternalObservation{}, errors.Errorf("external-name '%s' is not a valid GUID format", guid)
+	}
+
+	s, err := c.client.Get(ctx, guid)
+	if err != nil {
+		if clients.ErrorIsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	*cr.GetAtProvider() = securitygroup.GenerateObservation(s)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        securitygroup.IsUpToDate(*cr.GetForProvider(), s),
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+// Create a managed resource SecurityGroup
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(v1alpha1.SecurityGroupManaged)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	s, err := c.client.Create(ctx, securitygroup.GenerateCreate(*cr.GetForProvider()))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, s.GUID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update managed resource SecurityGroup
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(v1alpha1.SecurityGroupManaged)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errWrongKind)
+	}
+
+	guid := meta.GetExternalName(cr)
+	if _, err := c.client.Update(ctx, guid, securitygroup.GenerateUpdate(*cr.GetForProvider())); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	if securitygroup.NeedsClearRules(*cr.GetForProvider(), *cr.GetAtProvider()) {
+		if err := c.client.ClearRules(ctx, guid); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	if err := securitygroup.UpdateBindings(ctx, c.client, guid, *cr.GetForProvider(), *cr.GetAtProvider()); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBind)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete managed resource SecurityGroup
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(v1alpha1.SecurityGroupManaged)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	jobGUID, err := c.client.Delete(ctx, guid)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(clients.IgnoreNotFoundErr(err), errDelete)
+	}
+	if jobGUID == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, job.PollJobComplete(ctx, c.job, jobGUID)
+}
+
+// A spaceInitializer resolves the running and staging spaces given by
+// spaceName and orgName.
+type spaceInitializer struct {
+	kube k8s.Client
+}
+
+// Initialize implements the Initializer interface
+func (s *spaceInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(v1alpha1.SecurityGroupManaged)
+	if !ok {
+		return errors.New(errWrongKind)
+	}
+
+	clientFn := clients.ClientFnBuilder(ctx, s.kube)
+	if err := space.ResolveListByName(ctx, clientFn, mg, cr.GetForProvider().RunningSpaces); err != nil {
+		return err
+	}
+	return space.ResolveListByName(ctx, clientFn, mg, cr.GetForProvider().StagingSpaces)
+}
@@ -0,0 +1,436 @@
+package securitygroup
+
+import (
+	"context"
+	"testing"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+)
+
+var (
+	errBoom        = errors.New("boom")
+	resourceName   = "my-security-group"
+	guid           = "1c7e2a44-5d3b-4c2e-9f1a-7b3e5d2c8a01"
+	name           = "my-asg"
+	spaceGUID      = "7a2e4c1d-3b5f-4e6a-8c9d-0f1e2d3c4b5a"
+	otherSpaceGUID = "8b3f5d2e-4c6a-4f7b-9d0e-1a2b3c4d5e6f"
+	jobGUID        = "job-guid"
+)
+
+type modifier func(*v1alpha1.SecurityGroup)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.SecurityGroup) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withRunningSpaces(guids ...string) modifier {
+	return func(r *v1alpha1.SecurityGroup) {
+		for _, g := range guids {
+			r.Spec.ForProvider.RunningSpaces = append(r.Spec.ForProvider.RunningSpaces, v1alpha1.SpaceReference{Space: ptr.To(g)})
+		}
+	}
+}
+
+func withStagingSpaces(guids ...string) modifier {
+	return func(r *v1alpha1.SecurityGroup) {
+		for _, g := range guids {
+			r.Spec.ForProvider.StagingSpaces = append(r.Spec.ForProvider.StagingSpaces, v1alpha1.SpaceReference{Space: ptr.To(g)})
+		}
+	}
+}
+
+func withObservedRunningSpaces(guids ...string) modifier {
+	return func(r *v1alpha1.SecurityGroup) {
+		r.Status.AtProvider.RunningSpaces = guids
+	}
+}
+
+func withoutRules() modifier {
+	return func(r *v1alpha1.SecurityGroup) {
+		r.Spec.ForProvider.Rules = nil
+	}
+}
+
+func withObservedRules(rules ...v1alpha1.SecurityGroupRule) modifier {
+	return func(r *v1alpha1.SecurityGroup) {
+		r.Status.AtProvider.Rules = rules
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.SecurityGroup) { r.Status.SetConditions(c...) }
+}
+
+func securityGroup(m ...modifier) *v1alpha1.SecurityGroup {
+	r := &v1alpha1.SecurityGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.SecurityGroupSpec{
+			ForProvider: v1alpha1.SecurityGroupParameters{
+				Name: name,
+				Rules: []v1alpha1.SecurityGroupRule{
+					{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: ptr.To("443")},
+				},
+			},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+func group(m ...func(*fake.SecurityGroup)) *cfresource.SecurityGroup {
+	s := fake.NewSecurityGroup().SetName(name).SetGUID(guid).
+		SetRules(cfresource.SecurityGroupRule{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: ptr.To("443")})
+	for _, f := range m {
+		f(s)
+	}
+	return &s.SecurityGroup
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockSecurityGroup
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockSecurityGroup { return &fake.MockSecurityGroup{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"NotFoundByName": {
+			mg: securityGroup(),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Single").Return(fake.SecurityGroupNil, fake.ErrNoResultReturned)
+				return m
+			},
+			want: want{
+				mg:  securityGroup(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AdoptByName": {
+			mg: securityGroup(),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Single").Return(group(), nil)
+				m.On("Get", guid).Return(group(), nil)
+				return m
+			},
+			want: want{
+				mg:  securityGroup(withExternalName(guid), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"UpToDate": {
+			mg: securityGroup(withExternalName(guid), withRunningSpaces(spaceGUID)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Get", guid).Return(group(func(s *fake.SecurityGroup) { s.SetRunningSpaces(spaceGUID) }), nil)
+				return m
+			},
+			want: want{
+				mg:  securityGroup(withExternalName(guid), withRunningSpaces(spaceGUID), withObservedRunningSpaces(spaceGUID), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"BindingsOutdated": {
+			mg: securityGroup(withExternalName(guid), withRunningSpaces(spaceGUID)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Get", guid).Return(group(func(s *fake.SecurityGroup) { s.SetRunningSpaces(otherSpaceGUID) }), nil)
+				return m
+			},
+			want: want{
+				mg:  securityGroup(withExternalName(guid), withRunningSpaces(spaceGUID), withObservedRunningSpaces(otherSpaceGUID), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"RulesOutdated": {
+			mg: securityGroup(withExternalName(guid)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Get", guid).Return(group(func(s *fake.SecurityGroup) {
+					s.SetRules(cfresource.SecurityGroupRule{Protocol: "all", Destination: "0.0.0.0/0"})
+				}), nil)
+				return m
+			},
+			want: want{
+				mg:  securityGroup(withExternalName(guid), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Deleted": {
+			mg: securityGroup(withExternalName(guid)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Get", guid).Return(fake.SecurityGroupNil, cfresource.NewResourceNotFoundError())
+				return m
+			},
+			want: want{
+				mg:  securityGroup(withExternalName(guid)),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			mg: securityGroup(withExternalName(guid)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Get", guid).Return(fake.SecurityGroupNil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  securityGroup(withExternalName(guid)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			c := &external{client: tc.service()}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				opts := cmp.Options{test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.SecurityGroupObservation{}, "ID", "Name", "Rules", "CreatedAt", "UpdatedAt")}
+				if diff := cmp.Diff(tc.want.mg, tc.mg, opts); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockSecurityGroup
+		want    want
+	}{
+		"SuccessWithSpaces": {
+			mg: securityGroup(withRunningSpaces(spaceGUID), withStagingSpaces(otherSpaceGUID)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Create", &cfresource.SecurityGroupCreate{
+					Name:            name,
+					GloballyEnabled: &cfresource.SecurityGroupGloballyEnabled{Running: ptr.To(false), Staging: ptr.To(false)},
+					Rules:           []*cfresource.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: ptr.To("443")}},
+					Relationships: map[string]cfresource.ToManyRelationships{
+						"running_spaces": *cfresource.NewToManyRelationships([]string{spaceGUID}),
+						"staging_spaces": *cfresource.NewToManyRelationships([]string{otherSpaceGUID}),
+					},
+				}).Return(group(), nil)
+				return m
+			},
+			want: want{
+				mg: securityGroup(withRunningSpaces(spaceGUID), withStagingSpaces(otherSpaceGUID), withExternalName(guid), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			mg: securityGroup(),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Create", &cfresource.SecurityGroupCreate{
+					Name:            name,
+					GloballyEnabled: &cfresource.SecurityGroupGloballyEnabled{Running: ptr.To(false), Staging: ptr.To(false)},
+					Rules:           []*cfresource.SecurityGroupRule{{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: ptr.To("443")}},
+				}).Return(fake.SecurityGroupNil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  securityGroup(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want mg, +got mg:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockSecurityGroup
+		err     error
+	}{
+		"BindAndUnbind": {
+			mg: securityGroup(withExternalName(guid), withRunningSpaces(spaceGUID), withStagingSpaces(spaceGUID), withObservedRunningSpaces(otherSpaceGUID)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Update", guid).Return(group(), nil)
+				m.On("BindRunningSecurityGroup", guid, []string{spaceGUID}).Return(nil)
+				m.On("UnBindRunningSecurityGroup", guid, otherSpaceGUID).Return(nil)
+				m.On("BindStagingSecurityGroup", guid, []string{spaceGUID}).Return(nil)
+				return m
+			},
+		},
+		"UpdateError": {
+			mg: securityGroup(withExternalName(guid)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Update", guid).Return(fake.SecurityGroupNil, errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errUpdate),
+		},
+		"RemoveLastRule": {
+			mg: securityGroup(withExternalName(guid), withoutRules(), withObservedRules(v1alpha1.SecurityGroupRule{Protocol: "tcp", Destination: "10.0.0.0/24"})),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Update", guid).Return(group(), nil)
+				m.On("ClearRules", guid).Return(nil)
+				return m
+			},
+		},
+		"ClearRulesError": {
+			mg: securityGroup(withExternalName(guid), withoutRules(), withObservedRules(v1alpha1.SecurityGroupRule{Protocol: "tcp", Destination: "10.0.0.0/24"})),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Update", guid).Return(group(), nil)
+				m.On("ClearRules", guid).Return(errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errUpdate),
+		},
+		"UnbindError": {
+			mg: securityGroup(withExternalName(guid), withObservedRunningSpaces(spaceGUID)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Update", guid).Return(group(), nil)
+				m.On("UnBindRunningSecurityGroup", guid, spaceGUID).Return(errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errBind),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockSecurityGroup
+		job     func() *fake.MockJob
+		err     error
+	}{
+		"Success": {
+			mg: securityGroup(withExternalName(guid)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Delete", guid).Return(jobGUID, nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("PollComplete").Return(nil)
+				return j
+			},
+		},
+		"AlreadyDeleted": {
+			mg: securityGroup(withExternalName(guid)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Delete", guid).Return("", cfresource.NewResourceNotFoundError())
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+		},
+		"DeleteError": {
+			mg: securityGroup(withExternalName(guid)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Delete", guid).Return("", errBoom)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			err: errors.Wrap(errBoom, errDelete),
+		},
+		"JobFailed": {
+			mg: securityGroup(withExternalName(guid)),
+			service: func() *fake.MockSecurityGroup {
+				m := &fake.MockSecurityGroup{}
+				m.On("Delete", guid).Return(jobGUID, nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("PollComplete").Return(errBoom)
+				return j
+			},
+			err: errBoom,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc, j := tc.service(), tc.job()
+			c := &external{client: svc, job: j}
+			_, err := c.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+			j.AssertExpectations(t)
+		})
+	}
+}
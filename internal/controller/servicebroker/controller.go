@@ -0,0 +1,353 @@
+package servicebroker
+
+import (
+	"context"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/servicebroker"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/space"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
+)
+
+const (
+	resourceType     = "ServiceBroker"
+	externalSystem   = "Cloud Foundry"
+	errWrongKind     = "managed resource is not of kind " + resourceType
+	errTrackUsage    = "cannot track usage"
+	errGetClient     = "cannot create a client to talk to the API of " + externalSystem
+	errGet           = "cannot get " + resourceType + " in " + externalSystem
+	errGetJob        = "cannot get the catalog synchronization job of the " + resourceType
+	errGetCatalog    = "cannot get the catalog of the " + resourceType
+	errGetCreds      = "cannot get the credentials of the " + resourceType
+	errMissingCreds  = "the credentials Secret of the " + resourceType + " must contain the keys username and password"
+	errCreate        = "cannot create " + resourceType + " in " + externalSystem
+	errUpdate        = "cannot update " + resourceType
+	errDelete        = "cannot delete " + resourceType
+	errUpdateCR      = "cannot update the managed resource"
+	msgSyncInProcess = "catalog synchronization in progress"
+	msgSyncFailed    = "catalog synchronization failed: "
+	keyUsername      = "username"
+	keyPassword      = "password"
+)
+
+// Setup adds controllers that reconcile cluster scoped and namespaced
+// ServiceBroker managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := setup(mgr, o, v1alpha1.ServiceBroker_GroupVersionKind, &v1alpha1.ServiceBroker{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.ServiceBroker_GroupVersionKind, &nsv1alpha1.ServiceBroker{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+
+	options := []managed.ReconcilerOption{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
+			kube:  mgr.GetClient(),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithInitializers(&spaceInitializer{
+			kube: mgr.GetClient(),
+		}),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		options = append(options, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(gvk),
+		options...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
+}
+
+// A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
+type connector struct {
+	kube  k8s.Client
+	usage resource.Tracker
+}
+
+// Connect tracks the usage of the ProviderConfig and creates a ServiceBroker
+// client from its credentials.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(v1alpha1.ServiceBrokerManaged); !ok {
+		return nil, errors.New(errWrongKind)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetClient)
+	}
+
+	client, offerings, plans, tracker := servicebroker.NewClient(cf)
+	return &external{
+		kube:      c.kube,
+		client:    client,
+		offerings: offerings,
+		plans:     plans,
+		job:       tracker,
+	}, nil
+}
+
+// An external is a managed.ExternalClient that is using the CloudFoundry API to observe and modify resources.
+type external struct {
+	kube      k8s.Client
+	client    servicebroker.Client
+	offerings servicebroker.Offerings
+	plans     servicebroker.Plans
+	job       job.Tracker
+}
+
+// Disconnect implements the managed.ExternalClient interface
+func (c *external) Disconnect(ctx context.Context) error {
+	// No cleanup needed for Cloud Foundry client
+	return nil
+}
+
+// Observe managed resource ServiceBroker. The catalog synchronization job of
+// the last create or update is tracked until it completes or fails.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(v1alpha1.ServiceBrokerManaged)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errWrongKind)
+	}
+
+	lateInitialized := false
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		// adopt an existing service broker of the same name
+		b, err := servicebroker.FindBySpec(ctx, c.client, *cr.GetForProvider())
+		if err != nil {
+			if clients.ErrorIsNotFound(err) {
+				return managed.ExternalObservation{ResourceExists: false}, nil
+			}
+			return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+		}
+		guid = b.GUID
+		meta.SetExternalName(cr, guid)
+		lateInitialized = true
+	}
+
+	if !clients.IsValidGUID(guid) {
+		return managed.ExternalObservation{}, errors.Errorf("external-name '%s' is not a valid GUID format", guid)
+	}
+
+	b, err := c.client.Get(ctx, guid)
+	if err != nil {
+		if clients.ErrorIsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	lastJob := cr.GetAtProvider().LastJob
+	if lastJob != nil && job.IsInProgress(cfresource.JobState(lastJob.State)) {
+		j, err := c.job.Get(ctx, lastJob.GUID)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetJob)
+		}
+		lastJob = servicebroker.GenerateJob(j)
+	}
+
+	offerings, plans, err := servicebroker.CountCatalog(ctx, c.offerings, c.plans, guid)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCatalog)
+	}
+
+	obs := servicebroker.GenerateObservation(b)
+	obs.ServiceOfferings = offerings
+	obs.ServicePlans = plans
+	obs.LastJob = lastJob
+	obs.CredentialsVersion = cr.GetAtProvider().CredentialsVersion
+	*cr.GetAtProvider() = obs
+
+	upToDate := servicebroker.IsUpToDate(cr, *cr.GetForProvider(), b)
+	if upToDate && !meta.WasDeleted(cr) {
+		// the credentials are pushed again when the Secret changes
+		creds, err := c.credentials(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = creds.Version == ptr.Deref(obs.CredentialsVersion, "")
+	}
+	switch {
+	case lastJob != nil && job.IsInProgress(cfresource.JobState(lastJob.State)):
+		// do not interrupt the running synchronization with an update
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgSyncInProcess))
+		upToDate = true
+	case lastJob != nil && lastJob.State == string(cfresource.JobStateFailed):
+		// the catalog is synchronized again once the spec or the credentials change
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgSyncFailed + lastJob.Errors))
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+// Create a managed resource ServiceBroker
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(v1alpha1.ServiceBrokerManaged)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	creds, err := c.credentials(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	jobGUID, err := c.client.Create(ctx, servicebroker.GenerateCreate(cr, *cr.GetForProvider(), *creds))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	// the service broker exists right away, only the catalog is synchronized asynchronously
+	b, err := servicebroker.FindBySpec(ctx, c.client, *cr.GetForProvider())
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGet)
+	}
+	meta.SetExternalName(cr, b.GUID)
+
+	// The reconciler reverts status changes made during Create, and updating
+	// the CR reads back the stored status. Set the job of the initial catalog
+	// synchronization after updating the CR and before updating the status
+	// so that it is not lost.
+	if err := c.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errUpdateCR)
+	}
+	cr.GetAtProvider().LastJob = processingJob(jobGUID)
+	cr.GetAtProvider().CredentialsVersion = ptr.To(creds.Version)
+	if err := c.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errUpdateCR)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update managed resource ServiceBroker
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(v1alpha1.ServiceBrokerManaged)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errWrongKind)
+	}
+
+	creds, err := c.credentials(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	jobGUID, _, err := c.client.Update(ctx, meta.GetExternalName(cr), servicebroker.GenerateUpdate(cr, *cr.GetForProvider(), *creds))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	if jobGUID != "" {
+		cr.GetAtProvider().LastJob = processingJob(jobGUID)
+	}
+	cr.GetAtProvider().CredentialsVersion = ptr.To(creds.Version)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete managed resource ServiceBroker
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(v1alpha1.ServiceBrokerManaged)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	jobGUID, err := c.client.Delete(ctx, guid)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(clients.IgnoreNotFoundErr(err), errDelete)
+	}
+	if jobGUID == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	return managed.ExternalDelete{}, job.PollJobComplete(ctx, c.job, jobGUID)
+}
+
+// credentials reads the basic authentication credentials from the Secret
+// referenced by the ServiceBroker.
+func (c *external) credentials(ctx context.Context, cr v1alpha1.ServiceBrokerManaged) (*servicebroker.Credentials, error) {
+	ref := cr.GetForProvider().CredentialsSecretRef
+	if ref == nil {
+		return nil, errors.New(errMissingCreds)
+	}
+	secret, err := clients.GetManagedSecret(ctx, c.kube, cr, *ref)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	username, password := secret.Data[keyUsername], secret.Data[keyPassword]
+	if len(username) == 0 || len(password) == 0 {
+		return nil, errors.New(errMissingCreds)
+	}
+	return &servicebroker.Credentials{
+		Username: string(username),
+		Password: string(password),
+		Version:  string(secret.UID) + "/" + secret.ResourceVersion,
+	}, nil
+}
+
+func processingJob(guid string) *v1alpha1.ServiceBrokerJob {
+	return &v1alpha1.ServiceBrokerJob{GUID: guid, State: string(cfresource.JobStateProcessing)}
+}
+
+// A spaceInitializer resolves the space of a space-scoped ServiceBroker given
+// by spaceName and orgName.
+type spaceInitializer struct {
+	kube k8s.Client
+}
+
+// Initialize implements the Initializer interface
+func (s *spaceInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	if _, ok := mg.(v1alpha1.ServiceBrokerManaged); !ok {
+		return errors.New(errWrongKind)
+	}
+	return space.ResolveByName(ctx, clients.ClientFnBuilder(ctx, s.kube), mg)
+}
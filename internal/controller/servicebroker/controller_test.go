@@ -0,0 +1,534 @@
+package servicebroker
+
+import (
+	"context"
+	"testing"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	resourceName = "my-service-broker"
+	guid         = "2d8f3b55-6e4c-4d3f-8a2b-8c4f6e3d9b12"
+	name         = "my-broker"
+	url          = "https://broker.example.com"
+	jobGUID      = "job-guid"
+
+	creds        = map[string][]byte{keyUsername: []byte("admin"), keyPassword: []byte("secret")}
+	credsVersion = "secret-uid/2"
+)
+
+type modifier func(*v1alpha1.ServiceBroker)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.ServiceBroker) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withLastJob(guid string, state cfresource.JobState, errs string) modifier {
+	return func(r *v1alpha1.ServiceBroker) {
+		r.Status.AtProvider.LastJob = &v1alpha1.ServiceBrokerJob{GUID: guid, State: string(state), Errors: errs}
+	}
+}
+
+func withCatalog(offerings, plans int) modifier {
+	return func(r *v1alpha1.ServiceBroker) {
+		r.Status.AtProvider.ServiceOfferings = offerings
+		r.Status.AtProvider.ServicePlans = plans
+	}
+}
+
+func withCredentialsVersion(version string) modifier {
+	return func(r *v1alpha1.ServiceBroker) {
+		r.Status.AtProvider.CredentialsVersion = ptr.To(version)
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.ServiceBroker) { r.Status.SetConditions(c...) }
+}
+
+func serviceBroker(m ...modifier) *v1alpha1.ServiceBroker {
+	r := &v1alpha1.ServiceBroker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.ServiceBrokerSpec{
+			ForProvider: v1alpha1.ServiceBrokerParameters{
+				Name:                 name,
+				URL:                  url,
+				CredentialsSecretRef: &xpv1.SecretReference{Name: "broker-creds", Namespace: "default"},
+			},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+func broker(m ...func(*fake.ServiceBroker)) *cfresource.ServiceBroker {
+	b := fake.NewServiceBroker().SetName(name).SetGUID(guid).SetURL(url).SetMetadata(metadata.BuildMetadata(serviceBroker(), nil, nil))
+	for _, f := range m {
+		f(b)
+	}
+	return &b.ServiceBroker
+}
+
+func catalog(offerings, plans int) (*fake.MockServiceOfferingList, *fake.MockServicePlanList) {
+	o, p := &fake.MockServiceOfferingList{}, &fake.MockServicePlanList{}
+	o.On("List").Return(offerings, nil)
+	p.On("List").Return(plans, nil)
+	return o, p
+}
+
+func kube(data map[string][]byte) k8s.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ k8s.ObjectKey, obj k8s.Object) error {
+			secret := obj.(*corev1.Secret)
+			secret.UID = "secret-uid"
+			secret.ResourceVersion = "2"
+			secret.Data = data
+			return nil
+		},
+		MockUpdate:       test.NewMockUpdateFn(nil),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+}
+
+// resetStatus reads back the stored status like the API server does on
+// update, which has no status for a new resource.
+func resetStatus(_ context.Context, obj k8s.Object, _ ...k8s.UpdateOption) error {
+	obj.(*v1alpha1.ServiceBroker).Status = v1alpha1.ServiceBrokerStatus{}
+	return nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockServiceBroker
+		job     func() *fake.MockJob
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockServiceBroker { return &fake.MockServiceBroker{} },
+			job:     func() *fake.MockJob { return &fake.MockJob{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"NotFoundByName": {
+			mg: serviceBroker(),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Single").Return(fake.ServiceBrokerNil, fake.ErrNoResultReturned)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  serviceBroker(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AdoptByName": {
+			mg: serviceBroker(),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Single").Return(broker(), nil)
+				m.On("Get", guid).Return(broker(), nil)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg: serviceBroker(withExternalName(guid), withCatalog(2, 5), withConditions(xpv1.Available())),
+				// the credentials of an adopted service broker are unknown
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true},
+			},
+		},
+		"SyncInProgress": {
+			mg: serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateProcessing, "")),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Get", guid).Return(broker(func(b *fake.ServiceBroker) { b.SetURL("https://old.example.com") }), nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("Get", jobGUID).Return(&cfresource.Job{Resource: cfresource.Resource{GUID: jobGUID}, State: cfresource.JobStatePolling}, nil)
+				return j
+			},
+			want: want{
+				mg: serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStatePolling, ""), withCatalog(2, 5),
+					withConditions(xpv1.Unavailable().WithMessage(msgSyncInProcess))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SyncCompleted": {
+			mg: serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateProcessing, ""), withCredentialsVersion(credsVersion)),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Get", guid).Return(broker(), nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("Get", jobGUID).Return(&cfresource.Job{Resource: cfresource.Resource{GUID: jobGUID}, State: cfresource.JobStateComplete}, nil)
+				return j
+			},
+			want: want{
+				mg:  serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateComplete, ""), withCredentialsVersion(credsVersion), withCatalog(2, 5), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"CredentialsRotated": {
+			mg: serviceBroker(withExternalName(guid), withCredentialsVersion("secret-uid/1")),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Get", guid).Return(broker(), nil)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg: serviceBroker(withExternalName(guid), withCredentialsVersion("secret-uid/1"),
+					withCatalog(2, 5), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"SyncFailed": {
+			mg: serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateProcessing, ""), withCredentialsVersion(credsVersion)),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Get", guid).Return(broker(), nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("Get", jobGUID).Return(&cfresource.Job{
+					Resource: cfresource.Resource{GUID: jobGUID},
+					State:    cfresource.JobStateFailed,
+					Errors:   []cfresource.CloudFoundryError{{Detail: "invalid catalog"}},
+				}, nil)
+				return j
+			},
+			want: want{
+				mg: serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateFailed, "invalid catalog"), withCredentialsVersion(credsVersion), withCatalog(2, 5),
+					withConditions(xpv1.Unavailable().WithMessage(msgSyncFailed+"invalid catalog"))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SyncFailedCredentialsRotated": {
+			mg: serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateFailed, "invalid catalog"), withCredentialsVersion("secret-uid/1")),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Get", guid).Return(broker(), nil)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg: serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateFailed, "invalid catalog"), withCredentialsVersion("secret-uid/1"), withCatalog(2, 5),
+					withConditions(xpv1.Unavailable().WithMessage(msgSyncFailed+"invalid catalog"))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"GetJobError": {
+			mg: serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateProcessing, "")),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Get", guid).Return(broker(), nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("Get", jobGUID).Return(nil, errBoom)
+				return j
+			},
+			want: want{
+				mg:  serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateProcessing, "")),
+				err: errors.Wrap(errBoom, errGetJob),
+			},
+		},
+		"URLOutdated": {
+			mg: serviceBroker(withExternalName(guid)),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Get", guid).Return(broker(func(b *fake.ServiceBroker) { b.SetURL("https://old.example.com") }), nil)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  serviceBroker(withExternalName(guid), withCatalog(2, 5), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Deleted": {
+			mg: serviceBroker(withExternalName(guid)),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Get", guid).Return(fake.ServiceBrokerNil, cfresource.NewResourceNotFoundError())
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  serviceBroker(withExternalName(guid)),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			mg: serviceBroker(withExternalName(guid)),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Get", guid).Return(fake.ServiceBrokerNil, errBoom)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			want: want{
+				mg:  serviceBroker(withExternalName(guid)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc, j := tc.service(), tc.job()
+			o, p := catalog(2, 5)
+			c := &external{kube: kube(creds), client: svc, offerings: o, plans: p, job: j}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				opts := cmp.Options{test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.ServiceBrokerObservation{}, "ID", "Name", "URL", "CreatedAt", "UpdatedAt", "ResourceMetadata")}
+				if diff := cmp.Diff(tc.want.mg, tc.mg, opts); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+			svc.AssertExpectations(t)
+			j.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		kube    k8s.Client
+		service func() *fake.MockServiceBroker
+		want    want
+	}{
+		"Success": {
+			mg: serviceBroker(),
+			kube: &test.MockClient{
+				MockGet:          kube(creds).(*test.MockClient).MockGet,
+				MockUpdate:       resetStatus,
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			},
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				create := cfresource.NewServiceBrokerCreate(name, url, "admin", "secret")
+				create.Metadata = metadata.BuildMetadata(serviceBroker(), nil, nil)
+				m.On("Create", create).Return(jobGUID, nil)
+				m.On("Single").Return(broker(), nil)
+				return m
+			},
+			want: want{
+				mg: serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateProcessing, ""), withCredentialsVersion(credsVersion)),
+			},
+		},
+		"StatusUpdateError": {
+			mg: serviceBroker(),
+			kube: &test.MockClient{
+				MockGet:          kube(creds).(*test.MockClient).MockGet,
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(errBoom),
+			},
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Create", mock.Anything).Return(jobGUID, nil)
+				m.On("Single").Return(broker(), nil)
+				return m
+			},
+			want: want{
+				mg:  serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateProcessing, ""), withCredentialsVersion(credsVersion), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"MissingCredentials": {
+			mg:      serviceBroker(),
+			kube:    kube(map[string][]byte{keyUsername: []byte("admin")}),
+			service: func() *fake.MockServiceBroker { return &fake.MockServiceBroker{} },
+			want: want{
+				mg:  serviceBroker(withConditions(xpv1.Creating())),
+				err: errors.New(errMissingCreds),
+			},
+		},
+		"CreateError": {
+			mg:   serviceBroker(),
+			kube: kube(creds),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Create", mock.Anything).Return("", errBoom)
+				return m
+			},
+			want: want{
+				mg:  serviceBroker(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{kube: tc.kube, client: svc}
+			_, err := c.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want mg, +got mg:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockServiceBroker
+		want    want
+	}{
+		"Resynchronize": {
+			mg: serviceBroker(withExternalName(guid), withLastJob("old-job", cfresource.JobStateFailed, "invalid catalog")),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Update", guid, mock.Anything).Return(jobGUID, fake.ServiceBrokerNil, nil)
+				return m
+			},
+			want: want{
+				mg: serviceBroker(withExternalName(guid), withLastJob(jobGUID, cfresource.JobStateProcessing, ""), withCredentialsVersion(credsVersion)),
+			},
+		},
+		"UpdateError": {
+			mg: serviceBroker(withExternalName(guid)),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Update", guid, mock.Anything).Return("", fake.ServiceBrokerNil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  serviceBroker(withExternalName(guid)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{kube: kube(creds), client: svc}
+			_, err := c.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Update(...): -want mg, +got mg:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockServiceBroker
+		job     func() *fake.MockJob
+		err     error
+	}{
+		"Success": {
+			mg: serviceBroker(withExternalName(guid)),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Delete", guid).Return(jobGUID, nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				j := &fake.MockJob{}
+				j.On("PollComplete").Return(nil)
+				return j
+			},
+		},
+		"AlreadyDeleted": {
+			mg: serviceBroker(withExternalName(guid)),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Delete", guid).Return("", cfresource.NewResourceNotFoundError())
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+		},
+		"DeleteError": {
+			mg: serviceBroker(withExternalName(guid)),
+			service: func() *fake.MockServiceBroker {
+				m := &fake.MockServiceBroker{}
+				m.On("Delete", guid).Return("", errBoom)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			err: errors.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc, j := tc.service(), tc.job()
+			c := &external{client: svc, job: j}
+			_, err := c.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+			j.AssertExpectations(t)
+		})
+	}
+}
@@ -15,16 +15,17 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/google/uuid"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	apisv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	scb "github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/servicecredentialbinding"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
 
-	cfclient "github.com/cloudfoundry/go-cfclient/v3/client"
 	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -49,16 +50,24 @@ const (
 	createAttemptsAnnotation = "crossplane-provider-cloudfoundry/create-attempts"
 )
 
-// Setup adds a controller that reconciles ServiceCredentialBinding CR.
+// Setup adds controllers that reconcile cluster scoped and namespaced ServiceCredentialBinding
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.ServiceCredentialBindingGroupKind)
+	if err := setup(mgr, o, v1alpha1.ServiceCredentialBindingGroupVersionKind, &v1alpha1.ServiceCredentialBinding{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.ServiceCredentialBindingGroupVersionKind, &nsv1alpha1.ServiceCredentialBinding{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
 		managed.WithInitializers(),
-		managed.WithExternalConnector(&connector{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
 			kube:  mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1beta1.ProviderConfigUsage{}),
-		}),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithPollInterval(o.PollInterval),
@@ -69,21 +78,21 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.ServiceCredentialBindingGroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.ServiceCredentialBinding{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an external client when its Connect method
 // is called.
 type connector struct {
 	kube  k8s.Client
-	usage resource.LegacyTracker
+	usage resource.Tracker
 }
 
 // Connect typically produces an ExternalClient by:
@@ -92,11 +101,11 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.ServiceCredentialBinding); !ok {
+	if _, ok := mg.(v1alpha1.ServiceCredentialBindingManaged); !ok {
 		return nil, errors.New(errWrongCRType)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, fmt.Errorf(errTrackPCUsage, err)
 	}
 
@@ -125,7 +134,7 @@ func (c *external) Disconnect(ctx context.Context) error {
 
 // ObservationStateHandler defines the interface for handling observation state
 type ObservationStateHandler interface {
-	HandleObservationState(serviceBinding *cfresource.ServiceCredentialBinding, ctx context.Context, cr *v1alpha1.ServiceCredentialBinding) (managed.ExternalObservation, error)
+	HandleObservationState(serviceBinding *cfresource.ServiceCredentialBinding, ctx context.Context, cr v1alpha1.ServiceCredentialBindingManaged) (managed.ExternalObservation, error)
 }
 
 // An external service observes, then either creates, updates, or deletes an
@@ -139,21 +148,18 @@ type external struct {
 
 // isBindingNotFoundError returns true if the error indicates the binding was not found
 func isBindingNotFoundError(err error) bool {
-	return errors.Is(err, cfclient.ErrNoResultsReturned) ||
-		errors.Is(err, cfclient.ErrExactlyOneResultNotReturned) ||
-		cfresource.IsResourceNotFoundError(err) ||
-		cfresource.IsServiceBindingNotFoundError(err)
+	return cferrors.IsNotFound(err)
 }
 
 // Observe checks the observed state of the resource and updates the managed resource's status.
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.ServiceCredentialBinding)
+	cr, ok := mg.(v1alpha1.ServiceCredentialBindingManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errWrongCRType)
 	}
 
 	guid := meta.GetExternalName(cr)
-	serviceBinding, err := scb.GetByIDOrSearch(ctx, c.scbClient, guid, cr.Spec.ForProvider)
+	serviceBinding, err := scb.GetByIDOrSearch(ctx, c.scbClient, guid, *cr.GetForProvider())
 	if isBindingNotFoundError(err) {
 		// The binding does not exist in Cloud Foundry. If we have exhausted the
 		// create attempts, settle into an Unavailable state instead of triggering
@@ -182,8 +188,8 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
-	cr.Status.AtProvider.GUID = serviceBinding.GUID
-	cr.Status.AtProvider.CreatedAt = &metav1.Time{Time: serviceBinding.CreatedAt}
+	cr.GetAtProvider().GUID = serviceBinding.GUID
+	cr.GetAtProvider().CreatedAt = &metav1.Time{Time: serviceBinding.CreatedAt}
 
 	if c.keyRotator.RetireBinding(cr, serviceBinding) {
 		if err := c.kube.Status().Update(ctx, cr); err != nil {
@@ -192,14 +198,14 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	scb.UpdateObservation(&cr.Status.AtProvider, serviceBinding)
+	scb.UpdateObservation(cr.GetAtProvider(), serviceBinding)
 
 	return c.observationStateHandler.HandleObservationState(serviceBinding, ctx, cr)
 }
 
 // Create a ServiceCredentialBinding resource.
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.ServiceCredentialBinding)
+	cr, ok := mg.(v1alpha1.ServiceCredentialBindingManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errWrongCRType)
 	}
@@ -209,12 +215,12 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, fmt.Errorf("cannot persist create attempt count: %w", err)
 	}
 
-	params, err := extractParameters(ctx, c.kube, cr.Spec.ForProvider)
+	params, err := extractParameters(ctx, c.kube, cr)
 	if err != nil {
 		return managed.ExternalCreation{}, fmt.Errorf(errExtractParams, err)
 	}
 
-	serviceBinding, err := scb.Create(ctx, c.scbClient, cr, cr.Spec.ForProvider, params)
+	serviceBinding, err := scb.Create(ctx, c.scbClient, cr, *cr.GetForProvider(), params)
 	if serviceBinding != nil && serviceBinding.GUID != "" {
 		// Capture the external name even if the job failed,
 		// as the binding was created in Cloud Foundry and we can manage it going forward.
@@ -224,13 +230,18 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		meta.SetExternalName(cr, serviceBinding.GUID)
 	}
 	if err != nil {
+		if cferrors.Classify(err) == cferrors.CategoryTransient {
+			// A transient error does not count towards the circuit breaker, the
+			// binding is created once the CF API recovers. Persisting the count is
+			// best effort, the attempt counts if it fails.
+			decrementCreateAttempts(cr)
+			_ = c.kube.Update(ctx, cr)
+		}
 		return managed.ExternalCreation{}, fmt.Errorf(errCreate, err)
 	}
 
-	if cr.Annotations != nil {
-		if _, ok := cr.Annotations[scb.ForceRotationKey]; ok {
-			meta.RemoveAnnotations(cr, scb.ForceRotationKey)
-		}
+	if _, ok := cr.GetAnnotations()[scb.ForceRotationKey]; ok {
+		meta.RemoveAnnotations(cr, scb.ForceRotationKey)
 	}
 
 	return managed.ExternalCreation{}, nil
@@ -238,32 +249,32 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Update a ServiceCredentialBinding resource.
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1alpha1.ServiceCredentialBinding)
+	cr, ok := mg.(v1alpha1.ServiceCredentialBindingManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errWrongCRType)
 	}
 
 	if externalName := meta.GetExternalName(cr); externalName != "" && isValidUUID(externalName) {
-		if _, err := scb.Update(ctx, c.scbClient, externalName, cr, cr.Spec.ForProvider); err != nil {
+		if _, err := scb.Update(ctx, c.scbClient, externalName, cr, *cr.GetForProvider()); err != nil {
 			return managed.ExternalUpdate{}, fmt.Errorf(errUpdate, err)
 		}
 	}
 
-	if cr.Status.AtProvider.RetiredKeys == nil {
+	if cr.GetAtProvider().RetiredKeys == nil {
 		return managed.ExternalUpdate{}, nil
 	}
 
 	if newRetiredKeys, err := c.keyRotator.DeleteExpiredKeys(ctx, cr); err != nil {
 		return managed.ExternalUpdate{}, fmt.Errorf(errDeleteExpiredKeys, err)
 	} else {
-		cr.Status.AtProvider.RetiredKeys = newRetiredKeys
+		cr.GetAtProvider().RetiredKeys = newRetiredKeys
 		return managed.ExternalUpdate{}, err
 	}
 }
 
 // Delete a ServiceCredentialBinding resource.
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.ServiceCredentialBinding)
+	cr, ok := mg.(v1alpha1.ServiceCredentialBindingManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errWrongCRType)
 	}
@@ -279,7 +290,7 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	if isValidUUID(externalName) {
 		guidToDelete = externalName
-	} else if statusGUID := cr.GetID(); isValidUUID(statusGUID) {
+	} else if statusGUID := cr.GetAtProvider().GUID; isValidUUID(statusGUID) {
 		// Fallback: external-name is not a valid UUID, but status has a valid GUID
 		guidToDelete = statusGUID
 	}
@@ -297,21 +308,22 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 // extractParameters returns the parameters or credentials from the spec
-func extractParameters(ctx context.Context, kube k8s.Client, spec v1alpha1.ServiceCredentialBindingParameters) ([]byte, error) {
+func extractParameters(ctx context.Context, kube k8s.Client, cr v1alpha1.ServiceCredentialBindingManaged) ([]byte, error) {
+	spec := cr.GetForProvider()
 	// If the spec has yaml parameters use those and only those.
 	if spec.Parameters != nil {
 		return spec.Parameters.Raw, nil
 	}
 
 	if spec.ParametersSecretRef != nil {
-		return clients.ExtractSecret(ctx, kube, spec.ParametersSecretRef, "")
+		return clients.ExtractManagedSecret(ctx, kube, cr, spec.ParametersSecretRef, "")
 	}
 
 	// If the spec has no parameters or secret ref, return nil
 	return nil, nil
 }
 
-func (c *external) HandleObservationState(serviceBinding *cfresource.ServiceCredentialBinding, ctx context.Context, cr *v1alpha1.ServiceCredentialBinding) (managed.ExternalObservation, error) {
+func (c *external) HandleObservationState(serviceBinding *cfresource.ServiceCredentialBinding, ctx context.Context, cr v1alpha1.ServiceCredentialBindingManaged) (managed.ExternalObservation, error) {
 	switch serviceBinding.LastOperation.State {
 	case v1alpha1.LastOperationInitial, v1alpha1.LastOperationInProgress:
 		cr.SetConditions(xpv1.Unavailable().WithMessage(serviceBinding.LastOperation.Description))
@@ -338,8 +350,8 @@ func (c *external) HandleObservationState(serviceBinding *cfresource.ServiceCred
 
 		return managed.ExternalObservation{
 			ResourceExists:    true,
-			ResourceUpToDate:  scb.IsUpToDate(ctx, cr, cr.Spec.ForProvider, *serviceBinding) && !c.keyRotator.HasExpiredKeys(cr),
-			ConnectionDetails: scb.GetConnectionDetails(ctx, c.scbClient, serviceBinding.GUID, cr.Spec.ConnectionDetailsAsJSON),
+			ResourceUpToDate:  scb.IsUpToDate(ctx, cr, *cr.GetForProvider(), *serviceBinding) && !c.keyRotator.HasExpiredKeys(cr),
+			ConnectionDetails: scb.GetConnectionDetails(ctx, c.scbClient, serviceBinding.GUID, cr.GetConnectionDetailsAsJSON()),
 		}, nil
 	}
 
@@ -347,7 +359,7 @@ func (c *external) HandleObservationState(serviceBinding *cfresource.ServiceCred
 	return managed.ExternalObservation{}, errors.New(errUnknownState)
 }
 
-func getCreateAttempts(cr *v1alpha1.ServiceCredentialBinding) int {
+func getCreateAttempts(cr v1alpha1.ServiceCredentialBindingManaged) int {
 	val := cr.GetAnnotations()[createAttemptsAnnotation]
 	if val == "" {
 		return 0
@@ -359,14 +371,25 @@ func getCreateAttempts(cr *v1alpha1.ServiceCredentialBinding) int {
 	return n
 }
 
-func incrementCreateAttempts(cr *v1alpha1.ServiceCredentialBinding) {
+func incrementCreateAttempts(cr v1alpha1.ServiceCredentialBindingManaged) {
 	n := getCreateAttempts(cr)
 	meta.AddAnnotations(cr, map[string]string{
 		createAttemptsAnnotation: strconv.Itoa(n + 1),
 	})
 }
 
-func resetCreateAttempts(cr *v1alpha1.ServiceCredentialBinding) {
+func decrementCreateAttempts(cr v1alpha1.ServiceCredentialBindingManaged) {
+	n := getCreateAttempts(cr)
+	if n <= 1 {
+		resetCreateAttempts(cr)
+		return
+	}
+	meta.AddAnnotations(cr, map[string]string{
+		createAttemptsAnnotation: strconv.Itoa(n - 1),
+	})
+}
+
+func resetCreateAttempts(cr v1alpha1.ServiceCredentialBindingManaged) {
 	meta.RemoveAnnotations(cr, createAttemptsAnnotation)
 }
 
@@ -377,6 +400,6 @@ func isValidUUID(s string) bool {
 
 // isCircuitBreakerTripped returns true if the maximum number of create attempts has been reached.
 // If the resource is being deleted, the circuit breaker is bypassed to allow cleanup.
-func isCircuitBreakerTripped(cr *v1alpha1.ServiceCredentialBinding) bool {
+func isCircuitBreakerTripped(cr v1alpha1.ServiceCredentialBindingManaged) bool {
 	return getCreateAttempts(cr) >= maxCreateAttempts && cr.GetDeletionTimestamp().IsZero()
 }
@@ -28,6 +28,7 @@ import (
 
 var (
 	errCFClientError          = errors.New("boom")
+	errCFUnavailable          = cfresource.CloudFoundryError{Code: 10015, Title: "CF-ServiceUnavailable", Detail: "Service unavailable"}
 	errServiceInstanceMissing = errors.New(servicecredentialbinding.ErrServiceInstanceMissing)
 	errAppMissing             = errors.New(servicecredentialbinding.ErrAppMissing)
 	name                      = "my-service-credential-binding"
@@ -40,7 +41,7 @@ type MockObservationStateHandler struct {
 	mock.Mock
 }
 
-func (m *MockObservationStateHandler) HandleObservationState(serviceBinding *cfresource.ServiceCredentialBinding, ctx context.Context, cr *v1alpha1.ServiceCredentialBinding) (managed.ExternalObservation, error) {
+func (m *MockObservationStateHandler) HandleObservationState(serviceBinding *cfresource.ServiceCredentialBinding, ctx context.Context, cr v1alpha1.ServiceCredentialBindingManaged) (managed.ExternalObservation, error) {
 	args := m.Called(serviceBinding, ctx, cr)
 	return args.Get(0).(managed.ExternalObservation), args.Error(1)
 }
@@ -1125,6 +1126,26 @@ func TestCreate(t *testing.T) {
 				return m
 			},
 		},
+		"TransientError": {
+			args: args{
+				mg: serviceCredentialBinding("key", withServiceInstanceID(serviceInstanceGUID), withCreateAttempts(2)),
+			},
+			want: want{
+				// transient errors do not count towards the circuit breaker
+				mg:  serviceCredentialBinding("key", withServiceInstanceID(serviceInstanceGUID), withCreateAttempts(2)),
+				obs: managed.ExternalCreation{},
+				err: fmt.Errorf(errCreate, errCFUnavailable),
+			},
+			service: func() *fake.MockServiceCredentialBinding {
+				m := &fake.MockServiceCredentialBinding{}
+				m.On("Create", mock.Anything, mock.Anything).Return(
+					"",
+					fake.ServiceCredentialBindingNil,
+					errCFUnavailable,
+				)
+				return m
+			},
+		},
 		"AlreadyExist": {
 			args: args{
 				mg: serviceCredentialBinding("key", withServiceInstanceID(serviceInstanceGUID)),
@@ -12,15 +12,19 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/nsf/jsondiff"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	apisv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/serviceinstance"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/space"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
@@ -46,15 +50,23 @@ const (
 	errUpdateSharedSpaces = "cannot update shared spaces"
 )
 
-// Setup adds a controller that reconciles ServiceInstance CR.
+// Setup adds controllers that reconcile cluster scoped and namespaced ServiceInstance
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.ServiceInstance_GroupKind)
+	if err := setup(mgr, o, v1alpha1.ServiceInstance_GroupVersionKind, &v1alpha1.ServiceInstance{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.ServiceInstance_GroupVersionKind, &nsv1alpha1.ServiceInstance{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
-		managed.WithExternalConnector(&connector{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
 			kube:  mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1beta1.ProviderConfigUsage{}),
-		}),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithTimeout(5 * time.Minute), // increase timeout for long-running operations
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -70,21 +82,21 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.ServiceInstance_GroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.ServiceInstance{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an external client when its Connect method
 // is called.
 type connector struct {
 	kube  k8s.Client
-	usage resource.LegacyTracker
+	usage resource.Tracker
 }
 
 // Connect typically produces an ExternalClient by:
@@ -93,11 +105,11 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.ServiceInstance); !ok {
+	if _, ok := mg.(v1alpha1.ServiceInstanceManaged); !ok {
 		return nil, errors.New(errWrongCRType)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
@@ -127,7 +139,7 @@ type external struct {
 
 // Observe checks if the external resource exists and if it does, it observes it.
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { //nolint:gocyclo
-	cr, ok := mg.(*v1alpha1.ServiceInstance)
+	cr, ok := mg.(v1alpha1.ServiceInstanceManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errWrongCRType)
 	}
@@ -141,7 +153,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Errorf("external-name '%s' is not a valid GUID format", guid)
 	}
 
-	r, err := serviceinstance.GetByGUIDOrSpec(ctx, c.serviceinstance, guid, cr.Spec.ForProvider)
+	r, err := serviceinstance.GetByGUIDOrSpec(ctx, c.serviceinstance, guid, *cr.GetForProvider())
 	if err != nil {
 		if clients.ErrorIsNotFound(err) {
 			return managed.ExternalObservation{ResourceExists: false}, nil
@@ -161,7 +173,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	// Update atProvider from the retrieved the service instance
-	serviceinstance.UpdateObservation(&cr.Status.AtProvider, r)
+	serviceinstance.UpdateObservation(cr.GetAtProvider(), r)
 
 	// If the CR is marked for deletion we stop normal observe logic.
 	// We report "resource exists" so Crossplane will call Delete() next.
@@ -191,29 +203,29 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		// Empty state is treated as succeeded (happens with user-provided services that have no async operations)
 		cr.SetConditions(xpv1.Available())
 		var credentialsUpToDate bool
-		desiredCredentials, err := extractCredentialSpec(ctx, c.kube, cr.Spec.ForProvider)
+		desiredCredentials, err := extractCredentialSpec(ctx, c.kube, cr)
 		if err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errSecret)
 		}
 		// If parameter drift detection is enable, get actual credentials from the service instance
-		if cr.Spec.EnableParameterDriftDetection {
+		if cr.GetEnableParameterDriftDetection() {
 			// Get the parameters of the service instance for drift detection
 			cred, err := c.serviceinstance.GetServiceCredentials(ctx, r)
 			if err != nil {
 				return managed.ExternalObservation{ResourceExists: true}, errors.Wrap(err, errGetParameters)
 			}
-			cr.Status.AtProvider.Credentials = iSha256(cred)
+			cr.GetAtProvider().Credentials = iSha256(cred)
 			credentialsUpToDate = jsonContain(cred, desiredCredentials)
 		} else {
 			desiredHash := iSha256(desiredCredentials)
-			credentialsUpToDate = bytes.Equal(desiredHash, cr.Status.AtProvider.Credentials)
+			credentialsUpToDate = bytes.Equal(desiredHash, cr.GetAtProvider().Credentials)
 		}
 		// Check if the credentials in the spec match the credentials in the external resource
-		upToDate := credentialsUpToDate && serviceinstance.IsUpToDate(cr, &cr.Spec.ForProvider, r)
+		upToDate := credentialsUpToDate && serviceinstance.IsUpToDate(cr, cr.GetForProvider(), r)
 
 		// Check if shared spaces are up to date (only if field is explicitly set)
-		if cr.Spec.ForProvider.SharedSpaces != nil {
-			sharedSpacesUpToDate, err := c.serviceinstance.AreSharedSpacesUpToDate(ctx, r.GUID, cr.Spec.ForProvider.SharedSpaces)
+		if cr.GetForProvider().SharedSpaces != nil {
+			sharedSpacesUpToDate, err := c.serviceinstance.AreSharedSpacesUpToDate(ctx, r.GUID, cr.GetForProvider().SharedSpaces)
 			if err != nil {
 				return managed.ExternalObservation{ResourceExists: true}, errors.Wrap(err, errCheckSharedSpaces)
 			}
@@ -231,14 +243,14 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 // Create attempts to create the external resource.
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) { //nolint:gocyclo
-	cr, ok := mg.(*v1alpha1.ServiceInstance)
+	cr, ok := mg.(v1alpha1.ServiceInstanceManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errWrongCRType)
 	}
 
 	// On a failed create, clean up then clear the stale external-name so a re-create
 	// whose poll times out is re-adopted by spec instead of resolving the deleted GUID.
-	if cr.Status.AtProvider.Type == v1alpha1.LastOperationCreate && cr.Status.AtProvider.State == v1alpha1.LastOperationFailed {
+	if cr.GetAtProvider().Type == v1alpha1.LastOperationCreate && cr.GetAtProvider().State == v1alpha1.LastOperationFailed {
 		if guid := meta.GetExternalName(cr); clients.IsValidGUID(guid) {
 			if err := c.serviceinstance.Delete(ctx, guid); err != nil {
 				return managed.ExternalCreation{}, errors.Wrap(err, errCleanFailed)
@@ -253,12 +265,12 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	cr.SetConditions(xpv1.Creating())
 
 	// Extract the parameters or credentials from the spec as a json.RawMessage
-	creds, err := extractCredentialSpec(ctx, c.kube, cr.Spec.ForProvider)
+	creds, err := extractCredentialSpec(ctx, c.kube, cr)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errSecret)
 	}
 
-	r, err := c.serviceinstance.Create(ctx, cr, cr.Spec.ForProvider, creds)
+	r, err := c.serviceinstance.Create(ctx, cr, *cr.GetForProvider(), creds)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
@@ -275,7 +287,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 // postCreate sets the external name, persists the CR and status, and reconciles shared spaces after a successful creation.
-func (c *external) postCreate(ctx context.Context, cr *v1alpha1.ServiceInstance, guid string, creds []byte) error {
+func (c *external) postCreate(ctx context.Context, cr v1alpha1.ServiceInstanceManaged, guid string, creds []byte) error {
 	meta.SetExternalName(cr, guid)
 
 	// Update the CR before updating the status so that the status update is not lost.
@@ -284,13 +296,13 @@ func (c *external) postCreate(ctx context.Context, cr *v1alpha1.ServiceInstance,
 	}
 
 	// Save hash value of credentials in the status of the CR
-	cr.Status.AtProvider.Credentials = iSha256(creds)
+	cr.GetAtProvider().Credentials = iSha256(creds)
 	if err := c.kube.Status().Update(ctx, cr); err != nil {
 		return errors.Wrap(err, errUpdateCR)
 	}
 
-	if len(cr.Spec.ForProvider.SharedSpaces) > 0 {
-		if err := c.serviceinstance.UpdateSharedSpaces(ctx, guid, cr.Spec.ForProvider.SharedSpaces); err != nil {
+	if len(cr.GetForProvider().SharedSpaces) > 0 {
+		if err := c.serviceinstance.UpdateSharedSpaces(ctx, guid, cr.GetForProvider().SharedSpaces); err != nil {
 			return errors.Wrap(err, errUpdateSharedSpaces)
 		}
 	}
@@ -300,7 +312,7 @@ func (c *external) postCreate(ctx context.Context, cr *v1alpha1.ServiceInstance,
 
 // Update attempts to update the external resource to reflect the managed resource's desired state.
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1alpha1.ServiceInstance)
+	cr, ok := mg.(v1alpha1.ServiceInstanceManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errWrongCRType)
 	}
@@ -311,25 +323,25 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.Errorf("external-name '%s' is not a valid GUID format", guid)
 	}
 
-	creds, err := extractCredentialSpec(ctx, c.kube, cr.Spec.ForProvider)
+	creds, err := extractCredentialSpec(ctx, c.kube, cr)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errSecret)
 	}
 
-	if _, err := c.serviceinstance.Update(ctx, guid, cr, &cr.Spec.ForProvider, creds); err != nil {
+	if _, err := c.serviceinstance.Update(ctx, guid, cr, cr.GetForProvider(), creds); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 	}
 
 	if creds != nil {
-		cr.Status.AtProvider.Credentials = iSha256(creds)
+		cr.GetAtProvider().Credentials = iSha256(creds)
 		if err := c.kube.Status().Update(ctx, cr); err != nil {
 			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCR)
 		}
 	}
 
 	// Update shared spaces after successful update (only if field is explicitly set)
-	if cr.Spec.ForProvider.SharedSpaces != nil {
-		if err := c.serviceinstance.UpdateSharedSpaces(ctx, guid, cr.Spec.ForProvider.SharedSpaces); err != nil {
+	if cr.GetForProvider().SharedSpaces != nil {
+		if err := c.serviceinstance.UpdateSharedSpaces(ctx, guid, cr.GetForProvider().SharedSpaces); err != nil {
 			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSharedSpaces)
 		}
 	}
@@ -339,7 +351,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Delete attempts to delete the external resource.
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.ServiceInstance)
+	cr, ok := mg.(v1alpha1.ServiceInstanceManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errWrongCRType)
 	}
@@ -363,7 +375,8 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 // extractCredentialSpec returns the parameters or credentials from the spec
-func extractCredentialSpec(ctx context.Context, kube k8s.Client, spec v1alpha1.ServiceInstanceParameters) ([]byte, error) {
+func extractCredentialSpec(ctx context.Context, kube k8s.Client, cr v1alpha1.ServiceInstanceManaged) ([]byte, error) {
+	spec := cr.GetForProvider()
 	if spec.Type == v1alpha1.ManagedService {
 		if spec.Parameters != nil {
 			return spec.Parameters.Raw, nil
@@ -374,7 +387,7 @@ func extractCredentialSpec(ctx context.Context, kube k8s.Client, spec v1alpha1.S
 		}
 
 		if spec.ParametersSecretRef != nil {
-			return clients.ExtractSecret(ctx, kube, spec.ParametersSecretRef.SecretReference, spec.ParametersSecretRef.Key)
+			return clients.ExtractManagedSecret(ctx, kube, cr, spec.ParametersSecretRef.SecretReference, spec.ParametersSecretRef.Key)
 		}
 	}
 
@@ -387,7 +400,7 @@ func extractCredentialSpec(ctx context.Context, kube k8s.Client, spec v1alpha1.S
 			return []byte(*spec.JSONCredentials), nil
 		}
 		if spec.CredentialsSecretRef != nil {
-			return clients.ExtractSecret(ctx, kube, spec.CredentialsSecretRef.SecretReference, spec.CredentialsSecretRef.Key)
+			return clients.ExtractManagedSecret(ctx, kube, cr, spec.CredentialsSecretRef.SecretReference, spec.CredentialsSecretRef.Key)
 		}
 	}
 	return nil, nil
@@ -416,18 +429,54 @@ type spaceInitializer struct {
 
 // / Initialize implements the Initializer interface
 func (c spaceInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
-	cr, ok := mg.(*v1alpha1.ServiceInstance)
+	cr, ok := mg.(v1alpha1.ServiceInstanceManaged)
 	if !ok {
 		return errors.New(errWrongCRType)
 	}
 
-	if cr.Spec.ForProvider.SpaceRef != nil || cr.Spec.ForProvider.SpaceSelector != nil {
+	if cr.GetForProvider().SpaceRef != nil || cr.GetForProvider().SpaceSelector != nil {
 		return cr.ResolveReferences(ctx, c.kube)
 	}
 
 	return space.ResolveByName(ctx, clients.ClientFnBuilder(ctx, c.kube), mg)
 }
 
+// ResolveServicePlanReference resolves the service plan ID from the ServicePlan
+// referenced by servicePlanRef or servicePlanSelector. A namespaced
+// ServiceInstance references a ServicePlan in its namespace.
+func ResolveServicePlanReference(ctx context.Context, cr v1alpha1.ServiceInstanceManaged, c k8s.Reader) error {
+	fp := cr.GetForProvider()
+	if fp.ServicePlan == nil {
+		fp.ServicePlan = &v1alpha1.ServicePlanParameters{}
+	}
+
+	req := reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(fp.ServicePlan.ID),
+		Extract:      resources.ExternalID(),
+		Reference:    fp.ServicePlanRef,
+		Selector:     fp.ServicePlanSelector,
+		To: reference.To{
+			List:    &v1alpha1.ServicePlanList{},
+			Managed: &v1alpha1.ServicePlan{},
+		},
+	}
+	if _, ok := cr.(*nsv1alpha1.ServiceInstance); ok {
+		req.Namespace = cr.GetNamespace()
+		req.To = reference.To{
+			List:    &nsv1alpha1.ServicePlanList{},
+			Managed: &nsv1alpha1.ServicePlan{},
+		}
+	}
+
+	rsp, err := reference.NewAPIResolver(c, cr).Resolve(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "mg.GetForProvider().ServicePlan.ID")
+	}
+	fp.ServicePlan.ID = reference.ToPtrValue(rsp.ResolvedValue)
+	fp.ServicePlanRef = rsp.ResolvedReference
+	return nil
+}
+
 // A servicePlanInitializer is expected to initialize the service plan of a ServiceInstance
 type servicePlanInitializer struct {
 	kube k8s.Client
@@ -435,15 +484,20 @@ type servicePlanInitializer struct {
 
 // Initialize implements crossplane InitializeFn interface
 func (s servicePlanInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
-	cr, ok := mg.(*v1alpha1.ServiceInstance)
+	cr, ok := mg.(v1alpha1.ServiceInstanceManaged)
 	if !ok {
 		return errors.New(errWrongCRType)
 	}
-	if cr.Spec.ForProvider.Type != "managed" {
+	if cr.GetForProvider().Type != "managed" {
 		return nil
 	}
 
-	if cr.Spec.ForProvider.ServicePlan != nil {
+	if fp := cr.GetForProvider(); fp.ServicePlanRef != nil || fp.ServicePlanSelector != nil {
+		// The ID of an observed ServicePlan is the GUID of a service plan.
+		return ResolveServicePlanReference(ctx, cr, s.kube)
+	}
+
+	if cr.GetForProvider().ServicePlan != nil {
 		// When ServicePlan is set we either populate/update the service plan ID with the external resource GUID
 		// based on the specified offering and plan or we use the provided ID directly.
 		cf, err := clients.ClientFnBuilder(ctx, s.kube)(mg)
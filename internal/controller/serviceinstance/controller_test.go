@@ -1876,7 +1876,7 @@ func TestDelete(t *testing.T) {
 		err error
 	}
 
-	notFound := errors.New("CF-ResourceNotFound")
+	notFound := cfresource.NewResourceNotFoundError()
 
 	cases := map[string]struct {
 		args    args
@@ -2110,3 +2110,73 @@ func TestJSONContain(t *testing.T) {
 		})
 	}
 }
+
+func TestServicePlanInitializer(t *testing.T) {
+	errBoom := errors.New("boom")
+	planGUID := "a7c8e1d2-3f4b-4c5d-9e6f-7a8b9c0d1e2f"
+
+	type want struct {
+		cr  *v1alpha1.ServiceInstance
+		err error
+	}
+
+	withServicePlanRef := func(name string) modifier {
+		return func(r *v1alpha1.ServiceInstance) {
+			r.Spec.ForProvider.ServicePlanRef = &xpv1.Reference{Name: name}
+		}
+	}
+
+	getServicePlan := func(_ context.Context, _ k8s.ObjectKey, obj k8s.Object) error {
+		obj.(*v1alpha1.ServicePlan).Status.AtProvider.ID = ptr.To(planGUID)
+		return nil
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.ServiceInstance
+		kube k8s.Client
+		want want
+	}{
+		"UserProvided": {
+			cr:   serviceInstance("user-provided"),
+			want: want{cr: serviceInstance("user-provided")},
+		},
+		"ServicePlanRef": {
+			cr:   serviceInstance("managed", withServicePlanRef("small")),
+			kube: &test.MockClient{MockGet: getServicePlan},
+			want: want{
+				cr: serviceInstance("managed", withServicePlanRef("small"), withServicePlan(v1alpha1.ServicePlanParameters{ID: ptr.To(planGUID)})),
+			},
+		},
+		"ServicePlanRefNotFound": {
+			cr:   serviceInstance("managed", withServicePlanRef("small")),
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{
+				cr:  serviceInstance("managed", withServicePlanRef("small"), withServicePlan(v1alpha1.ServicePlanParameters{})),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get referenced resource"), "mg.GetForProvider().ServicePlan.ID"),
+			},
+		},
+		"MissingServicePlan": {
+			cr: serviceInstance("managed"),
+			want: want{
+				cr:  serviceInstance("managed"),
+				err: errors.New(errMissingServicePlan),
+			},
+		},
+		"ExternalNameWithoutServicePlan": {
+			cr:   serviceInstance("managed", withExternalName(guid)),
+			want: want{cr: serviceInstance("managed", withExternalName(guid))},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			err := servicePlanInitializer{kube: tc.kube}.Initialize(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("Initialize(...): -want cr, +got cr:\n%s", diff)
+			}
+		})
+	}
+}
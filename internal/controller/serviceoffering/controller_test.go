@@ -0,0 +1,157 @@
+package serviceoffering
+
+import (
+	"context"
+	"testing"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	resourceName = "my-service"
+	guid         = "6bcd7f99-0c8a-4b7d-8e6f-2a8d0c7b3f56"
+	name         = "my-service"
+)
+
+type modifier func(*v1alpha1.ServiceOffering)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.ServiceOffering) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.ServiceOffering) { r.Status.SetConditions(c...) }
+}
+
+func serviceOffering(m ...modifier) *v1alpha1.ServiceOffering {
+	r := &v1alpha1.ServiceOffering{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.ServiceOfferingSpec{
+			ForProvider: v1alpha1.ServiceOfferingParameters{Name: name},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+func offering(available bool) *cfresource.ServiceOffering {
+	return &cfresource.ServiceOffering{Name: name, Available: available, Resource: cfresource.Resource{GUID: guid}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockServiceOffering
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockServiceOffering { return &fake.MockServiceOffering{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"NotFoundByName": {
+			mg: serviceOffering(),
+			service: func() *fake.MockServiceOffering {
+				m := &fake.MockServiceOffering{}
+				m.On("Single").Return(nil, fake.ErrNoResultReturned)
+				return m
+			},
+			want: want{
+				mg:  serviceOffering(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AdoptByName": {
+			mg: serviceOffering(),
+			service: func() *fake.MockServiceOffering {
+				m := &fake.MockServiceOffering{}
+				m.On("Single").Return(offering(true), nil)
+				m.On("Get", guid).Return(offering(true), nil)
+				return m
+			},
+			want: want{
+				mg:  serviceOffering(withExternalName(guid), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"NotAvailable": {
+			mg: serviceOffering(withExternalName(guid)),
+			service: func() *fake.MockServiceOffering {
+				m := &fake.MockServiceOffering{}
+				m.On("Get", guid).Return(offering(false), nil)
+				return m
+			},
+			want: want{
+				mg:  serviceOffering(withExternalName(guid), withConditions(xpv1.Unavailable().WithMessage(msgUnavailable))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GetError": {
+			mg: serviceOffering(withExternalName(guid)),
+			service: func() *fake.MockServiceOffering {
+				m := &fake.MockServiceOffering{}
+				m.On("Get", guid).Return(nil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  serviceOffering(withExternalName(guid)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				opts := cmp.Options{test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.ServiceOfferingStatus{}, "AtProvider")}
+				if diff := cmp.Diff(tc.want.mg, tc.mg, opts); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	c := &external{client: &fake.MockServiceOffering{}}
+	_, err := c.Create(context.Background(), serviceOffering())
+	if diff := cmp.Diff(errors.New(errCreate), err, test.EquateErrors()); diff != "" {
+		t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+	}
+}
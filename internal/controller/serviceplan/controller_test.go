@@ -0,0 +1,158 @@
+package serviceplan
+
+import (
+	"context"
+	"testing"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	resourceName = "small"
+	guid         = "7cde8a00-1d9b-4c8e-9f7a-3b9e1d8c4a67"
+	name         = "small"
+)
+
+type modifier func(*v1alpha1.ServicePlan)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.ServicePlan) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.ServicePlan) { r.Status.SetConditions(c...) }
+}
+
+func servicePlan(m ...modifier) *v1alpha1.ServicePlan {
+	r := &v1alpha1.ServicePlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.ServicePlanSpec{
+			ForProvider: v1alpha1.ServicePlanParameters{Offering: ptr.To("my-service"), Plan: ptr.To(name)},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+func plan(available bool) *cfresource.ServicePlan {
+	return &cfresource.ServicePlan{Name: name, Available: available, Resource: cfresource.Resource{GUID: guid}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockServicePlan
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockServicePlan { return &fake.MockServicePlan{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"NotFoundByName": {
+			mg: servicePlan(),
+			service: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Single").Return((*cfresource.ServicePlan)(nil), fake.ErrNoResultReturned)
+				return m
+			},
+			want: want{
+				mg:  servicePlan(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AdoptByName": {
+			mg: servicePlan(),
+			service: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Single").Return(plan(true), nil)
+				m.On("Get", guid).Return(plan(true), nil)
+				return m
+			},
+			want: want{
+				mg:  servicePlan(withExternalName(guid), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"NotAvailable": {
+			mg: servicePlan(withExternalName(guid)),
+			service: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Get", guid).Return(plan(false), nil)
+				return m
+			},
+			want: want{
+				mg:  servicePlan(withExternalName(guid), withConditions(xpv1.Unavailable().WithMessage(msgUnavailable))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GetError": {
+			mg: servicePlan(withExternalName(guid)),
+			service: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Get", guid).Return((*cfresource.ServicePlan)(nil), errBoom)
+				return m
+			},
+			want: want{
+				mg:  servicePlan(withExternalName(guid)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				opts := cmp.Options{test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.ServicePlanStatus{}, "AtProvider")}
+				if diff := cmp.Diff(tc.want.mg, tc.mg, opts); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	c := &external{client: &fake.MockServicePlan{}}
+	_, err := c.Create(context.Background(), servicePlan())
+	if diff := cmp.Diff(errors.New(errCreate), err, test.EquateErrors()); diff != "" {
+		t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+	}
+}
@@ -0,0 +1,239 @@
+package serviceplanvisibility
+
+import (
+	"context"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/serviceplan"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/serviceplanvisibility"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
+)
+
+const (
+	resourceType   = "ServicePlanVisibility"
+	externalSystem = "Cloud Foundry"
+	errWrongKind   = "managed resource is not of kind " + resourceType
+	errTrackUsage  = "cannot track usage"
+	errGetClient   = "cannot create a client to talk to the API of " + externalSystem
+	errGet         = "cannot get " + resourceType + " in " + externalSystem
+	errGetPlan     = "cannot get the service plan of the " + resourceType
+	errCreate      = "cannot create " + resourceType + " in " + externalSystem
+	errUpdate      = "cannot update " + resourceType
+	errDelete      = "cannot delete " + resourceType
+)
+
+// Setup adds controllers that reconcile cluster scoped and namespaced
+// ServicePlanVisibility managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := setup(mgr, o, v1alpha1.ServicePlanVisibility_GroupVersionKind, &v1alpha1.ServicePlanVisibility{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.ServicePlanVisibility_GroupVersionKind, &nsv1alpha1.ServicePlanVisibility{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+
+	options := []managed.ReconcilerOption{
+		managed.WithInitializers(),
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
+			kube:  mgr.GetClient(),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		options = append(options, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(gvk),
+		options...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
+}
+
+// A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
+type connector struct {
+	kube  k8s.Client
+	usage resource.Tracker
+}
+
+// Connect tracks the usage of the ProviderConfig and creates a
+// ServicePlanVisibility client from its credentials.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(v1alpha1.ServicePlanVisibilityManaged); !ok {
+		return nil, errors.New(errWrongKind)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetClient)
+	}
+
+	visibility, plans := serviceplanvisibility.NewClient(cf)
+	return &external{client: visibility, plans: plans}, nil
+}
+
+// An external is a managed.ExternalClient that is using the CloudFoundry API to observe and modify resources.
+type external struct {
+	client serviceplanvisibility.Client
+	plans  serviceplan.Client
+}
+
+// Disconnect implements the managed.ExternalClient interface
+func (c *external) Disconnect(ctx context.Context) error {
+	// No cleanup needed for Cloud Foundry client
+	return nil
+}
+
+// Observe managed resource ServicePlanVisibility. The external-name is the
+// GUID of the service plan, which is looked up by ID or by offering and plan
+// name when empty.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(v1alpha1.ServicePlanVisibilityManaged)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errWrongKind)
+	}
+
+	lateInitialized := false
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		// every service plan has a visibility, adopt it
+		planGUID, err := serviceplanvisibility.FindServicePlan(ctx, c.plans, cr.GetForProvider().ServicePlan)
+		if err != nil {
+			if clients.ErrorIsNotFound(err) {
+				return managed.ExternalObservation{ResourceExists: false}, nil
+			}
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetPlan)
+		}
+		guid = planGUID
+		meta.SetExternalName(cr, guid)
+		lateInitialized = true
+	}
+
+	if !clients.IsValidGUID(guid) {
+		return managed.ExternalObservation{}, errors.Errorf("external-name '%s' is not a valid GUID format", guid)
+	}
+
+	v, err := c.client.Get(ctx, guid)
+	if err != nil {
+		if clients.ErrorIsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	// the visibility of a plan cannot be deleted, it is gone once Delete has
+	// restricted the plan to admins again
+	if meta.WasDeleted(cr) && (v.Type == cfresource.ServicePlanVisibilityAdmin.String() || v.Type == cfresource.ServicePlanVisibilitySpace.String()) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	*cr.GetAtProvider() = serviceplanvisibility.GenerateObservation(guid, v)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        serviceplanvisibility.IsUpToDate(*cr.GetForProvider(), v),
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+// Create a managed resource ServicePlanVisibility by replacing the visibility
+// of the service plan.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(v1alpha1.ServicePlanVisibilityManaged)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	planGUID, err := serviceplanvisibility.FindServicePlan(ctx, c.plans, cr.GetForProvider().ServicePlan)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPlan)
+	}
+
+	if _, err := c.client.Update(ctx, planGUID, serviceplanvisibility.GenerateUpdate(*cr.GetForProvider())); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, planGUID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update managed resource ServicePlanVisibility
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(v1alpha1.ServicePlanVisibilityManaged)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errWrongKind)
+	}
+
+	guid := meta.GetExternalName(cr)
+	v, err := c.client.Get(ctx, guid)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+
+	if err := serviceplanvisibility.UpdateVisibility(ctx, c.client, guid, *cr.GetForProvider(), v); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete managed resource ServicePlanVisibility. A service plan cannot be
+// without visibility, so it is restricted to admins again. The visibility of
+// plans of space-scoped service brokers cannot be changed and is left as is.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(v1alpha1.ServicePlanVisibilityManaged)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	if t := cr.GetAtProvider().Type; t != nil && *t == cfresource.ServicePlanVisibilitySpace.String() {
+		return managed.ExternalDelete{}, nil
+	}
+
+	if _, err := c.client.Update(ctx, guid, cfresource.NewServicePlanVisibilityUpdate(cfresource.ServicePlanVisibilityAdmin)); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(clients.IgnoreNotFoundErr(err), errDelete)
+	}
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,413 @@
+package serviceplanvisibility
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	resourceName = "my-plan-visibility"
+	planGUID     = "3e9a4c66-7f5d-4e4a-9b3c-9d5a7f4e0c23"
+	orgGUID      = "4fab5d77-8a6e-4f5b-8c4d-0e6b8a5f1d34"
+	otherOrgGUID = "5abc6e88-9b7f-4a6c-9d5e-1f7c9b6a2e45"
+)
+
+type modifier func(*v1alpha1.ServicePlanVisibility)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.ServicePlanVisibility) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withType(t string, orgs ...string) modifier {
+	return func(r *v1alpha1.ServicePlanVisibility) {
+		r.Spec.ForProvider.Type = t
+		r.Spec.ForProvider.Orgs = nil
+		for _, org := range orgs {
+			r.Spec.ForProvider.Orgs = append(r.Spec.ForProvider.Orgs, ptr.To(org))
+		}
+	}
+}
+
+func withObservation(t string, orgs ...string) modifier {
+	return func(r *v1alpha1.ServicePlanVisibility) {
+		r.Status.AtProvider = v1alpha1.ServicePlanVisibilityObservation{ServicePlan: ptr.To(planGUID), Type: ptr.To(t)}
+		for _, org := range orgs {
+			r.Status.AtProvider.Orgs = append(r.Status.AtProvider.Orgs, ptr.To(org))
+		}
+	}
+}
+
+func withDeletionTimestamp() modifier {
+	return func(r *v1alpha1.ServicePlanVisibility) {
+		r.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1, 0)})
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.ServicePlanVisibility) { r.Status.SetConditions(c...) }
+}
+
+func servicePlanVisibility(m ...modifier) *v1alpha1.ServicePlanVisibility {
+	r := &v1alpha1.ServicePlanVisibility{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.ServicePlanVisibilitySpec{
+			ForProvider: v1alpha1.ServicePlanVisibilityParameters{
+				ServicePlan: v1alpha1.ServicePlanParameters{Offering: ptr.To("my-service"), Plan: ptr.To("small")},
+				Type:        "organization",
+				Orgs:        []*string{ptr.To(orgGUID)},
+			},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+func visibility(t string, orgs ...string) *cfresource.ServicePlanVisibility {
+	v := &cfresource.ServicePlanVisibility{Type: t}
+	for _, org := range orgs {
+		v.Organizations = append(v.Organizations, cfresource.ServicePlanVisibilityRelation{GUID: org})
+	}
+	return v
+}
+
+func plan() *cfresource.ServicePlan {
+	return &cfresource.ServicePlan{Resource: cfresource.Resource{GUID: planGUID}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockServicePlanVisibility
+		plans   func() *fake.MockServicePlan
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockServicePlanVisibility { return &fake.MockServicePlanVisibility{} },
+			plans:   func() *fake.MockServicePlan { return &fake.MockServicePlan{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"PlanNotFound": {
+			mg:      servicePlanVisibility(),
+			service: func() *fake.MockServicePlanVisibility { return &fake.MockServicePlanVisibility{} },
+			plans: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Single").Return((*cfresource.ServicePlan)(nil), fake.ErrNoResultReturned)
+				return m
+			},
+			want: want{
+				mg:  servicePlanVisibility(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AdoptPlan": {
+			mg: servicePlanVisibility(),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Get", planGUID).Return(visibility("admin"), nil)
+				return m
+			},
+			plans: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Single").Return(plan(), nil)
+				return m
+			},
+			want: want{
+				mg:  servicePlanVisibility(withExternalName(planGUID), withObservation("admin"), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true},
+			},
+		},
+		"UpToDate": {
+			mg: servicePlanVisibility(withExternalName(planGUID)),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Get", planGUID).Return(visibility("organization", orgGUID), nil)
+				return m
+			},
+			plans: func() *fake.MockServicePlan { return &fake.MockServicePlan{} },
+			want: want{
+				mg:  servicePlanVisibility(withExternalName(planGUID), withObservation("organization", orgGUID), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"OrganizationsOutdated": {
+			mg: servicePlanVisibility(withExternalName(planGUID)),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Get", planGUID).Return(visibility("organization", orgGUID, otherOrgGUID), nil)
+				return m
+			},
+			plans: func() *fake.MockServicePlan { return &fake.MockServicePlan{} },
+			want: want{
+				mg:  servicePlanVisibility(withExternalName(planGUID), withObservation("organization", orgGUID, otherOrgGUID), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ResetOnDeletion": {
+			mg: servicePlanVisibility(withExternalName(planGUID), withDeletionTimestamp()),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Get", planGUID).Return(visibility("admin"), nil)
+				return m
+			},
+			plans: func() *fake.MockServicePlan { return &fake.MockServicePlan{} },
+			want: want{
+				mg:  servicePlanVisibility(withExternalName(planGUID), withDeletionTimestamp()),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NotYetResetOnDeletion": {
+			mg: servicePlanVisibility(withExternalName(planGUID), withDeletionTimestamp()),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Get", planGUID).Return(visibility("organization", orgGUID), nil)
+				return m
+			},
+			plans: func() *fake.MockServicePlan { return &fake.MockServicePlan{} },
+			want: want{
+				mg:  servicePlanVisibility(withExternalName(planGUID), withDeletionTimestamp(), withObservation("organization", orgGUID), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GetError": {
+			mg: servicePlanVisibility(withExternalName(planGUID)),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Get", planGUID).Return(nil, errBoom)
+				return m
+			},
+			plans: func() *fake.MockServicePlan { return &fake.MockServicePlan{} },
+			want: want{
+				mg:  servicePlanVisibility(withExternalName(planGUID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc, plans := tc.service(), tc.plans()
+			c := &external{client: svc, plans: plans}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+			svc.AssertExpectations(t)
+			plans.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockServicePlanVisibility
+		plans   func() *fake.MockServicePlan
+		want    want
+	}{
+		"Success": {
+			mg: servicePlanVisibility(),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Update", planGUID, visibility("organization", orgGUID)).Return(visibility("organization", orgGUID), nil)
+				return m
+			},
+			plans: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Single").Return(plan(), nil)
+				return m
+			},
+			want: want{
+				mg: servicePlanVisibility(withExternalName(planGUID), withConditions(xpv1.Creating())),
+			},
+		},
+		"PlanNotFound": {
+			mg:      servicePlanVisibility(),
+			service: func() *fake.MockServicePlanVisibility { return &fake.MockServicePlanVisibility{} },
+			plans: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Single").Return((*cfresource.ServicePlan)(nil), fake.ErrNoResultReturned)
+				return m
+			},
+			want: want{
+				mg:  servicePlanVisibility(withConditions(xpv1.Creating())),
+				err: errors.Wrap(fake.ErrNoResultReturned, errGetPlan),
+			},
+		},
+		"UpdateError": {
+			mg: servicePlanVisibility(withType("public")),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Update", planGUID, visibility("public")).Return(nil, errBoom)
+				return m
+			},
+			plans: func() *fake.MockServicePlan {
+				m := &fake.MockServicePlan{}
+				m.On("Single").Return(plan(), nil)
+				return m
+			},
+			want: want{
+				mg:  servicePlanVisibility(withType("public"), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc, plans := tc.service(), tc.plans()
+			c := &external{client: svc, plans: plans}
+			_, err := c.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want mg, +got mg:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+			plans.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockServicePlanVisibility
+		err     error
+	}{
+		"AddAndRemoveOrganizations": {
+			mg: servicePlanVisibility(withExternalName(planGUID)),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Get", planGUID).Return(visibility("organization", otherOrgGUID), nil)
+				m.On("Apply", planGUID, visibility("organization", orgGUID)).Return(visibility("organization", orgGUID, otherOrgGUID), nil)
+				m.On("Delete", planGUID, otherOrgGUID).Return(nil)
+				return m
+			},
+		},
+		"ChangeType": {
+			mg: servicePlanVisibility(withExternalName(planGUID), withType("public")),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Get", planGUID).Return(visibility("organization", orgGUID), nil)
+				m.On("Update", planGUID, visibility("public")).Return(visibility("public"), nil)
+				return m
+			},
+		},
+		"UpdateError": {
+			mg: servicePlanVisibility(withExternalName(planGUID), withType("admin")),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Get", planGUID).Return(visibility("public"), nil)
+				m.On("Update", planGUID, visibility("admin")).Return(nil, errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errUpdate),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockServicePlanVisibility
+		err     error
+	}{
+		"RestrictToAdmins": {
+			mg: servicePlanVisibility(withExternalName(planGUID), withObservation("organization", orgGUID)),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Update", planGUID, visibility("admin")).Return(visibility("admin"), nil)
+				return m
+			},
+		},
+		"SpaceScoped": {
+			mg:      servicePlanVisibility(withExternalName(planGUID), withObservation("space")),
+			service: func() *fake.MockServicePlanVisibility { return &fake.MockServicePlanVisibility{} },
+		},
+		"PlanDeleted": {
+			mg: servicePlanVisibility(withExternalName(planGUID)),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Update", planGUID, visibility("admin")).Return(nil, cfresource.NewResourceNotFoundError())
+				return m
+			},
+		},
+		"UpdateError": {
+			mg: servicePlanVisibility(withExternalName(planGUID)),
+			service: func() *fake.MockServicePlanVisibility {
+				m := &fake.MockServicePlanVisibility{}
+				m.On("Update", planGUID, visibility("admin")).Return(nil, errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
@@ -15,12 +15,14 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	apisv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/job"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
 	srb "github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/serviceroutebinding"
@@ -48,16 +50,24 @@ const (
 	errParametersFromCF         = "cannot get parameters from " + resourceType + " in " + externalSystem + ": %w"
 )
 
-// Setup adds a controller that reconciles ServiceRouteBinding CR.
+// Setup adds controllers that reconcile cluster scoped and namespaced ServiceRouteBinding
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.ServiceRouteBinding_GroupKind)
+	if err := setup(mgr, o, v1alpha1.ServiceRouteBinding_GroupVersionKind, &v1alpha1.ServiceRouteBinding{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.ServiceRouteBinding_GroupVersionKind, &nsv1alpha1.ServiceRouteBinding{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
 		managed.WithInitializers(),
-		managed.WithExternalConnector(&connector{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
 			kube:  mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1beta1.ProviderConfigUsage{}),
-		}),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithPollInterval(o.PollInterval),
@@ -68,30 +78,30 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.ServiceRouteBinding_GroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.ServiceRouteBinding{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an external client when its Connect method
 // is called.
 type connector struct {
 	kube  k8s.Client
-	usage resource.LegacyTracker
+	usage resource.Tracker
 }
 
 // Connect establishes a client for ServiceRouteBinding operations.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.ServiceRouteBinding); !ok {
+	if _, ok := mg.(v1alpha1.ServiceRouteBindingManaged); !ok {
 		return nil, errors.New(errWrongCRType)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, fmt.Errorf(errTrackPCUsage, err)
 	}
 
@@ -125,7 +135,7 @@ func (c *external) Disconnect(ctx context.Context) error {
 
 // Observe checks the current external state.
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.ServiceRouteBinding)
+	cr, ok := mg.(v1alpha1.ServiceRouteBindingManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errWrongCRType)
 	}
@@ -152,14 +162,14 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	// detect if their should be parameters / if its a user-provided service instance (Is their a better way to detect this?)
 	paramMap := &runtime.RawExtension{}
-	if cr.Spec.ForProvider.Parameters.Raw != nil {
+	if cr.GetForProvider().Parameters.Raw != nil {
 		paramMap, err = srb.GetParameters(ctx, e.srbClient, guid)
 		if err != nil {
 			return managed.ExternalObservation{}, fmt.Errorf(errExtractParams, err)
 		}
 	}
 
-	srb.UpdateObservation(&cr.Status.AtProvider, servicerouteBinding, paramMap)
+	srb.UpdateObservation(cr.GetAtProvider(), servicerouteBinding, paramMap)
 
 	obs, herr := handleObservationState(servicerouteBinding, cr)
 	if herr != nil {
@@ -170,28 +180,28 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 // Creates the external resource.
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.ServiceRouteBinding)
+	cr, ok := mg.(v1alpha1.ServiceRouteBindingManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errWrongCRType)
 	}
 
-	routeGUID := cr.Spec.ForProvider.Route
-	serviceInstanceGUID := cr.Spec.ForProvider.ServiceInstance
+	routeGUID := cr.GetForProvider().Route
+	serviceInstanceGUID := cr.GetForProvider().ServiceInstance
 	if routeGUID == "" || serviceInstanceGUID == "" {
 		return managed.ExternalCreation{}, fmt.Errorf(errCreate, fmt.Errorf(errMissingRelationshipGUIDs, routeGUID, serviceInstanceGUID))
 	}
 
 	// Get ParametersSecretRef if provided
 	parameterFromSecret := runtime.RawExtension{}
-	if cr.Spec.ForProvider.Parameters.Raw == nil && cr.Spec.ForProvider.ParametersSecretRef != nil {
-		parameters, err := resolveParametersSecret(ctx, e.kube, cr.Spec.ForProvider)
+	if cr.GetForProvider().Parameters.Raw == nil && cr.GetForProvider().ParametersSecretRef != nil {
+		parameters, err := resolveParametersSecret(ctx, e.kube, cr)
 		if err != nil {
 			return managed.ExternalCreation{}, fmt.Errorf(errExtractParams, err)
 		}
 		parameterFromSecret = *parameters
 	}
 
-	binding, err := srb.Create(ctx, e.srbClient, cr, cr.Spec.ForProvider, parameterFromSecret)
+	binding, err := srb.Create(ctx, e.srbClient, cr, *cr.GetForProvider(), parameterFromSecret)
 	if err != nil {
 		return managed.ExternalCreation{}, fmt.Errorf(errCreate, err)
 	} else if binding == nil {
@@ -204,12 +214,13 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 // resolveParameters resolves ParametersSecretRef if set and returns the updated forProvider
-func resolveParametersSecret(ctx context.Context, kube k8s.Client, forProvider v1alpha1.ServiceRouteBindingParameters) (*runtime.RawExtension, error) {
+func resolveParametersSecret(ctx context.Context, kube k8s.Client, cr v1alpha1.ServiceRouteBindingManaged) (*runtime.RawExtension, error) {
+	forProvider := cr.GetForProvider()
 	if forProvider.ParametersSecretRef == nil {
 		return nil, fmt.Errorf("ParametersSecretRef is not set")
 	}
 
-	jsonBytes, err := clients.ExtractSecret(ctx, kube, forProvider.ParametersSecretRef, "")
+	jsonBytes, err := clients.ExtractManagedSecret(ctx, kube, cr, forProvider.ParametersSecretRef, "")
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +234,7 @@ func resolveParametersSecret(ctx context.Context, kube k8s.Client, forProvider v
 
 // Updates the external resource.
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1alpha1.ServiceRouteBinding)
+	cr, ok := mg.(v1alpha1.ServiceRouteBindingManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, fmt.Errorf("managed resource is not a ServiceRouteBinding")
 	}
@@ -235,7 +246,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	// Update metadata (labels and annotations) - only supported fields for ServiceRouteBindings
-	_, err := srb.Update(ctx, e.srbClient, guid, cr, cr.Spec.ForProvider)
+	_, err := srb.Update(ctx, e.srbClient, guid, cr, *cr.GetForProvider())
 	if err != nil {
 		return managed.ExternalUpdate{}, fmt.Errorf(errUpdate, err)
 	}
@@ -245,7 +256,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Deletes the external resource.
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.ServiceRouteBinding)
+	cr, ok := mg.(v1alpha1.ServiceRouteBindingManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errWrongCRType)
 	}
@@ -274,7 +285,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 //
 // Note: Immutable fields (route, serviceInstance, parameters) are protected by CEL validation
 // at the API level. Only metadata (labels/annotations) can be updated.
-func handleObservationState(binding *cfresource.ServiceRouteBinding, cr *v1alpha1.ServiceRouteBinding) (managed.ExternalObservation, error) {
+func handleObservationState(binding *cfresource.ServiceRouteBinding, cr v1alpha1.ServiceRouteBindingManaged) (managed.ExternalObservation, error) {
 	state := binding.LastOperation.State
 	typ := binding.LastOperation.Type
 
@@ -304,7 +315,7 @@ func handleObservationState(binding *cfresource.ServiceRouteBinding, cr *v1alpha
 			actualLabels = binding.Metadata.Labels
 			actualAnnotations = binding.Metadata.Annotations
 		}
-		desired := metadata.BuildMetadata(cr, cr.Spec.ForProvider.Labels, cr.Spec.ForProvider.Annotations)
+		desired := metadata.BuildMetadata(cr, cr.GetForProvider().Labels, cr.GetForProvider().Annotations)
 		upToDate := metadata.IsMetadataUpToDate(desired.Labels, desired.Annotations, actualLabels, actualAnnotations)
 
 		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
@@ -945,7 +945,7 @@ func TestIsNotFoundError(t *testing.T) {
 			want: true,
 		},
 		"CF-ResourceNotFound": {
-			err:  errors.New("CF-ResourceNotFound: The resource could not be found"),
+			err:  cfresource.NewResourceNotFoundError(),
 			want: true,
 		},
 		"OtherError": {
@@ -12,12 +12,16 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	apisv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/isolationsegment"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/org"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/space"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
@@ -35,23 +39,36 @@ const (
 	errUpdate            = "cannot update cloudfoundry Space"
 	errDelete            = "cannot delete cloudfoundry Space"
 	errEnableSSH         = "cannot enable SSH for space"
+	errGetSegment        = "cannot get the isolation segment assigned to the space"
+	errAssignSegment     = "cannot assign the isolation segment to the space"
+	errResolveSegment    = "cannot resolve isolation segment reference by name"
 )
 
-// Setup adds a controller that reconciles Org managed resources.
+// Setup adds controllers that reconcile cluster scoped and namespaced Space
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.Space_GroupKind)
+	if err := setup(mgr, o, v1alpha1.Space_GroupVersionKind, &v1alpha1.Space{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.Space_GroupVersionKind, &nsv1alpha1.Space{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
 
-		managed.WithExternalConnector(&connector{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
 			kube:  mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1beta1.ProviderConfigUsage{}),
-		}),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithInitializers(&orgInitializer{
 			kube: mgr.GetClient(),
+		}, &isolationSegmentInitializer{
+			kube: mgr.GetClient(),
 		}),
 	}
 
@@ -61,21 +78,21 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.Space_GroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.Space{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
 	kube  k8s.Client
-	usage resource.LegacyTracker
+	usage resource.Tracker
 }
 
 // Connect typically produces an ExternalClient by:
@@ -84,11 +101,11 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.Space); !ok {
+	if _, ok := mg.(v1alpha1.SpaceManaged); !ok {
 		return nil, errors.New(errNotSpace)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
@@ -123,7 +140,7 @@ type external struct {
 
 // Observe generates observation for a space
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.Space)
+	cr, ok := mg.(v1alpha1.SpaceManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotSpace)
 	}
@@ -131,7 +148,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	resourceLateInitialized := false
 
 	if meta.GetExternalName(cr) == "" {
-		s, err := space.GetBySpec(ctx, c.client, cr.Spec.ForProvider)
+		s, err := space.GetBySpec(ctx, c.client, *cr.GetForProvider())
 		if err != nil {
 			if clients.ErrorIsNotFound(err) {
 				return managed.ExternalObservation{ResourceExists: false}, nil
@@ -165,26 +182,31 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
 	}
 
-	cr.Status.AtProvider = space.GenerateObservation(s, ssh)
+	segment, err := c.client.GetAssignedIsolationSegment(ctx, guid)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSegment)
+	}
+
+	*cr.GetAtProvider() = space.GenerateObservation(s, ssh, segment)
 	cr.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        space.IsUpToDate(cr, cr.Spec.ForProvider, s, ssh),
+		ResourceUpToDate:        space.IsUpToDate(cr, *cr.GetForProvider(), s, ssh, segment),
 		ResourceLateInitialized: resourceLateInitialized,
 	}, nil
 }
 
 // Create creates a space
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.Space)
+	cr, ok := mg.(v1alpha1.SpaceManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotSpace)
 	}
 
 	cr.SetConditions(xpv1.Creating())
 
-	s, err := c.client.Create(ctx, space.GenerateCreate(cr, cr.Spec.ForProvider))
+	s, err := c.client.Create(ctx, space.GenerateCreate(cr, *cr.GetForProvider()))
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
@@ -196,13 +218,20 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	// enable SSH if allowed
-	if cr.Spec.ForProvider.AllowSSH {
+	if cr.GetForProvider().AllowSSH {
 		err = c.feature.EnableSSH(ctx, s.GUID, true)
 		if err != nil {
 			return managed.ExternalCreation{}, errors.Wrap(err, errEnableSSH)
 		}
 	}
 
+	// assign the isolation segment if set
+	if segment := ptr.Deref(cr.GetForProvider().IsolationSegment, ""); segment != "" {
+		if err := c.client.AssignIsolationSegment(ctx, s.GUID, segment); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errAssignSegment)
+		}
+	}
+
 	return managed.ExternalCreation{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
@@ -212,7 +241,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Update updates a space
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1alpha1.Space)
+	cr, ok := mg.(v1alpha1.SpaceManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotSpace)
 	}
@@ -225,14 +254,22 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	// reconcile SSH
-	if cr.Spec.ForProvider.AllowSSH != cr.Status.AtProvider.AllowSSH {
-		err := c.feature.EnableSSH(ctx, guid, cr.Spec.ForProvider.AllowSSH)
+	if cr.GetForProvider().AllowSSH != cr.GetAtProvider().AllowSSH {
+		err := c.feature.EnableSSH(ctx, guid, cr.GetForProvider().AllowSSH)
 		if err != nil {
 			return managed.ExternalUpdate{}, errors.Wrap(err, errEnableSSH)
 		}
 	}
 
-	_, err := c.client.Update(ctx, guid, space.GenerateUpdate(cr, cr.Spec.ForProvider))
+	// reconcile isolation segment, an empty one unassigns it
+	if !space.IsIsolationSegmentUpToDate(*cr.GetForProvider(), ptr.Deref(cr.GetAtProvider().IsolationSegment, "")) {
+		err := c.client.AssignIsolationSegment(ctx, guid, *cr.GetForProvider().IsolationSegment)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAssignSegment)
+		}
+	}
+
+	_, err := c.client.Update(ctx, guid, space.GenerateUpdate(cr, *cr.GetForProvider()))
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 	}
@@ -242,7 +279,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Delete deletes a space
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.Space)
+	cr, ok := mg.(v1alpha1.SpaceManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotSpace)
 	}
@@ -274,19 +311,46 @@ type orgInitializer initializer
 
 // / Initialize implements the Initializer interface
 func (c *orgInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
-	cr, ok := mg.(*v1alpha1.Space)
+	cr, ok := mg.(v1alpha1.SpaceManaged)
 	if !ok {
 		return errors.New(errNotSpace)
 	}
 
-	if cr.Spec.ForProvider.OrgRef != nil || cr.Spec.ForProvider.OrgSelector != nil {
+	if cr.GetForProvider().OrgRef != nil || cr.GetForProvider().OrgSelector != nil {
 		return cr.ResolveReferences(ctx, c.kube)
 	}
 
 	// If orgName is provided, resolve by orgName
-	if cr.Spec.ForProvider.OrgName != nil {
+	if cr.GetForProvider().OrgName != nil {
 		return org.ResolveByName(ctx, clients.ClientFnBuilder(ctx, c.kube), mg)
 	}
 
 	return nil
 }
+
+type isolationSegmentInitializer initializer
+
+// Initialize resolves the isolation segment by isolationSegmentName, if set.
+func (c *isolationSegmentInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(v1alpha1.SpaceManaged)
+	if !ok {
+		return errors.New(errNotSpace)
+	}
+
+	name := cr.GetForProvider().IsolationSegmentName
+	if name == nil || cr.GetForProvider().IsolationSegmentRef != nil || cr.GetForProvider().IsolationSegmentSelector != nil {
+		return nil
+	}
+
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
+	if err != nil {
+		return errors.Wrap(err, errNewClient)
+	}
+
+	segmentGUID, err := isolationsegment.GetGUID(ctx, isolationsegment.NewClient(cf), *name)
+	if err != nil {
+		return errors.Wrap(err, errResolveSegment)
+	}
+	cr.GetForProvider().IsolationSegment = segmentGUID
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
 )
@@ -27,6 +28,7 @@ var (
 	name        = "my-space"
 	guid        = "2d8b0d04-d537-4e4e-8c6f-f09ca0e7f56f"
 	orgGuid     = "3d8b0d04-d537-4e4e-8c6f-f09ca0e7f56f"
+	segmentGuid = "4d8b0d04-d537-4e4e-8c6f-f09ca0e7f56f"
 	invalidGuid = "not-a-valid-guid"
 )
 
@@ -56,6 +58,18 @@ func withOrg(org string) modifier {
 	}
 }
 
+func withIsolationSegment(segment string) modifier {
+	return func(r *v1alpha1.Space) {
+		r.Spec.ForProvider.IsolationSegment = &segment
+	}
+}
+
+func withObservedIsolationSegment(segment string) modifier {
+	return func(r *v1alpha1.Space) {
+		r.Status.AtProvider.IsolationSegment = &segment
+	}
+}
+
 func withConditions(c ...xpv1.Condition) modifier {
 	return func(i *v1alpha1.Space) { i.Status.SetConditions(c...) }
 }
@@ -78,6 +92,19 @@ func fakeSpace(m ...modifier) *v1alpha1.Space {
 	return r
 }
 
+// fakeNamespacedSpace returns the namespaced counterpart of fakeSpace.
+func fakeNamespacedSpace(m ...modifier) *nsv1alpha1.Space {
+	s := fakeSpace(m...)
+	r := &nsv1alpha1.Space{
+		ObjectMeta: s.ObjectMeta,
+		Spec:       nsv1alpha1.SpaceSpec{ForProvider: s.Spec.ForProvider},
+		Status:     s.Status,
+	}
+	r.Namespace = "team-a"
+	r.SetGroupVersionKind(nsv1alpha1.Space_GroupVersionKind)
+	return r
+}
+
 type MockSpaceFeature struct {
 	*fake.MockSpace
 	*fake.MockFeature
@@ -96,7 +123,7 @@ func TestObserve(t *testing.T) {
 	}
 
 	type want struct {
-		mg  *v1alpha1.Space
+		mg  resource.Managed
 		obs managed.ExternalObservation
 		err error
 	}
@@ -210,6 +237,34 @@ func TestObserve(t *testing.T) {
 					false,
 					nil,
 				)
+				m.On("GetAssignedIsolationSegment", guid).Return("", nil)
+
+				return &MockSpaceFeature{m, f}
+			},
+		},
+		"NamespacedSetExternalNameSuccessful": {
+			args: args{
+				mg: fakeNamespacedSpace(withName(name), withOrg(orgGuid), withExternalName(guid)),
+			},
+			want: want{
+				mg:  fakeNamespacedSpace(withName(name), withOrg(orgGuid), withAllowSSH(false), withExternalName(guid), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: false},
+				err: nil,
+			},
+			service: func() *MockSpaceFeature {
+				m := &fake.MockSpace{}
+				f := &fake.MockFeature{}
+
+				m.On("Get", guid).Return(
+					&fake.NewSpace().SetName(name).SetGUID(guid).SetRelationships(orgGuid).SetLabels(map[string]*string{"crossplane-kind": ptr.To("space.m.cloudfoundry.crossplane.io"), "crossplane-name": ptr.To("my-space"), "crossplane-namespace": ptr.To("team-a")}).Space,
+					nil,
+				)
+
+				f.On("IsSSHEnabled").Return(
+					false,
+					nil,
+				)
+				m.On("GetAssignedIsolationSegment", guid).Return("", nil)
 
 				return &MockSpaceFeature{m, f}
 			},
@@ -259,6 +314,57 @@ func TestObserve(t *testing.T) {
 					false,
 					nil,
 				)
+				m.On("GetAssignedIsolationSegment", guid).Return("", nil)
+				return &MockSpaceFeature{m, f}
+			},
+		},
+		"IsolationSegmentDrift": {
+			args: args{
+				mg: fakeSpace(withName(name), withOrg(orgGuid), withExternalName(guid), withIsolationSegment(segmentGuid), withDefaultMetadataLabels()),
+			},
+			want: want{
+				mg:  fakeSpace(withName(name), withOrg(orgGuid), withExternalName(guid), withIsolationSegment(segmentGuid), withConditions(xpv1.Available()), withDefaultMetadataLabels()),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: false},
+				err: nil,
+			},
+			service: func() *MockSpaceFeature {
+				m := &fake.MockSpace{}
+				f := &fake.MockFeature{}
+
+				m.On("Get", guid).Return(
+					&fake.NewSpace().SetName(name).SetGUID(guid).SetRelationships(orgGuid).SetLabels(map[string]*string{"crossplane-kind": ptr.To("space.cloudfoundry.crossplane.io"), "crossplane-name": ptr.To("my-space")}).Space,
+					nil,
+				)
+				f.On("IsSSHEnabled").Return(
+					false,
+					nil,
+				)
+				m.On("GetAssignedIsolationSegment", guid).Return("", nil)
+				return &MockSpaceFeature{m, f}
+			},
+		},
+		"GetIsolationSegmentError": {
+			args: args{
+				mg: fakeSpace(withName(name), withOrg(orgGuid), withExternalName(guid)),
+			},
+			want: want{
+				mg:  fakeSpace(withName(name), withOrg(orgGuid), withExternalName(guid)),
+				obs: managed.ExternalObservation{},
+				err: errors.Wrap(errBoom, errGetSegment),
+			},
+			service: func() *MockSpaceFeature {
+				m := &fake.MockSpace{}
+				f := &fake.MockFeature{}
+
+				m.On("Get", guid).Return(
+					&fake.NewSpace().SetName(name).SetGUID(guid).SetRelationships(orgGuid).Space,
+					nil,
+				)
+				f.On("IsSSHEnabled").Return(
+					false,
+					nil,
+				)
+				m.On("GetAssignedIsolationSegment", guid).Return("", errBoom)
 				return &MockSpaceFeature{m, f}
 			},
 		},
@@ -289,6 +395,7 @@ func TestObserve(t *testing.T) {
 					false,
 					nil,
 				)
+				m.On("GetAssignedIsolationSegment", guid).Return("", nil)
 
 				return &MockSpaceFeature{m, f}
 			},
@@ -322,7 +429,7 @@ func TestObserve(t *testing.T) {
 				t.Errorf("Observe(...): -want, +got:\n%s", diff)
 			}
 			if tc.args.mg != nil && tc.want.mg != nil {
-				if diff := cmp.Diff(tc.want.mg, tc.args.mg, cmp.Options{cmpopts.IgnoreFields(v1alpha1.Space{}, "Status.AtProvider")}); diff != "" {
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, cmp.Options{cmpopts.IgnoreFields(v1alpha1.Space{}, "Status.AtProvider"), cmpopts.IgnoreFields(nsv1alpha1.Space{}, "Status.AtProvider")}); diff != "" {
 					t.Errorf("Observe(...): -want, +got:\n%s", diff)
 				}
 			}
@@ -392,6 +499,46 @@ func TestCreate(t *testing.T) {
 				return &MockSpaceFeature{m, f}
 			},
 		},
+		"SuccessfulWithIsolationSegment": {
+			args: args{
+				mg: fakeSpace(withIsolationSegment(segmentGuid)),
+			},
+			want: want{
+				mg:  fakeSpace(withIsolationSegment(segmentGuid), withExternalName(guid), withConditions(xpv1.Creating())),
+				obs: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}},
+				err: nil,
+			},
+			service: func() *MockSpaceFeature {
+				m := &fake.MockSpace{}
+				f := &fake.MockFeature{}
+				m.On("Create").Return(
+					&fake.NewSpace().SetName(name).SetGUID(guid).Space,
+					nil,
+				)
+				m.On("AssignIsolationSegment", guid, segmentGuid).Return(nil)
+				return &MockSpaceFeature{m, f}
+			},
+		},
+		"AssignIsolationSegmentError": {
+			args: args{
+				mg: fakeSpace(withIsolationSegment(segmentGuid)),
+			},
+			want: want{
+				mg:  fakeSpace(withIsolationSegment(segmentGuid), withExternalName(guid), withConditions(xpv1.Creating())),
+				obs: managed.ExternalCreation{},
+				err: errors.Wrap(errBoom, errAssignSegment),
+			},
+			service: func() *MockSpaceFeature {
+				m := &fake.MockSpace{}
+				f := &fake.MockFeature{}
+				m.On("Create").Return(
+					&fake.NewSpace().SetName(name).SetGUID(guid).Space,
+					nil,
+				)
+				m.On("AssignIsolationSegment", guid, segmentGuid).Return(errBoom)
+				return &MockSpaceFeature{m, f}
+			},
+		},
 		"AlreadyExistWithExternalName": {
 			args: args{
 				mg: fakeSpace(withExternalName(guid)),
@@ -535,6 +682,46 @@ func TestUpdate(t *testing.T) {
 				return &MockSpaceFeature{m, f}
 			},
 		},
+		"AssignIsolationSegment": {
+			args: args{
+				mg: fakeSpace(withExternalName(guid), withName(name), withIsolationSegment(segmentGuid)),
+			},
+			want: want{
+				mg:  fakeSpace(withExternalName(guid), withName(name), withIsolationSegment(segmentGuid)),
+				obs: managed.ExternalUpdate{},
+				err: nil,
+			},
+			service: func() *MockSpaceFeature {
+				m := &fake.MockSpace{}
+				f := &fake.MockFeature{}
+				m.On("AssignIsolationSegment", guid, segmentGuid).Return(nil)
+				m.On("Update").Return(
+					&fake.NewSpace().SetName(name).SetGUID(guid).Space,
+					nil,
+				)
+				return &MockSpaceFeature{m, f}
+			},
+		},
+		"UnassignIsolationSegment": {
+			args: args{
+				mg: fakeSpace(withExternalName(guid), withName(name), withIsolationSegment(""), withObservedIsolationSegment(segmentGuid)),
+			},
+			want: want{
+				mg:  fakeSpace(withExternalName(guid), withName(name), withIsolationSegment(""), withObservedIsolationSegment(segmentGuid)),
+				obs: managed.ExternalUpdate{},
+				err: nil,
+			},
+			service: func() *MockSpaceFeature {
+				m := &fake.MockSpace{}
+				f := &fake.MockFeature{}
+				m.On("AssignIsolationSegment", guid, "").Return(nil)
+				m.On("Update").Return(
+					&fake.NewSpace().SetName(name).SetGUID(guid).Space,
+					nil,
+				)
+				return &MockSpaceFeature{m, f}
+			},
+		},
 		"InvalidGUID": {
 			args: args{
 				mg: fakeSpace(withExternalName(invalidGuid)),
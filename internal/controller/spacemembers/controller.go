@@ -5,9 +5,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/cloudfoundry/go-cfclient/v3/config"
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/pkg/errors"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -19,9 +20,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	apisv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/members"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
 )
@@ -101,15 +103,15 @@ func hasCompoundExternalName(externalName, resourceName string) bool {
 	return externalName != "" && externalName != resourceName && !isOldExternalNameFormat(externalName)
 }
 
-func validateIdentityConflict(cr *v1alpha1.SpaceMembers, spaceGUID, roleType string) error {
+func validateIdentityConflict(cr v1alpha1.SpaceMembersManaged, spaceGUID, roleType string) error {
 	if !hasCompoundExternalName(meta.GetExternalName(cr), cr.GetName()) {
 		return nil
 	}
-	if cr.Spec.ForProvider.Space != nil && *cr.Spec.ForProvider.Space != spaceGUID {
-		return errors.Errorf("identity conflict: external-name space (%s) differs from spec (%s)", spaceGUID, *cr.Spec.ForProvider.Space)
+	if cr.GetForProvider().Space != nil && *cr.GetForProvider().Space != spaceGUID {
+		return errors.Errorf("identity conflict: external-name space (%s) differs from spec (%s)", spaceGUID, *cr.GetForProvider().Space)
 	}
-	if cr.Spec.ForProvider.RoleType != "" && canonicalizeRoleType(cr.Spec.ForProvider.RoleType) != roleType {
-		return errors.Errorf("identity conflict: external-name role type (%s) differs from spec (%s)", roleType, cr.Spec.ForProvider.RoleType)
+	if cr.GetForProvider().RoleType != "" && canonicalizeRoleType(cr.GetForProvider().RoleType) != roleType {
+		return errors.Errorf("identity conflict: external-name role type (%s) differs from spec (%s)", roleType, cr.GetForProvider().RoleType)
 	}
 	return nil
 }
@@ -137,20 +139,28 @@ func buildObservation(lateInitialized, exists bool, observed *v1alpha1.RoleAssig
 
 // isLaxDeletionComplete returns true when the resource is being deleted under Lax
 // enforcement and all tracked roles have already been removed.
-func isLaxDeletionComplete(cr *v1alpha1.SpaceMembers) bool {
-	return meta.WasDeleted(cr) && cr.Spec.ForProvider.EnforcementPolicy != "Strict" && len(cr.Status.AtProvider.AssignedRoles) == 0
+func isLaxDeletionComplete(cr v1alpha1.SpaceMembersManaged) bool {
+	return meta.WasDeleted(cr) && cr.GetForProvider().EnforcementPolicy != "Strict" && len(cr.GetAtProvider().AssignedRoles) == 0
 }
 
-// Setup adds a controller that reconciles managed resources SpaceMembers.
+// Setup adds controllers that reconcile cluster scoped and namespaced SpaceMembers
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.SpaceMembersGroupKind)
+	if err := setup(mgr, o, v1alpha1.SpaceMembersGroupVersionKind, &v1alpha1.SpaceMembers{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.SpaceMembersGroupVersionKind, &nsv1alpha1.SpaceMembers{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
 
-		managed.WithExternalConnector(&connector{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
 			kube:        mgr.GetClient(),
-			usage:       resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1beta1.ProviderConfigUsage{}),
-			newClientFn: members.NewClient}),
+			usage:       clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+			newClientFn: members.NewClient})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithPollInterval(o.PollInterval),
@@ -162,22 +172,22 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.SpaceMembersGroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.SpaceMembers{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
 	kube        k8s.Client
-	usage       resource.LegacyTracker
-	newClientFn func(*config.Config) (*members.Client, error)
+	usage       resource.Tracker
+	newClientFn func(*cfv3.Client) *members.Client
 }
 
 // Connect typically produces an ExternalClient by:
@@ -186,21 +196,16 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.SpaceMembers); !ok {
+	if _, ok := mg.(v1alpha1.SpaceMembersManaged); !ok {
 		return nil, errors.New(errWrongKind)
 	}
 
-	config, err := clients.GetCredentialConfig(ctx, c.kube, mg)
-	if err != nil {
-		return nil, errors.Wrap(err, errGetProviderConfig)
-	}
-
-	client, err := c.newClientFn(config)
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetClient)
 	}
 
-	return &external{client: client}, nil
+	return &external{client: c.newClientFn(cf)}, nil
 }
 
 // Disconnect implements the managed.ExternalClient interface
@@ -210,10 +215,10 @@ func (c *external) Disconnect(ctx context.Context) error {
 }
 
 type spaceMemberClient interface {
-	ObserveSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) (*v1alpha1.RoleAssignments, bool, error)
-	AssignSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) (*v1alpha1.RoleAssignments, error)
-	UpdateSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) (*v1alpha1.RoleAssignments, error)
-	DeleteSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) error
+	ObserveSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) (*v1alpha1.RoleAssignments, bool, error)
+	AssignSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) (*v1alpha1.RoleAssignments, error)
+	UpdateSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) (*v1alpha1.RoleAssignments, error)
+	DeleteSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) error
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -222,23 +227,23 @@ type external struct {
 	client spaceMemberClient
 }
 
-func resolveIdentity(cr *v1alpha1.SpaceMembers) (spaceGUID, roleType string, lateInitialized bool, err error) {
+func resolveIdentity(cr v1alpha1.SpaceMembersManaged) (spaceGUID, roleType string, lateInitialized bool, err error) {
 	externalName := meta.GetExternalName(cr)
 
 	switch {
 	case externalName == "" || externalName == cr.GetName():
-		if cr.Spec.ForProvider.Space == nil {
+		if cr.GetForProvider().Space == nil {
 			return "", "", false, errors.New(errSpaceNotResolved)
 		}
-		if !isValidSpaceRoleType(cr.Spec.ForProvider.RoleType) {
+		if !isValidSpaceRoleType(cr.GetForProvider().RoleType) {
 			return "", "", false, errors.New(errRoleTypeRequired)
 		}
-		return *cr.Spec.ForProvider.Space, canonicalizeRoleType(cr.Spec.ForProvider.RoleType), true, nil
+		return *cr.GetForProvider().Space, canonicalizeRoleType(cr.GetForProvider().RoleType), true, nil
 	case isOldExternalNameFormat(externalName):
-		if !isValidSpaceRoleType(cr.Spec.ForProvider.RoleType) {
+		if !isValidSpaceRoleType(cr.GetForProvider().RoleType) {
 			return "", "", false, errors.New(errRoleTypeRequired)
 		}
-		return externalName, canonicalizeRoleType(cr.Spec.ForProvider.RoleType), true, nil
+		return externalName, canonicalizeRoleType(cr.GetForProvider().RoleType), true, nil
 	default:
 		spaceGUID, roleType, err := parseExternalName(externalName)
 		if err != nil {
@@ -252,7 +257,7 @@ func resolveIdentity(cr *v1alpha1.SpaceMembers) (spaceGUID, roleType string, lat
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.SpaceMembers)
+	cr, ok := mg.(v1alpha1.SpaceMembersManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errWrongKind)
 	}
@@ -277,7 +282,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	if observed != nil && !meta.WasDeleted(cr) {
-		cr.Status.AtProvider.AssignedRoles = observed.AssignedRoles
+		cr.GetAtProvider().AssignedRoles = observed.AssignedRoles
 		cr.SetConditions(xpv1.Available())
 	}
 
@@ -292,7 +297,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.SpaceMembers)
+	cr, ok := mg.(v1alpha1.SpaceMembersManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errWrongKind)
 	}
@@ -313,7 +318,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	meta.SetExternalName(cr, composeExternalName(spaceGUID, roleType))
 
 	// Collection resource — no single CF GUID, so set status directly.
-	cr.Status.AtProvider.AssignedRoles = created.AssignedRoles
+	cr.GetAtProvider().AssignedRoles = created.AssignedRoles
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -321,7 +326,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1alpha1.SpaceMembers)
+	cr, ok := mg.(v1alpha1.SpaceMembersManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errWrongKind)
 	}
@@ -336,7 +341,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 	}
 
-	cr.Status.AtProvider.AssignedRoles = updated.AssignedRoles
+	cr.GetAtProvider().AssignedRoles = updated.AssignedRoles
 
 	return managed.ExternalUpdate{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -344,7 +349,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.SpaceMembers)
+	cr, ok := mg.(v1alpha1.SpaceMembersManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errWrongKind)
 	}
@@ -353,7 +358,7 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	// Lax resources only remove the roles tracked in status. If nothing is tracked,
 	// deletion is already complete and we should not block on resolving identity.
-	if cr.Spec.ForProvider.EnforcementPolicy != "Strict" && len(cr.Status.AtProvider.AssignedRoles) == 0 {
+	if cr.GetForProvider().EnforcementPolicy != "Strict" && len(cr.GetAtProvider().AssignedRoles) == 0 {
 		return managed.ExternalDelete{}, nil
 	}
 
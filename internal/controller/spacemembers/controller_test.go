@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/google/go-cmp/cmp"
@@ -87,32 +88,32 @@ type mockMembersClient struct {
 	deleteFn  func(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) error
 }
 
-func (m *mockMembersClient) ObserveSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) (*v1alpha1.RoleAssignments, bool, error) {
+func (m *mockMembersClient) ObserveSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) (*v1alpha1.RoleAssignments, bool, error) {
 	if m.observeFn == nil {
 		return nil, false, nil
 	}
-	return m.observeFn(ctx, spaceGUID, roleType, cr)
+	return m.observeFn(ctx, spaceGUID, roleType, cr.(*v1alpha1.SpaceMembers))
 }
 
-func (m *mockMembersClient) AssignSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) (*v1alpha1.RoleAssignments, error) {
+func (m *mockMembersClient) AssignSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) (*v1alpha1.RoleAssignments, error) {
 	if m.assignFn == nil {
 		return nil, nil
 	}
-	return m.assignFn(ctx, spaceGUID, roleType, cr)
+	return m.assignFn(ctx, spaceGUID, roleType, cr.(*v1alpha1.SpaceMembers))
 }
 
-func (m *mockMembersClient) UpdateSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) (*v1alpha1.RoleAssignments, error) {
+func (m *mockMembersClient) UpdateSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) (*v1alpha1.RoleAssignments, error) {
 	if m.updateFn == nil {
 		return nil, nil
 	}
-	return m.updateFn(ctx, spaceGUID, roleType, cr)
+	return m.updateFn(ctx, spaceGUID, roleType, cr.(*v1alpha1.SpaceMembers))
 }
 
-func (m *mockMembersClient) DeleteSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr *v1alpha1.SpaceMembers) error {
+func (m *mockMembersClient) DeleteSpaceMembers(ctx context.Context, spaceGUID, roleType string, cr v1alpha1.SpaceMembersManaged) error {
 	if m.deleteFn == nil {
 		return nil
 	}
-	return m.deleteFn(ctx, spaceGUID, roleType, cr)
+	return m.deleteFn(ctx, spaceGUID, roleType, cr.(*v1alpha1.SpaceMembers))
 }
 
 func TestObserve(t *testing.T) {
@@ -480,7 +481,7 @@ func TestDelete(t *testing.T) {
 			want: want{err: nil},
 			mock: mockMembersClient{
 				deleteFn: func(ctx context.Context, _, _ string, cr *v1alpha1.SpaceMembers) error {
-					return fmt.Errorf("NotFound: resource not found")
+					return cfresource.NewResourceNotFoundError()
 				},
 			},
 		},
@@ -14,6 +14,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8s "sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,10 +22,11 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
 	resources "github.com/SAP/crossplane-provider-cloudfoundry/apis/resources"
 	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
-	apisv1beta1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/v1beta1"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/spacequota"
 	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
 )
@@ -43,16 +45,24 @@ const (
 	errExternalName      = "external-name annotation is not set"
 )
 
-// Setup adds a controller that reconciles space quota managed resources.
+// Setup adds controllers that reconcile cluster scoped and namespaced SpaceQuota
+// managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	name := managed.ControllerName(v1alpha1.SpaceQuota_GroupKind)
+	if err := setup(mgr, o, v1alpha1.SpaceQuota_GroupVersionKind, &v1alpha1.SpaceQuota{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.SpaceQuota_GroupVersionKind, &nsv1alpha1.SpaceQuota{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
 
 	options := []managed.ReconcilerOption{
 
-		managed.WithExternalConnector(&connector{
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
 			kube:  mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1beta1.ProviderConfigUsage{}),
-		}),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithPollInterval(o.PollInterval),
@@ -67,62 +77,62 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.SpaceQuota_GroupVersionKind),
+		resource.ManagedKind(gvk),
 		options...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		For(&v1alpha1.SpaceQuota{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its
 // Connect method is called.
 type connector struct {
 	kube  k8s.Client
-	usage resource.LegacyTracker
+	usage resource.Tracker
 }
 
 // ResolveReferences resolves the references in the managed resources
 // using the crossplane reference resolution algorithm.
-func ResolveReferences(ctx context.Context, mg *v1alpha1.SpaceQuota, client k8s.Reader) error {
+func ResolveReferences(ctx context.Context, mg v1alpha1.SpaceQuotaManaged, client k8s.Reader) error {
 	r := reference.NewAPIResolver(client, mg)
 
 	var rsp reference.ResolutionResponse
 	var err error
 
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
-		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Org),
+		CurrentValue: reference.FromPtrValue(mg.GetForProvider().Org),
 		Extract:      resources.ExternalID(),
-		Reference:    mg.Spec.ForProvider.OrgRef,
-		Selector:     mg.Spec.ForProvider.OrgSelector,
+		Reference:    mg.GetForProvider().OrgRef,
+		Selector:     mg.GetForProvider().OrgSelector,
 		To: reference.To{
 			List:    &v1alpha1.OrganizationList{},
 			Managed: &v1alpha1.Organization{},
 		},
 	})
 	if err != nil {
-		return errors.Wrap(err, "mg.Spec.ForProvider.Organization")
+		return errors.Wrap(err, "mg.GetForProvider().Organization")
 	}
-	mg.Spec.ForProvider.Org = reference.ToPtrValue(rsp.ResolvedValue)
-	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+	mg.GetForProvider().Org = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.GetForProvider().OrgRef = rsp.ResolvedReference
 
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
-		CurrentValue: reference.FromPtrValue(mg.Spec.InitProvider.Org),
+		CurrentValue: reference.FromPtrValue(mg.GetInitProvider().Org),
 		Extract:      resources.ExternalID(),
-		Reference:    mg.Spec.InitProvider.OrgRef,
-		Selector:     mg.Spec.InitProvider.OrgSelector,
+		Reference:    mg.GetInitProvider().OrgRef,
+		Selector:     mg.GetInitProvider().OrgSelector,
 		To: reference.To{
 			List:    &v1alpha1.OrganizationList{},
 			Managed: &v1alpha1.Organization{},
 		},
 	})
 	if err != nil {
-		return errors.Wrap(err, "mg.Spec.InitProvider.Organization")
+		return errors.Wrap(err, "mg.GetInitProvider().Organization")
 	}
-	mg.Spec.InitProvider.Org = reference.ToPtrValue(rsp.ResolvedValue)
-	mg.Spec.InitProvider.OrgRef = rsp.ResolvedReference
+	mg.GetInitProvider().Org = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.GetInitProvider().OrgRef = rsp.ResolvedReference
 
 	return nil
 }
@@ -135,7 +145,7 @@ type initializer struct {
 // Initialize method resolves the references which are not resolved by
 // the crossplane reconciler.
 func (i initializer) Initialize(ctx context.Context, mg resource.Managed) error {
-	cr, ok := mg.(*v1alpha1.SpaceQuota)
+	cr, ok := mg.(v1alpha1.SpaceQuotaManaged)
 	if !ok {
 		return errors.New(errUnexpectedObject)
 	}
@@ -148,9 +158,9 @@ func (i initializer) Initialize(ctx context.Context, mg resource.Managed) error
 //
 //nolint:gocyclo
 func isUpToDate(ctx context.Context,
-	cr *v1alpha1.SpaceQuota,
+	cr v1alpha1.SpaceQuotaManaged,
 	resp *cfresource.SpaceQuota) (bool, error) {
-	spec := &cr.Spec.ForProvider
+	spec := cr.GetForProvider()
 	if v := spec.AllowPaidServicePlans; v != nil {
 		if *v != resp.Services.PaidServicesAllowed {
 			return false, nil
@@ -243,11 +253,11 @@ func isUpToDate(ctx context.Context,
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	if _, ok := mg.(*v1alpha1.SpaceQuota); !ok {
+	if _, ok := mg.(v1alpha1.SpaceQuotaManaged); !ok {
 		return nil, errors.New(errUnexpectedObject)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
@@ -271,13 +281,13 @@ type external struct {
 	kube       k8s.Client
 	client     spacequota.SpaceQuotaClient
 	isUpToDate func(context.Context,
-		*v1alpha1.SpaceQuota,
+		v1alpha1.SpaceQuotaManaged,
 		*cfresource.SpaceQuota) (bool, error)
 }
 
 // Observe generates observation for a space
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1alpha1.SpaceQuota)
+	cr, ok := mg.(v1alpha1.SpaceQuotaManaged)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
@@ -300,8 +310,8 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGet)
 	}
 
-	currentSpec := cr.Spec.ForProvider.DeepCopy()
-	GenerateSpaceQuota(resp).Status.AtProvider.DeepCopyInto(&cr.Status.AtProvider)
+	currentSpec := cr.GetForProvider().DeepCopy()
+	GenerateSpaceQuota(resp).Status.AtProvider.DeepCopyInto(cr.GetAtProvider())
 	cr.SetConditions(xpv1.Available())
 
 	upToDate := true
@@ -315,13 +325,13 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	return managed.ExternalObservation{
 		ResourceExists:          true,
 		ResourceUpToDate:        upToDate,
-		ResourceLateInitialized: !cmp.Equal(&cr.Spec.ForProvider, currentSpec),
+		ResourceLateInitialized: !cmp.Equal(cr.GetForProvider(), currentSpec),
 	}, nil
 }
 
 // Create creates a space quota
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1alpha1.SpaceQuota)
+	cr, ok := mg.(v1alpha1.SpaceQuotaManaged)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
@@ -335,14 +345,14 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	meta.SetExternalName(cr, resp.GUID)
 
-	GenerateSpaceQuota(resp).Status.AtProvider.DeepCopyInto(&cr.Status.AtProvider)
+	GenerateSpaceQuota(resp).Status.AtProvider.DeepCopyInto(cr.GetAtProvider())
 
 	return managed.ExternalCreation{}, nil
 }
 
 // Update updates a space quota
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1alpha1.SpaceQuota)
+	cr, ok := mg.(v1alpha1.SpaceQuotaManaged)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
@@ -373,13 +383,13 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			}
 		}
 	}
-	GenerateSpaceQuota(resp).Status.AtProvider.DeepCopyInto(&cr.Status.AtProvider)
+	GenerateSpaceQuota(resp).Status.AtProvider.DeepCopyInto(cr.GetAtProvider())
 	return managed.ExternalUpdate{}, nil
 }
 
 // Delete deletes a space quota
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1alpha1.SpaceQuota)
+	cr, ok := mg.(v1alpha1.SpaceQuotaManaged)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
 	}
@@ -392,8 +402,8 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errDelete + ": " + errExternalName)
 	}
 
-	for i := range cr.Status.AtProvider.Spaces {
-		err := c.client.Remove(ctx, guid, *cr.Status.AtProvider.Spaces[i])
+	for i := range cr.GetAtProvider().Spaces {
+		err := c.client.Remove(ctx, guid, *cr.GetAtProvider().Spaces[i])
 		if err != nil {
 			return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
 		}
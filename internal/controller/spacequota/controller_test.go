@@ -298,7 +298,7 @@ func TestObserve(t *testing.T) {
 		c := &external{
 			kube:   &test.MockClient{},
 			client: tc.cfClient,
-			isUpToDate: func(context.Context, *v1alpha1.SpaceQuota, *cfresource.SpaceQuota) (bool, error) {
+			isUpToDate: func(context.Context, v1alpha1.SpaceQuotaManaged, *cfresource.SpaceQuota) (bool, error) {
 				return true, nil
 			},
 		}
@@ -610,7 +610,7 @@ func TestDelete(t *testing.T) {
 				m := &fake.MockSpaceQuota{}
 				m.On("Delete").Return(
 					"",
-					errors.New("CF-ResourceNotFound: The space quota was not found"),
+					cfresource.NewResourceNotFoundError(),
 				)
 				return m
 			}(),
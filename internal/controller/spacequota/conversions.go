@@ -72,11 +72,11 @@ func GenerateSpaceQuota(resp *resource.SpaceQuota) *v1alpha1.SpaceQuota {
 // GenerateCreateSpaceQuota returns a create input.
 //
 //nolint:gocyclo
-func GenerateCreateSpaceQuota(cr *v1alpha1.SpaceQuota) *resource.SpaceQuotaCreateOrUpdate {
+func GenerateCreateSpaceQuota(cr v1alpha1.SpaceQuotaManaged) *resource.SpaceQuotaCreateOrUpdate {
 	if cr == nil {
 		return nil
 	}
-	spec := &cr.Spec.ForProvider
+	spec := cr.GetForProvider()
 	if spec.Name == nil || spec.Org == nil {
 		return nil
 	}
@@ -131,11 +131,11 @@ func GenerateCreateSpaceQuota(cr *v1alpha1.SpaceQuota) *resource.SpaceQuotaCreat
 // GenerateUpdateSpaceQuota returns a create input.
 //
 //nolint:gocyclo
-func GenerateUpdateSpaceQuota(cr *v1alpha1.SpaceQuota) *resource.SpaceQuotaCreateOrUpdate {
+func GenerateUpdateSpaceQuota(cr v1alpha1.SpaceQuotaManaged) *resource.SpaceQuotaCreateOrUpdate {
 	if cr == nil {
 		return nil
 	}
-	spec := &cr.Spec.ForProvider
+	spec := cr.GetForProvider()
 	if spec.Name == nil {
 		return nil
 	}
@@ -252,6 +252,6 @@ func getSpaceStatusHelper(specSpaces, statusSpaces []*string) spaceStatuses {
 
 // getSpaceStatus function returns the spaceStatuses type for a given
 // SpaceQuote managed resource.
-func getSpaceStatus(cr *v1alpha1.SpaceQuota) spaceStatuses {
-	return getSpaceStatusHelper(cr.Spec.ForProvider.Spaces, cr.Status.AtProvider.Spaces)
+func getSpaceStatus(cr v1alpha1.SpaceQuotaManaged) spaceStatuses {
+	return getSpaceStatusHelper(cr.GetForProvider().Spaces, cr.GetAtProvider().Spaces)
 }
@@ -83,6 +83,12 @@ func withOrigin(origin string) modifier {
 	}
 }
 
+func withUserRef(name string) modifier {
+	return func(r *v1alpha1.SpaceRole) {
+		r.Spec.ForProvider.UserRef = &xpv1.Reference{Name: name}
+	}
+}
+
 func withExternalName(name string) modifier {
 	return func(r *v1alpha1.SpaceRole) {
 		r.Annotations[meta.AnnotationKeyExternalName] = name
@@ -268,7 +274,7 @@ func TestObserve(t *testing.T) {
 				m := &fake.MockSpaceRole{}
 				m.On("Get", guidRole).Return(
 					fake.SpaceRoleNil,
-					errors.New("CF-ResourceNotFound: The role was not found"),
+					cfresource.NewResourceNotFoundError(),
 				)
 				return m
 			},
@@ -562,7 +568,7 @@ func TestDelete(t *testing.T) {
 				m := &fake.MockSpaceRole{}
 				m.On("Delete").Return(
 					"",
-					errors.New("CF-ResourceNotFound: The role was not found"),
+					cfresource.NewResourceNotFoundError(),
 				)
 				return m
 			},
@@ -621,3 +627,56 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestOriginInitializer(t *testing.T) {
+	getUser := func(_ context.Context, _ k8s.ObjectKey, obj k8s.Object) error {
+		obj.(*v1alpha1.User).Status.AtProvider.Origin = ptr.To("uaa")
+		return nil
+	}
+
+	type want struct {
+		cr  *v1alpha1.SpaceRole
+		err error
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.SpaceRole
+		kube k8s.Client
+		want want
+	}{
+		"NoUserReference": {
+			cr:   fakeSpaceRole(withUsername("user1")),
+			want: want{cr: fakeSpaceRole(withUsername("user1"))},
+		},
+		"UserRef": {
+			cr:   fakeSpaceRole(withUserRef("user1")),
+			kube: &test.MockClient{MockGet: getUser},
+			want: want{cr: fakeSpaceRole(withUserRef("user1"), withOrigin("uaa"))},
+		},
+		"OriginAlreadySet": {
+			cr:   fakeSpaceRole(withUserRef("user1"), withOrigin("sap.ids")),
+			want: want{cr: fakeSpaceRole(withUserRef("user1"), withOrigin("sap.ids"))},
+		},
+		"UserRefNotFound": {
+			cr:   fakeSpaceRole(withUserRef("user1")),
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{
+				cr:  fakeSpaceRole(withUserRef("user1")),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get referenced resource"), "mg.GetForProvider().Origin"),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			c := &originInitializer{kube: tc.kube}
+			err := c.Initialize(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("Initialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
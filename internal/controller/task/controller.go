@@ -0,0 +1,250 @@
+package task
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	nsv1alpha1 "github.com/SAP/crossplane-provider-cloudfoundry/apis/namespaced/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/cferrors"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/task"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/features"
+)
+
+const (
+	resourceType   = "Task"
+	externalSystem = "Cloud Foundry"
+	errWrongKind   = "managed resource is not of kind " + resourceType
+	errTrackUsage  = "cannot track usage"
+	errGetClient   = "cannot create a client to talk to the API of " + externalSystem
+	errGet         = "cannot get " + resourceType + " in " + externalSystem
+	errCreate      = "cannot run " + resourceType + " in " + externalSystem
+	errMissingApp  = "the app of the " + resourceType + " is not resolved"
+	errUpdate      = "cannot update " + resourceType
+	errUpdateCR    = "cannot update the managed resource"
+	errDelete      = "cannot cancel " + resourceType
+	msgInProgress  = "task is "
+	msgFailed      = "task failed: "
+)
+
+// Setup adds controllers that reconcile cluster scoped and namespaced
+// Task managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := setup(mgr, o, v1alpha1.Task_GroupVersionKind, &v1alpha1.Task{}); err != nil {
+		return err
+	}
+	return setup(mgr, o, nsv1alpha1.Task_GroupVersionKind, &nsv1alpha1.Task{})
+}
+
+func setup(mgr ctrl.Manager, o controller.Options, gvk schema.GroupVersionKind, obj resource.Managed) error {
+	name := managed.ControllerName(gvk.GroupKind().String())
+
+	options := []managed.ReconcilerOption{
+		managed.WithInitializers(),
+		managed.WithExternalConnector(cferrors.NewConnector(&connector{
+			kube:  mgr.GetClient(),
+			usage: clients.NewProviderConfigUsageTracker(mgr.GetClient()),
+		})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		options = append(options, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(gvk),
+		options...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(obj).
+		Complete(ratelimiter.NewReconciler(name, cferrors.NewReconciler(mgr.GetClient(), resource.ManagedKind(gvk), r, o.PollInterval), o.GlobalRateLimiter))
+}
+
+// A connector supplies a function for the Reconciler to create a client to the external CloudFoundry resources.
+type connector struct {
+	kube  k8s.Client
+	usage resource.Tracker
+}
+
+// Connect tracks the usage of the ProviderConfig and creates a
+// Task client from its credentials.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(v1alpha1.TaskManaged); !ok {
+		return nil, errors.New(errWrongKind)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackUsage)
+	}
+
+	cf, err := clients.ClientFnBuilder(ctx, c.kube)(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetClient)
+	}
+
+	return &external{kube: c.kube, client: task.NewClient(cf)}, nil
+}
+
+// An external is a managed.ExternalClient that is using the CloudFoundry API to observe and modify resources.
+type external struct {
+	kube   k8s.Client
+	client task.Client
+}
+
+// Disconnect implements the managed.ExternalClient interface
+func (c *external) Disconnect(ctx context.Context) error {
+	// No cleanup needed for Cloud Foundry client
+	return nil
+}
+
+// Observe managed resource Task. A finished task is kept as it is, even once
+// Cloud Foundry has pruned it, until the hash of its parameters changes and
+// the task has to run again.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(v1alpha1.TaskManaged)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errWrongKind)
+	}
+
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if !clients.IsValidGUID(guid) {
+		return managed.ExternalObservation{}, errors.Errorf("external-name '%s' is not a valid GUID format", guid)
+	}
+
+	t, err := c.client.Get(ctx, guid)
+	if err != nil && !clients.ErrorIsNotFound(err) {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+
+	state := ptr.Deref(cr.GetAtProvider().State, "")
+	if t == nil && !task.IsFinished(state) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if t != nil {
+		obs := task.GenerateObservation(t)
+		obs.SpecHash = cr.GetAtProvider().SpecHash
+		*cr.GetAtProvider() = obs
+		state = t.State
+	}
+
+	if meta.WasDeleted(cr) {
+		// only a task that has not finished yet is canceled
+		return managed.ExternalObservation{ResourceExists: !task.IsFinished(state), ResourceUpToDate: true}, nil
+	}
+
+	if task.IsFinished(state) && ptr.Deref(cr.GetAtProvider().SpecHash, "") != task.SpecHash(*cr.GetForProvider()) {
+		// run the task again
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	switch state {
+	case task.StateSucceeded:
+		cr.SetConditions(xpv1.Available())
+	case task.StateFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgFailed + ptr.Deref(cr.GetAtProvider().FailureReason, "")))
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgInProgress + strings.ToLower(state)))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		// the metadata of a pruned task cannot be updated
+		ResourceUpToDate: t == nil || task.IsUpToDate(cr, *cr.GetForProvider(), t),
+	}, nil
+}
+
+// Create managed resource Task by running the task.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(v1alpha1.TaskManaged)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	spec := cr.GetForProvider()
+	if spec.App == nil {
+		return managed.ExternalCreation{}, errors.New(errMissingApp)
+	}
+
+	t, err := c.client.Create(ctx, *spec.App, task.GenerateCreate(cr, *spec))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, t.GUID)
+
+	// The reconciler reverts status changes made during Create. Update the CR
+	// before updating the status so that the hash of the parameters the task
+	// runs with is not lost.
+	if err := c.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errUpdateCR)
+	}
+	obs := task.GenerateObservation(t)
+	obs.SpecHash = ptr.To(task.SpecHash(*spec))
+	*cr.GetAtProvider() = obs
+	if err := c.kube.Status().Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errUpdateCR)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+// Update managed resource Task. Only the metadata of a task can be updated.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(v1alpha1.TaskManaged)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errWrongKind)
+	}
+
+	if _, err := c.client.Update(ctx, meta.GetExternalName(cr), task.GenerateUpdate(cr, *cr.GetForProvider())); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete managed resource Task. A pending or running task is canceled; a
+// finished task is left as it is.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(v1alpha1.TaskManaged)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errWrongKind)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	guid := meta.GetExternalName(cr)
+	if guid == "" || !task.IsCancelable(ptr.Deref(cr.GetAtProvider().State, "")) {
+		return managed.ExternalDelete{}, nil
+	}
+
+	if _, err := c.client.Cancel(ctx, guid); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(clients.IgnoreNotFoundErr(err), errDelete)
+	}
+	return managed.ExternalDelete{}, nil
+}
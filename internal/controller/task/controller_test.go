@@ -0,0 +1,395 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	k8s "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/task"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	resourceName = "db-migration"
+	guid         = "3c9a5f1e-8d2b-4e7a-b6c1-0f9e8d7c6b5a"
+	appGUID      = "7b2e4c6d-1a3f-4b5e-8c7d-9e0f1a2b3c4d"
+	command      = "bin/migrate"
+)
+
+type modifier func(*v1alpha1.Task)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.Task) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withDeletionTimestamp() modifier {
+	return func(r *v1alpha1.Task) {
+		r.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1, 0)})
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.Task) { r.Status.SetConditions(c...) }
+}
+
+func withCommand(command string) modifier {
+	return func(r *v1alpha1.Task) { r.Spec.ForProvider.Command = command }
+}
+
+func withState(state string) modifier {
+	return func(r *v1alpha1.Task) { r.Status.AtProvider.State = ptr.To(state) }
+}
+
+func withFailureReason(reason string) modifier {
+	return func(r *v1alpha1.Task) { r.Status.AtProvider.FailureReason = ptr.To(reason) }
+}
+
+// withSpecHash records the hash of the parameters of a task running command.
+func withSpecHash() modifier {
+	return func(r *v1alpha1.Task) {
+		r.Status.AtProvider.SpecHash = ptr.To(task.SpecHash(newTask().Spec.ForProvider))
+	}
+}
+
+func newTask(m ...modifier) *v1alpha1.Task {
+	r := &v1alpha1.Task{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.TaskSpec{
+			ForProvider: v1alpha1.TaskParameters{
+				Command: command,
+				App:     ptr.To(appGUID),
+			},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+func cfTask(state string, m ...func(*cfresource.Task)) *cfresource.Task {
+	t := &cfresource.Task{
+		Resource: cfresource.Resource{GUID: guid},
+		Name:     "a1b2c3d4",
+		Command:  command,
+		State:    state,
+		Metadata: metadata.BuildMetadata(newTask(), nil, nil),
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockTask
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockTask { return &fake.MockTask{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"NotRunYet": {
+			mg:      newTask(),
+			service: func() *fake.MockTask { return &fake.MockTask{} },
+			want: want{
+				mg:  newTask(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Running": {
+			mg: newTask(withExternalName(guid), withSpecHash(), withState(task.StatePending)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Get", guid).Return(cfTask(task.StateRunning), nil)
+				return m
+			},
+			want: want{
+				mg:  newTask(withExternalName(guid), withSpecHash(), withState(task.StateRunning), withConditions(xpv1.Unavailable().WithMessage(msgInProgress+"running"))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Succeeded": {
+			mg: newTask(withExternalName(guid), withSpecHash(), withState(task.StateRunning)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Get", guid).Return(cfTask(task.StateSucceeded), nil)
+				return m
+			},
+			want: want{
+				mg:  newTask(withExternalName(guid), withSpecHash(), withState(task.StateSucceeded), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			mg: newTask(withExternalName(guid), withSpecHash(), withState(task.StateRunning)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Get", guid).Return(cfTask(task.StateFailed, func(t *cfresource.Task) {
+					t.Result.FailureReason = ptr.To("APP/TASK/a1b2c3d4: Exited with status 1")
+				}), nil)
+				return m
+			},
+			want: want{
+				mg: newTask(withExternalName(guid), withSpecHash(), withState(task.StateFailed), withFailureReason("APP/TASK/a1b2c3d4: Exited with status 1"),
+					withConditions(xpv1.Unavailable().WithMessage(msgFailed+"APP/TASK/a1b2c3d4: Exited with status 1"))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PrunedAfterSucceeded": {
+			mg: newTask(withExternalName(guid), withSpecHash(), withState(task.StateSucceeded)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Get", guid).Return(fake.TaskNil, cfresource.NewResourceNotFoundError())
+				return m
+			},
+			want: want{
+				mg:  newTask(withExternalName(guid), withSpecHash(), withState(task.StateSucceeded), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RunAgainOnSpecChange": {
+			mg: newTask(withExternalName(guid), withCommand("bin/migrate --all"), withSpecHash(), withState(task.StateSucceeded)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Get", guid).Return(cfTask(task.StateSucceeded), nil)
+				return m
+			},
+			want: want{
+				mg:  newTask(withExternalName(guid), withCommand("bin/migrate --all"), withSpecHash(), withState(task.StateSucceeded)),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"WaitForRunningTaskOnSpecChange": {
+			mg: newTask(withExternalName(guid), withCommand("bin/migrate --all"), withSpecHash(), withState(task.StateRunning)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Get", guid).Return(cfTask(task.StateRunning), nil)
+				return m
+			},
+			want: want{
+				mg: newTask(withExternalName(guid), withCommand("bin/migrate --all"), withSpecHash(), withState(task.StateRunning),
+					withConditions(xpv1.Unavailable().WithMessage(msgInProgress+"running"))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			mg: newTask(withExternalName(guid)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Get", guid).Return(fake.TaskNil, cfresource.NewResourceNotFoundError())
+				return m
+			},
+			want: want{
+				mg:  newTask(withExternalName(guid)),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FinishedOnDeletion": {
+			mg: newTask(withExternalName(guid), withDeletionTimestamp(), withSpecHash(), withState(task.StateRunning)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Get", guid).Return(cfTask(task.StateFailed), nil)
+				return m
+			},
+			want: want{
+				mg:  newTask(withExternalName(guid), withDeletionTimestamp(), withSpecHash(), withState(task.StateFailed)),
+				obs: managed.ExternalObservation{ResourceExists: false, ResourceUpToDate: true},
+			},
+		},
+		"GetError": {
+			mg: newTask(withExternalName(guid)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Get", guid).Return(fake.TaskNil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  newTask(withExternalName(guid)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{client: m}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				opts := cmp.Options{test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.TaskObservation{},
+					"ID", "Name", "SequenceID", "MemoryInMB", "DiskInMB", "LogRateLimitInBytesPerSecond", "Droplet", "CreatedAt", "UpdatedAt", "ResourceMetadata")}
+				if diff := cmp.Diff(tc.want.mg, tc.mg, opts); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		kube    k8s.Client
+		service func() *fake.MockTask
+		want    want
+	}{
+		"Success": {
+			mg:   newTask(),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil), MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil)},
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				create := cfresource.NewTaskCreateWithCommand(command)
+				create.Metadata = metadata.BuildMetadata(newTask(), nil, nil)
+				m.On("Create", appGUID, create).Return(cfTask(task.StatePending), nil)
+				return m
+			},
+			want: want{
+				mg: newTask(withExternalName(guid), withSpecHash(), withState(task.StatePending), withConditions(xpv1.Creating())),
+			},
+		},
+		"MissingApp": {
+			mg:      newTask(func(r *v1alpha1.Task) { r.Spec.ForProvider.App = nil }),
+			service: func() *fake.MockTask { return &fake.MockTask{} },
+			want: want{
+				mg:  newTask(func(r *v1alpha1.Task) { r.Spec.ForProvider.App = nil }, withConditions(xpv1.Creating())),
+				err: errors.New(errMissingApp),
+			},
+		},
+		"CreateError": {
+			mg: newTask(),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Create", appGUID, mock.Anything).Return(fake.TaskNil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  newTask(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"UpdateCRError": {
+			mg:   newTask(),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Create", appGUID, mock.Anything).Return(cfTask(task.StatePending), nil)
+				return m
+			},
+			want: want{
+				mg:  newTask(withExternalName(guid), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{kube: tc.kube, client: m}
+			_, err := c.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			opts := cmp.Options{test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.TaskObservation{},
+				"ID", "Name", "SequenceID", "MemoryInMB", "DiskInMB", "LogRateLimitInBytesPerSecond", "Droplet", "CreatedAt", "UpdatedAt", "ResourceMetadata")}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, opts); diff != "" {
+				t.Errorf("Create(...): -want mg, +got mg:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockTask
+		err     error
+	}{
+		"CancelRunning": {
+			mg: newTask(withExternalName(guid), withState(task.StateRunning)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Cancel", guid).Return(cfTask(task.StateCanceling), nil)
+				return m
+			},
+		},
+		"AlreadyCanceling": {
+			mg:      newTask(withExternalName(guid), withState(task.StateCanceling)),
+			service: func() *fake.MockTask { return &fake.MockTask{} },
+		},
+		"AlreadyDeleted": {
+			mg: newTask(withExternalName(guid), withState(task.StatePending)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Cancel", guid).Return(fake.TaskNil, cfresource.NewResourceNotFoundError())
+				return m
+			},
+		},
+		"CancelError": {
+			mg: newTask(withExternalName(guid), withState(task.StateRunning)),
+			service: func() *fake.MockTask {
+				m := &fake.MockTask{}
+				m.On("Cancel", guid).Return(fake.TaskNil, errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			m := tc.service()
+			c := &external{client: m}
+			_, err := c.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
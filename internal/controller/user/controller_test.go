@@ -0,0 +1,368 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	cfresource "github.com/cloudfoundry/go-cfclient/v3/resource"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/SAP/crossplane-provider-cloudfoundry/apis/resources/v1alpha1"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/fake"
+	"github.com/SAP/crossplane-provider-cloudfoundry/internal/clients/metadata"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	resourceName = "jane-doe"
+	guid         = "3f2b8c1d-7a4e-4b9f-8c2d-5e6f7a8b9c01"
+	username     = "jane.doe@example.com"
+	origin       = "sap.ids"
+	jobGUID      = "job-guid"
+)
+
+type modifier func(*v1alpha1.User)
+
+func withExternalName(name string) modifier {
+	return func(r *v1alpha1.User) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withCreated() modifier {
+	return func(r *v1alpha1.User) {
+		meta.AddAnnotations(r, map[string]string{createdAnnotation: "true"})
+	}
+}
+
+func withGUID(guid string) modifier {
+	return func(r *v1alpha1.User) {
+		r.Spec.ForProvider.GUID = ptr.To(guid)
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(r *v1alpha1.User) { r.Status.SetConditions(c...) }
+}
+
+func withObservation() modifier {
+	return func(r *v1alpha1.User) {
+		r.Status.AtProvider = v1alpha1.UserObservation{
+			ID:               ptr.To(guid),
+			Username:         ptr.To(username),
+			Origin:           ptr.To(origin),
+			PresentationName: ptr.To(username),
+			CreatedAt:        ptr.To("0001-01-01T00:00:00Z"),
+			UpdatedAt:        ptr.To("0001-01-01T00:00:00Z"),
+		}
+		if m := observedMetadata(); m != nil {
+			r.Status.AtProvider.Labels = m.Labels
+		}
+	}
+}
+
+func newUser(m ...modifier) *v1alpha1.User {
+	r := &v1alpha1.User{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resourceName,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.UserSpec{
+			ForProvider: v1alpha1.UserParameters{Username: username, Origin: origin},
+		},
+	}
+	for _, rm := range m {
+		rm(r)
+	}
+	return r
+}
+
+// observedMetadata returns the metadata CF reports for a user created by the
+// provider.
+func observedMetadata() *cfresource.Metadata {
+	return metadata.BuildMetadata(newUser(), nil, nil)
+}
+
+func cfUser() *cfresource.User {
+	return &cfresource.User{
+		Username:         ptr.To(username),
+		Origin:           ptr.To(origin),
+		PresentationName: username,
+		Metadata:         observedMetadata(),
+		Resource:         cfresource.Resource{GUID: guid},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockUser
+		want    want
+	}{
+		"WrongKind": {
+			mg:      nil,
+			service: func() *fake.MockUser { return &fake.MockUser{} },
+			want:    want{err: errors.New(errWrongKind)},
+		},
+		"NotFoundByUsername": {
+			mg: newUser(),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("Single").Return(nil, fake.ErrNoResultReturned)
+				return m
+			},
+			want: want{
+				mg:  newUser(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AdoptByUsername": {
+			mg: newUser(),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("Single").Return(cfUser(), nil)
+				m.On("Get", guid).Return(cfUser(), nil)
+				return m
+			},
+			want: want{
+				mg:  newUser(withExternalName(guid), withObservation(), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"NotFoundByGUID": {
+			mg: newUser(withGUID(guid)),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("Get", guid).Return(nil, cfresource.NewResourceNotFoundError())
+				return m
+			},
+			want: want{
+				mg:  newUser(withGUID(guid)),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"MetadataOutdated": {
+			mg: newUser(withExternalName(guid)),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				u := cfUser()
+				u.Metadata = nil
+				m.On("Get", guid).Return(u, nil)
+				return m
+			},
+			want: want{
+				mg: newUser(withExternalName(guid), withObservation(), withConditions(xpv1.Available()), func(r *v1alpha1.User) {
+					r.Status.AtProvider.Labels = nil
+				}),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"GetError": {
+			mg: newUser(withExternalName(guid)),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("Get", guid).Return(nil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  newUser(withExternalName(guid)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			obs, err := c.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.mg != nil {
+				if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("Observe(...): -want mg, +got mg:\n%s", diff)
+				}
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockUser
+		want    want
+	}{
+		"ByUsername": {
+			mg: newUser(),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("CreateWithUsername", username, origin).Return(cfUser(), nil)
+				return m
+			},
+			want: want{
+				mg: newUser(withExternalName(guid), withCreated(), withConditions(xpv1.Creating())),
+			},
+		},
+		"ByGUID": {
+			mg: newUser(withGUID(guid)),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("Create", guid).Return(cfUser(), nil)
+				return m
+			},
+			want: want{
+				mg: newUser(withGUID(guid), withExternalName(guid), withCreated(), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			mg: newUser(),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("CreateWithUsername", username, origin).Return(nil, errBoom)
+				return m
+			},
+			want: want{
+				mg:  newUser(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want mg, +got mg:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockUser
+		err     error
+	}{
+		"Success": {
+			mg: newUser(withExternalName(guid)),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("Update", guid).Return(cfUser(), nil)
+				return m
+			},
+		},
+		"UpdateError": {
+			mg: newUser(withExternalName(guid)),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("Update", guid).Return(nil, errBoom)
+				return m
+			},
+			err: errors.Wrap(errBoom, errUpdate),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc := tc.service()
+			c := &external{client: svc}
+			_, err := c.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      resource.Managed
+		service func() *fake.MockUser
+		job     func() *fake.MockJob
+		err     error
+	}{
+		"Success": {
+			mg: newUser(withExternalName(guid), withCreated()),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("Delete", guid).Return(jobGUID, nil)
+				return m
+			},
+			job: func() *fake.MockJob {
+				m := &fake.MockJob{}
+				m.On("PollComplete").Return(nil)
+				return m
+			},
+		},
+		"Adopted": {
+			mg:      newUser(withExternalName(guid)),
+			service: func() *fake.MockUser { return &fake.MockUser{} },
+			job:     func() *fake.MockJob { return &fake.MockJob{} },
+		},
+		"AlreadyDeleted": {
+			mg: newUser(withExternalName(guid), withCreated()),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("Delete", guid).Return("", cfresource.NewResourceNotFoundError())
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+		},
+		"DeleteError": {
+			mg: newUser(withExternalName(guid), withCreated()),
+			service: func() *fake.MockUser {
+				m := &fake.MockUser{}
+				m.On("Delete", guid).Return("", errBoom)
+				return m
+			},
+			job: func() *fake.MockJob { return &fake.MockJob{} },
+			err: errors.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			svc, j := tc.service(), tc.job()
+			c := &external{client: svc, job: j}
+			_, err := c.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			svc.AssertExpectations(t)
+			j.AssertExpectations(t)
+		})
+	}
+}
@@ -115,6 +115,12 @@ func extractConfigurations() ([]ResourceConfig, error) {
 			return err
 		}
 
+		// namespaced resources share the external-name format of their
+		// cluster scoped counterparts
+		if info.IsDir() && info.Name() == "namespaced" {
+			return filepath.SkipDir
+		}
+
 		if info.IsDir() || !strings.HasSuffix(path, "_types.go") {
 			return nil
 		}